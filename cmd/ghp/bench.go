@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"time"
+
+	"github.com/h0rv/ghp/internal/fixture"
+	"github.com/h0rv/ghp/internal/tui"
+	"github.com/spf13/cobra"
+)
+
+// newBenchCmd creates the hidden `ghp bench` subcommand, which times the
+// board on a synthetic project to catch performance regressions.
+func newBenchCmd() *cobra.Command {
+	var (
+		cardsFlag      int
+		roundsFlag     int
+		seedFlag       uint64
+		widthFlag      int
+		heightFlag     int
+		filterFlag     string
+		cpuProfileFlag string
+		memProfileFlag string
+	)
+
+	cmd := &cobra.Command{
+		Use:    "bench",
+		Short:  "Time board layout, rendering, and filtering on a synthetic project",
+		Hidden: true,
+		Long: `Generate a synthetic project and report the mean time of the board's hot
+paths: building columns, rendering, filtering, and moving the selection.
+No GitHub access is needed.
+
+Write profiles with --cpuprofile and --memprofile and inspect them with
+'go tool pprof'.`,
+		Example: `  ghp bench --cards 10000
+  ghp bench --cards 20000 --cpuprofile cpu.out && go tool pprof -top cpu.out`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cardsFlag < 1 || roundsFlag < 1 {
+				return usageError(fmt.Errorf("--cards and --rounds must be at least 1"))
+			}
+			if widthFlag < 40 || heightFlag < 10 {
+				return usageError(fmt.Errorf("--width must be at least 40 and --height at least 10"))
+			}
+
+			start := time.Now()
+			s := fixture.Board(cardsFlag, seedFlag)
+			generated := time.Since(start)
+
+			if cpuProfileFlag != "" {
+				f, err := os.Create(cpuProfileFlag)
+				if err != nil {
+					return fmt.Errorf("failed to create CPU profile: %w", err)
+				}
+				defer f.Close()
+				if err := pprof.StartCPUProfile(f); err != nil {
+					return fmt.Errorf("failed to start CPU profile: %w", err)
+				}
+			}
+			result := tui.MeasureBoard(s, widthFlag, heightFlag, roundsFlag, filterFlag)
+			if cpuProfileFlag != "" {
+				pprof.StopCPUProfile()
+			}
+
+			if memProfileFlag != "" {
+				f, err := os.Create(memProfileFlag)
+				if err != nil {
+					return fmt.Errorf("failed to create memory profile: %w", err)
+				}
+				defer f.Close()
+				runtime.GC()
+				if err := pprof.WriteHeapProfile(f); err != nil {
+					return fmt.Errorf("failed to write memory profile: %w", err)
+				}
+			}
+
+			w := cmd.OutOrStdout()
+			fmt.Fprintf(w, "%d cards, %dx%d, %d rounds (generated in %s)\n", cardsFlag, widthFlag, heightFlag, roundsFlag, generated.Round(time.Millisecond))
+			fmt.Fprintf(w, "layout    %s\n", result.Layout)
+			fmt.Fprintf(w, "render    %s\n", result.Render)
+			fmt.Fprintf(w, "filter    %s  (%q)\n", result.Filter, filterFlag)
+			fmt.Fprintf(w, "navigate  %s\n", result.Navigate)
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&cardsFlag, "cards", 5000, "Number of synthetic cards")
+	cmd.Flags().IntVar(&roundsFlag, "rounds", 20, "Times each operation runs; the mean is reported")
+	cmd.Flags().Uint64Var(&seedFlag, "seed", 1, "Seed for the synthetic project; the same seed gives the same board")
+	cmd.Flags().IntVar(&widthFlag, "width", 200, "Terminal width in columns")
+	cmd.Flags().IntVar(&heightFlag, "height", 50, "Terminal height in rows")
+	cmd.Flags().StringVar(&filterFlag, "filter", "cache", "Filter text to time")
+	cmd.Flags().StringVar(&cpuProfileFlag, "cpuprofile", "", "Write a CPU profile of the measured operations to this file")
+	cmd.Flags().StringVar(&memProfileFlag, "memprofile", "", "Write a heap profile to this file after measuring")
+	return cmd
+}
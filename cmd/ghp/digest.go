@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/h0rv/ghp/internal/cache"
+	"github.com/h0rv/ghp/internal/stats"
+	"github.com/spf13/cobra"
+)
+
+// newDigestCmd creates the `ghp digest` subcommand, which summarizes recent
+// project activity as markdown.
+func newDigestCmd() *cobra.Command {
+	var (
+		weekFlag  bool
+		sinceFlag string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "digest",
+		Short: "Summarize recent project activity as markdown",
+		Long: `Print a markdown digest of completed items, new items, and status changes,
+ready to pipe to mail or paste into a discussion.
+
+Completed and moved items come from the board history ghp records each time it
+loads the project, so they only cover periods when ghp ran; run 'ghp watch' to
+record history continuously.`,
+		Example: `  ghp digest --owner myorg --project 1 --week
+  ghp digest --owner myorg --project 1 --since 2024-06-01 | mail -s "Project digest" team@example.com`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireProjectFlags(); err != nil {
+				return err
+			}
+			if weekFlag && sinceFlag != "" {
+				return usageError(fmt.Errorf("--week and --since can't be combined"))
+			}
+			until := time.Now()
+			since := until.AddDate(0, 0, -7)
+			if sinceFlag != "" {
+				t, err := time.ParseInLocation(time.DateOnly, sinceFlag, time.Local)
+				if err != nil {
+					return usageError(fmt.Errorf("--since must be a date like 2024-06-01"))
+				}
+				since = t
+			}
+
+			client, err := newClient()
+			if err != nil {
+				return err
+			}
+			entry, err := fetchSnapshot(cmd.Context(), client)
+			if err != nil {
+				return err
+			}
+			if err := loadItemDetails(cmd.Context(), client, entry.Cards); err != nil {
+				return err
+			}
+			history, err := cache.LoadHistory(entry.Project.Owner, entry.Project.Number)
+			if err != nil {
+				return err
+			}
+
+			snapshots := make([]stats.Snapshot, len(history))
+			for i, s := range history {
+				snapshots[i] = stats.Snapshot(s)
+			}
+
+			digest := stats.BuildDigest(entry.Cards, snapshots, entry.GroupField, since, until)
+			_, err = io.WriteString(cmd.OutOrStdout(), digest.Markdown(entry.Project, entry.GroupField))
+			return err
+		},
+	}
+
+	cmd.Flags().BoolVar(&weekFlag, "week", false, "Cover the last 7 days (the default)")
+	cmd.Flags().StringVar(&sinceFlag, "since", "", "Cover activity since a date (YYYY-MM-DD)")
+
+	return cmd
+}
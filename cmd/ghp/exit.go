@@ -0,0 +1,67 @@
+package main
+
+import (
+	"errors"
+	"io"
+
+	"github.com/h0rv/ghp/internal/gh"
+	"github.com/h0rv/ghp/internal/workspace"
+	"github.com/spf13/cobra"
+)
+
+// Exit codes, documented in the root command's help for scripts to rely on.
+const (
+	exitOK          = 0
+	exitFailure     = 1 // Anything not listed below
+	exitUsage       = 2 // Invalid flags or arguments
+	exitAuth        = 3 // No token, token rejected, or SAML SSO authorization needed
+	exitNotFound    = 4 // Owner, project, item, or workspace does not exist
+	exitRateLimited = 5 // GitHub API rate limit exceeded
+	exitPartial     = 6 // A bulk operation applied only some of its changes
+)
+
+// exitError attaches an exit code to an error found by the CLI itself.
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e *exitError) Error() string { return e.err.Error() }
+func (e *exitError) Unwrap() error { return e.err }
+
+// usageError marks err as a problem with the command line.
+func usageError(err error) error {
+	return &exitError{code: exitUsage, err: err}
+}
+
+// exitCode maps an error returned by a command to the process exit code.
+func exitCode(err error) int {
+	var exit *exitError
+	var sso *gh.SSORequiredError
+	var notFound *gh.NotFoundError
+	var partial *gh.PartialError
+	switch {
+	case err == nil:
+		return exitOK
+	case errors.As(err, &exit):
+		return exit.code
+	case errors.As(err, &partial):
+		return exitPartial
+	case errors.As(err, &sso), errors.Is(err, gh.ErrUnauthorized):
+		return exitAuth
+	case errors.Is(err, gh.ErrRateLimited):
+		return exitRateLimited
+	case errors.As(err, &notFound), errors.Is(err, workspace.ErrNotFound):
+		return exitNotFound
+	}
+	return exitFailure
+}
+
+// progressOut returns where a command writes progress and confirmations:
+// its output, or nowhere with --quiet. Requested data is always printed.
+func progressOut(cmd *cobra.Command) io.Writer {
+	if quietFlag {
+		return io.Discard
+	}
+	return cmd.OutOrStdout()
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// extensionName is the gh extension name; gh runs it as `gh projects-board`
+// from an executable named gh-projects-board.
+const extensionName = "projects-board"
+
+// isExtension reports whether ghp is running as a gh extension.
+// This is true when built with the ghextension tag or when the executable
+// was installed under the extension's name.
+func isExtension() bool {
+	if extensionBuild {
+		return true
+	}
+	base := strings.TrimSuffix(filepath.Base(os.Args[0]), ".exe")
+	return base == "gh-"+extensionName
+}
+
+// commandName returns how the user invokes ghp, for usage and help text.
+func commandName() string {
+	if isExtension() {
+		return "gh " + extensionName
+	}
+	return "ghp"
+}
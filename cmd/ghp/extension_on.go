@@ -0,0 +1,6 @@
+//go:build ghextension
+
+package main
+
+// extensionBuild is set when built with `-tags ghextension`.
+const extensionBuild = true
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/spf13/cobra"
+)
+
+// fieldsOutput is the --json shape of `ghp fields`, named after the
+// arguments of updateProjectV2ItemFieldValue.
+type fieldsOutput struct {
+	ProjectID string        `json:"projectId"`
+	Title     string        `json:"title"`
+	Fields    []fieldOutput `json:"fields"`
+}
+
+type fieldOutput struct {
+	ID      string         `json:"id"`
+	Name    string         `json:"name"`
+	Type    string         `json:"dataType"`
+	Options []optionOutput `json:"options,omitempty"`
+}
+
+type optionOutput struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color,omitempty"`
+}
+
+// newFieldsCmd creates the `ghp fields` subcommand, which lists a project's fields.
+func newFieldsCmd() *cobra.Command {
+	var jsonFlag bool
+
+	cmd := &cobra.Command{
+		Use:   "fields",
+		Short: "Print a project's fields, types, and options",
+		Long: `Print every field of a project with its type and node ID, and the options
+of single-select fields. The IDs are the ones updateProjectV2ItemFieldValue
+expects, so this is a quick way to look them up when scripting.`,
+		Example: `  ghp fields --owner myorg --project 1
+  ghp fields --owner myorg --project 1 --json | jq -r '.fields[] | select(.name == "Status") | .id'`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireProjectFlags(); err != nil {
+				return err
+			}
+			client, err := newClient()
+			if err != nil {
+				return err
+			}
+			project, fields, err := client.GetProjectByNumber(cmd.Context(), ownerFlag, projectFlag)
+			if err != nil {
+				return err
+			}
+			if jsonFlag {
+				return printFieldsJSON(cmd.OutOrStdout(), project, fields)
+			}
+			return printFields(cmd.OutOrStdout(), project, fields)
+		},
+	}
+
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Print as JSON, including option colors")
+
+	return cmd
+}
+
+// printFields lists fields as an aligned table with options indented below their field.
+func printFields(out io.Writer, project domain.Project, fields []domain.FieldDef) error {
+	fmt.Fprintf(out, "%s (%s)\n\n", project.Title, project.ID)
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tTYPE\tID")
+	for _, f := range fields {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", f.Name, f.Type, f.ID)
+		for _, opt := range f.Options {
+			fmt.Fprintf(w, "  %s\toption\t%s\n", opt.Name, opt.ID)
+		}
+	}
+	return w.Flush()
+}
+
+// printFieldsJSON writes fields as indented JSON.
+func printFieldsJSON(out io.Writer, project domain.Project, fields []domain.FieldDef) error {
+	result := fieldsOutput{ProjectID: project.ID, Title: project.Title, Fields: make([]fieldOutput, 0, len(fields))}
+	for _, f := range fields {
+		field := fieldOutput{ID: f.ID, Name: f.Name, Type: f.Type}
+		for _, opt := range f.Options {
+			field.Options = append(field.Options, optionOutput{ID: opt.ID, Name: opt.Name, Color: opt.Color})
+		}
+		result.Fields = append(result.Fields, field)
+	}
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(result)
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/gh"
+	"github.com/h0rv/ghp/internal/importer"
+	"github.com/h0rv/ghp/internal/store"
+	"github.com/spf13/cobra"
+)
+
+// newImportCmd creates the `ghp import` subcommand, which bulk-creates items from a file.
+func newImportCmd() *cobra.Command {
+	var (
+		formatFlag string
+		statusFlag string
+		repoFlag   string
+		dryRunFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Create project items from a CSV file or markdown checklist",
+		Long: `Create project items from a CSV file or markdown checklist.
+
+CSV files need a header row with a "title" column; "body" and "status" columns
+are optional. Markdown files are read as task lists ("- [ ] Title"), with
+indented lines beneath a task used as its body. Use "-" to read from stdin.
+
+Items are created as draft issues unless --repo is given, in which case real
+issues are opened in that repository and added to the project.`,
+		Example: `  ghp import backlog.csv --owner myorg --project 1 --status Todo --dry-run
+  ghp import launch.md --owner myorg --project 1 --repo myorg/web`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireProjectFlags(); err != nil {
+				return err
+			}
+
+			var owner, repo string
+			if repoFlag != "" {
+				var ok bool
+				owner, repo, ok = strings.Cut(repoFlag, "/")
+				if !ok || owner == "" || repo == "" {
+					return fmt.Errorf("--repo must be in owner/name form")
+				}
+			}
+
+			entries, err := readImportFile(args[0], formatFlag)
+			if err != nil {
+				return err
+			}
+			if len(entries) == 0 {
+				return fmt.Errorf("no items found in %s", args[0])
+			}
+
+			return runImport(cmd.Context(), progressOut(cmd), entries, statusFlag, owner, repo, dryRunFlag)
+		},
+	}
+
+	cmd.Flags().StringVar(&formatFlag, "format", "", "Input format: csv or markdown (default: from file extension)")
+	cmd.Flags().StringVar(&statusFlag, "status", "", "Status option for imported items (overridden by a CSV status column)")
+	cmd.Flags().StringVar(&repoFlag, "repo", "", "Create real issues in this repository (owner/name) instead of drafts")
+	cmd.Flags().BoolVar(&dryRunFlag, "dry-run", false, "Preview the items without creating them")
+
+	return cmd
+}
+
+// readImportFile opens and parses the import source.
+func readImportFile(path, format string) ([]importer.Entry, error) {
+	if format == "" {
+		if path == "-" {
+			return nil, fmt.Errorf("--format is required when reading from stdin")
+		}
+		detected, err := importer.DetectFormat(path)
+		if err != nil {
+			return nil, err
+		}
+		format = detected
+	}
+
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	return importer.Parse(r, format)
+}
+
+// runImport validates statuses, then creates each entry (or prints it when dryRun is set).
+// Statuses are validated before anything is created so a typo cannot leave a partial import.
+func runImport(ctx context.Context, out io.Writer, entries []importer.Entry, defaultStatus, owner, repo string, dryRun bool) error {
+	client, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	project, err := loadProject(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	fields, err := client.GetProjectFields(ctx, project.ID)
+	if err != nil {
+		return err
+	}
+
+	groupField, err := resolveGroupField(fields)
+	if err != nil {
+		return err
+	}
+
+	optionIDs := make([]string, len(entries))
+	for i, entry := range entries {
+		status := entry.Status
+		if status == "" {
+			status = defaultStatus
+		}
+		if status == "" {
+			continue
+		}
+		opt, err := store.FindOption(groupField, status)
+		if err != nil {
+			return fmt.Errorf("%w (item %q)", err, entry.Title)
+		}
+		optionIDs[i] = opt.ID
+	}
+
+	target := "draft issue"
+	if repo != "" {
+		target = fmt.Sprintf("issue in %s/%s", owner, repo)
+	}
+
+	// Once something was created, a failure leaves the import half done
+	partial := func(i int, err error) error {
+		if i == 0 {
+			return err
+		}
+		return &gh.PartialError{Done: i, Total: len(entries), Err: err}
+	}
+
+	for i, entry := range entries {
+		status := optionName(groupField, optionIDs[i])
+		if dryRun {
+			fmt.Fprintf(out, "would create %s: %s [%s]\n", target, entry.Title, status)
+			continue
+		}
+
+		var itemID string
+		if repo != "" {
+			contentID, err := client.CreateIssue(ctx, owner, repo, entry.Title, entry.Body)
+			if err != nil {
+				return partial(i, fmt.Errorf("item %d (%q): %w", i+1, entry.Title, err))
+			}
+			itemID, err = client.AddItem(ctx, project.ID, contentID)
+			if err != nil {
+				return partial(i, fmt.Errorf("item %d (%q): %w", i+1, entry.Title, err))
+			}
+		} else {
+			itemID, err = client.AddDraftIssue(ctx, project.ID, entry.Title, entry.Body)
+			if err != nil {
+				return partial(i, fmt.Errorf("item %d (%q): %w", i+1, entry.Title, err))
+			}
+		}
+
+		if optionIDs[i] != "" {
+			if err := client.UpdateItemField(ctx, project.ID, itemID, groupField.ID, gh.SingleSelectValue(optionIDs[i])); err != nil {
+				return &gh.PartialError{Done: i, Total: len(entries), Err: fmt.Errorf("item %d (%q) created but status not set: %w", i+1, entry.Title, err)}
+			}
+		}
+
+		fmt.Fprintf(out, "created %s: %s [%s]\n", target, entry.Title, status)
+	}
+
+	if dryRun {
+		fmt.Fprintf(out, "%d items (dry run, nothing created)\n", len(entries))
+	}
+	return nil
+}
+
+// optionName returns the display name for an option ID, or "No Status" if empty.
+func optionName(field *domain.FieldDef, optionID string) string {
+	for _, opt := range field.Options {
+		if opt.ID == optionID {
+			return opt.Name
+		}
+	}
+	return "No Status"
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/h0rv/ghp/internal/cache"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/filter"
+	"github.com/h0rv/ghp/internal/store"
+	"github.com/spf13/cobra"
+)
+
+// itemQuery selects which items `ghp items` prints. Empty fields match everything.
+type itemQuery struct {
+	status   string // Grouping field option name
+	assignee string // Assignee login, "@me" for the viewer
+	label    string // Label name
+	repo     string // Repository (owner/name)
+	search   string // Words matched against title and repo#number
+}
+
+// itemOutput is one item in the --json output of `ghp items`.
+type itemOutput struct {
+	Type      string   `json:"type"`
+	Repo      string   `json:"repo,omitempty"`
+	Number    int      `json:"number,omitempty"`
+	Title     string   `json:"title"`
+	Status    string   `json:"status"`
+	State     string   `json:"state,omitempty"`
+	Assignees []string `json:"assignees"`
+	Labels    []string `json:"labels,omitempty"`
+	URL       string   `json:"url,omitempty"`
+	ItemID    string   `json:"itemId"`
+}
+
+// newItemsCmd creates the `ghp items` subcommand, a filterable item listing.
+func newItemsCmd() *cobra.Command {
+	var (
+		query    itemQuery
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "items",
+		Short: "List project items matching filters",
+		Long: `List a project's items with their column, assignees, and labels.
+
+Every item is fetched and the filters are applied locally, so they can be
+combined freely. --label fetches item details as well, which costs an extra
+request per 50 items.`,
+		Example: `  ghp items --owner myorg --project 1 --status "In Progress" --assignee @me
+  ghp items --owner myorg --project 1 --label bug --repo myorg/api --json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireProjectFlags(); err != nil {
+				return err
+			}
+			client, err := newClient()
+			if err != nil {
+				return err
+			}
+			entry, err := fetchSnapshot(cmd.Context(), client)
+			if err != nil {
+				return err
+			}
+			if query.label != "" || jsonFlag {
+				if err := loadItemDetails(cmd.Context(), client, entry.Cards); err != nil {
+					return err
+				}
+			}
+
+			cards, err := matchItems(entry, query)
+			if err != nil {
+				return err
+			}
+			if jsonFlag {
+				return printItemsJSON(cmd.OutOrStdout(), entry, cards)
+			}
+			return printItems(cmd.OutOrStdout(), entry, cards)
+		},
+	}
+
+	cmd.Flags().StringVar(&query.status, "status", "", "Only items in this column of the grouping field")
+	cmd.Flags().StringVar(&query.assignee, "assignee", "", "Only items assigned to this login (@me for yourself)")
+	cmd.Flags().StringVar(&query.label, "label", "", "Only items with this label")
+	cmd.Flags().StringVar(&query.repo, "repo", "", "Only items in this repository (owner/name)")
+	cmd.Flags().StringVar(&query.search, "search", "", "Only items whose title or repo#number contains these words")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Print as JSON, including labels, URLs, and item IDs")
+
+	return cmd
+}
+
+// matchItems returns the cards matching q in project order.
+// Returns an error if q names a column that does not exist or @me can't be resolved.
+func matchItems(entry *cache.Entry, q itemQuery) ([]domain.Card, error) {
+	columnID := ""
+	if q.status != "" && !strings.EqualFold(q.status, "No Status") {
+		opt, err := store.FindOption(&entry.GroupField, q.status)
+		if err != nil {
+			return nil, err
+		}
+		columnID = opt.ID
+	}
+
+	assignee := q.assignee
+	if assignee == "@me" {
+		if entry.Viewer == "" {
+			return nil, fmt.Errorf("could not determine the signed-in user for --assignee @me")
+		}
+		assignee = entry.Viewer
+	}
+
+	search := filter.New(q.search, foldDiacritics)
+
+	var matches []domain.Card
+	for _, card := range entry.Cards {
+		if q.status != "" && card.GroupOptionID != columnID {
+			continue
+		}
+		if assignee != "" && !assignedTo(card.Assignees, assignee) {
+			continue
+		}
+		if q.label != "" && !containsFold(card.Labels, q.label) {
+			continue
+		}
+		if q.repo != "" && !strings.EqualFold(card.Repo, q.repo) {
+			continue
+		}
+		if !search.Empty() && !search.Match(card.Title+" "+itemRef(card)) {
+			continue
+		}
+		matches = append(matches, card)
+	}
+	return matches, nil
+}
+
+// itemRef returns "owner/repo#123" for issues and PRs, or the content type otherwise.
+func itemRef(card domain.Card) string {
+	if card.Repo != "" && card.Number > 0 {
+		return fmt.Sprintf("%s#%d", card.Repo, card.Number)
+	}
+	return card.ContentType
+}
+
+// columnName returns the name of a card's column in the grouping field.
+func columnName(entry *cache.Entry, card domain.Card) string {
+	for _, opt := range entry.GroupField.Options {
+		if opt.ID == card.GroupOptionID {
+			return opt.Name
+		}
+	}
+	return "No Status"
+}
+
+// printItems lists items as an aligned table.
+func printItems(out io.Writer, entry *cache.Entry, cards []domain.Card) error {
+	if len(cards) == 0 {
+		fmt.Fprintln(out, "No matching items.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "ITEM\t%s\tASSIGNEES\tTITLE\n", strings.ToUpper(entry.GroupField.Name))
+	for _, card := range cards {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", itemRef(card), columnName(entry, card), strings.Join(card.Assignees, ","), card.Title)
+	}
+	return w.Flush()
+}
+
+// printItemsJSON writes items as an indented JSON array.
+func printItemsJSON(out io.Writer, entry *cache.Entry, cards []domain.Card) error {
+	result := make([]itemOutput, 0, len(cards))
+	for _, card := range cards {
+		assignees := card.Assignees
+		if assignees == nil {
+			assignees = []string{}
+		}
+		result = append(result, itemOutput{
+			Type:      card.ContentType,
+			Repo:      card.Repo,
+			Number:    card.Number,
+			Title:     card.Title,
+			Status:    columnName(entry, card),
+			State:     card.State,
+			Assignees: assignees,
+			Labels:    card.Labels,
+			URL:       card.URL,
+			ItemID:    card.ItemID,
+		})
+	}
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(result)
+}
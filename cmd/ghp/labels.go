@@ -0,0 +1,520 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/h0rv/ghp/internal/cache"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/gh"
+	"github.com/h0rv/ghp/internal/store"
+	"github.com/spf13/cobra"
+)
+
+// labelFilter selects which board items a bulk label operation applies to.
+type labelFilter struct {
+	text   string // Case-insensitive title substring
+	column string // Grouping field option name
+	label  string // Existing label the item must have
+	repo   string // Repository (owner/name)
+}
+
+// newLabelsCmd creates the `ghp labels` maintenance command and its subcommands.
+func newLabelsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "labels",
+		Short: "List and bulk-edit labels on project items",
+		Long: `List labels used across project items, or add, remove, and rename a label
+on every issue and PR matching a filter. Changes are sent in batches, and each
+item's outcome is printed afterward.
+
+Before changing more than 3 items, the matching items are listed and ghp asks
+for confirmation. Without a terminal to ask on, pass --yes.`,
+		Example: `  ghp labels --owner myorg --project 1
+  ghp labels add needs-triage --column Todo --owner myorg --project 1 --dry-run
+  ghp labels rename bug type:bug --owner myorg --project 1 --yes`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireProjectFlags(); err != nil {
+				return err
+			}
+			client, err := newClient()
+			if err != nil {
+				return err
+			}
+			entry, err := fetchSnapshot(cmd.Context(), client)
+			if err != nil {
+				return err
+			}
+			if err := loadItemDetails(cmd.Context(), client, entry.Cards); err != nil {
+				return err
+			}
+			printLabelCounts(cmd.OutOrStdout(), entry.Cards)
+			return nil
+		},
+	}
+
+	cmd.AddCommand(newLabelChangeCmd("add", "Add a label to matching items"))
+	cmd.AddCommand(newLabelChangeCmd("remove", "Remove a label from matching items"))
+	cmd.AddCommand(newLabelRenameCmd())
+
+	return cmd
+}
+
+// newLabelChangeCmd creates the `labels add` or `labels remove` subcommand.
+func newLabelChangeCmd(action, short string) *cobra.Command {
+	var (
+		filter     labelFilter
+		dryRunFlag bool
+		yesFlag    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   action + " <label>",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireProjectFlags(); err != nil {
+				return err
+			}
+			confirm := newBulkConfirm(cmd, yesFlag)
+			return runLabelChange(cmd.Context(), progressOut(cmd), confirm, action, args[0], filter, dryRunFlag)
+		},
+	}
+
+	addLabelFilterFlags(cmd, &filter, true)
+	addBulkFlags(cmd, &dryRunFlag, &yesFlag)
+
+	return cmd
+}
+
+// newLabelRenameCmd creates `labels rename`, which moves items from one label to another.
+func newLabelRenameCmd() *cobra.Command {
+	var (
+		filter     labelFilter
+		dryRunFlag bool
+		yesFlag    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "rename <old> <new>",
+		Short: "Replace a label with another on matching items",
+		Long: `Add <new> to every matching item labeled <old>, then remove <old> from the
+items that have <new>. The <new> label must already exist in each affected
+repository; items in repositories without it keep <old>.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireProjectFlags(); err != nil {
+				return err
+			}
+			filter.label = args[0]
+			confirm := newBulkConfirm(cmd, yesFlag)
+			return runLabelRename(cmd.Context(), progressOut(cmd), confirm, args[0], args[1], filter, dryRunFlag)
+		},
+	}
+
+	addLabelFilterFlags(cmd, &filter, false)
+	addBulkFlags(cmd, &dryRunFlag, &yesFlag)
+
+	return cmd
+}
+
+// addLabelFilterFlags registers the shared item filter flags on cmd.
+// withLabel controls whether the --label flag is offered.
+func addLabelFilterFlags(cmd *cobra.Command, filter *labelFilter, withLabel bool) {
+	cmd.Flags().StringVar(&filter.text, "filter", "", "Only items whose title contains this text")
+	cmd.Flags().StringVar(&filter.column, "column", "", "Only items in this column")
+	cmd.Flags().StringVar(&filter.repo, "repo", "", "Only items in this repository (owner/name)")
+	if withLabel {
+		cmd.Flags().StringVar(&filter.label, "label", "", "Only items that already have this label")
+	}
+}
+
+// addBulkFlags registers the flags that preview or confirm a bulk change.
+func addBulkFlags(cmd *cobra.Command, dryRun, yes *bool) {
+	cmd.Flags().BoolVar(dryRun, "dry-run", false, "List matching items without changing them")
+	cmd.Flags().BoolVarP(yes, "yes", "y", false, fmt.Sprintf("Change more than %d items without asking", bulkConfirmMin))
+}
+
+// bulkConfirmMin is the most items a bulk change touches without asking,
+// as on the board.
+const bulkConfirmMin = 3
+
+// bulkConfirm asks on the terminal before a bulk change touches more than
+// bulkConfirmMin items.
+type bulkConfirm struct {
+	in          io.Reader
+	out         io.Writer // Where the preview and question go
+	interactive bool      // Whether in is a terminal to ask on
+	yes         bool      // --yes: change without asking
+}
+
+func newBulkConfirm(cmd *cobra.Command, yes bool) bulkConfirm {
+	interactive := false
+	if info, err := os.Stdin.Stat(); err == nil {
+		interactive = info.Mode()&os.ModeCharDevice != 0
+	}
+	return bulkConfirm{in: cmd.InOrStdin(), out: cmd.ErrOrStderr(), interactive: interactive, yes: yes}
+}
+
+// ask lists cards and asks whether to apply what to them, reporting whether
+// to go ahead. Without a terminal it refuses unless --yes was given.
+func (c bulkConfirm) ask(what string, cards []domain.Card) (bool, error) {
+	if len(cards) <= bulkConfirmMin || c.yes {
+		return true, nil
+	}
+	if !c.interactive {
+		return false, usageError(fmt.Errorf("%s would change %d items; pass --yes to confirm without a terminal", what, len(cards)))
+	}
+
+	fmt.Fprintf(c.out, "%s on %d items:\n", what, len(cards))
+	for _, card := range cards {
+		fmt.Fprintf(c.out, "  %s#%d %s\n", card.Repo, card.Number, card.Title)
+	}
+	fmt.Fprint(c.out, "Continue? [y/N] ")
+	answer, _ := bufio.NewReader(c.in).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	}
+	fmt.Fprintln(c.out, "Nothing changed.")
+	return false, nil
+}
+
+// printLabelOutcome prints whether a bulk label change reached one item:
+// done for a change that was made, or why it wasn't.
+func printLabelOutcome(out io.Writer, done, why string, card domain.Card) {
+	if why == "" {
+		fmt.Fprintf(out, "%s: %s#%d %s\n", done, card.Repo, card.Number, card.Title)
+		return
+	}
+	fmt.Fprintf(out, "not %s: %s#%d %s (%s)\n", done, card.Repo, card.Number, card.Title, why)
+}
+
+// sentCount returns how many of n label changes were made before err.
+func sentCount(n int, err error) int {
+	var partial *gh.PartialError
+	switch {
+	case err == nil:
+		return n
+	case errors.As(err, &partial):
+		return partial.Done
+	}
+	return 0
+}
+
+// loadLabelSnapshot fetches the project's items with their labels.
+func loadLabelSnapshot(ctx context.Context, client *gh.Client) (*cache.Entry, error) {
+	entry, err := fetchSnapshot(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if err := loadItemDetails(ctx, client, entry.Cards); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// labelRepos returns the repositories of cards, in order of first appearance.
+func labelRepos(cards []domain.Card) []string {
+	repos := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, card := range cards {
+		if !seen[card.Repo] {
+			seen[card.Repo] = true
+			repos = append(repos, card.Repo)
+		}
+	}
+	return repos
+}
+
+// runLabelChange adds or removes a label on all items matching filter.
+func runLabelChange(ctx context.Context, out io.Writer, confirm bulkConfirm, action, label string, filter labelFilter, dryRun bool) error {
+	client, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	entry, err := loadLabelSnapshot(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	matches, err := matchLabelTargets(entry, filter, action, label)
+	if err != nil {
+		return err
+	}
+	if len(matches) == 0 {
+		fmt.Fprintf(out, "%s %q: no matching items\n", action, label)
+		return nil
+	}
+
+	if dryRun {
+		for _, card := range matches {
+			fmt.Fprintf(out, "would %s %q: %s#%d %s\n", action, label, card.Repo, card.Number, card.Title)
+		}
+		fmt.Fprintf(out, "%d items (dry run, nothing changed)\n", len(matches))
+		return nil
+	}
+	if ok, err := confirm.ask(fmt.Sprintf("%s %q", action, label), matches); !ok {
+		return err
+	}
+
+	repos := labelRepos(matches)
+	labelIDs, err := client.GetLabelIDs(ctx, repos, label)
+	if err != nil {
+		return err
+	}
+
+	var changes []gh.LabelChange
+	var changed []domain.Card
+	var missing []string
+	for _, card := range matches {
+		labelID, ok := labelIDs[card.Repo]
+		if !ok {
+			continue
+		}
+		changes = append(changes, gh.LabelChange{ContentID: card.ContentID, LabelID: labelID})
+		changed = append(changed, card)
+	}
+	for _, repo := range repos {
+		if _, ok := labelIDs[repo]; !ok {
+			missing = append(missing, repo)
+		}
+	}
+
+	if action == "add" {
+		err = client.AddLabels(ctx, changes)
+	} else {
+		err = client.RemoveLabels(ctx, changes)
+	}
+	// Earlier batches may have been applied even when a later one failed
+	sent := sentCount(len(changes), err)
+
+	// The snapshot we just cached no longer reflects the labels
+	_ = cache.Clear(entry.Project.Owner, entry.Project.Number)
+
+	done := fmt.Sprintf("removed %q", label)
+	if action == "add" {
+		done = fmt.Sprintf("added %q", label)
+	}
+	for i, card := range changed {
+		why := ""
+		if i >= sent {
+			why = "request failed"
+		}
+		printLabelOutcome(out, done, why, card)
+	}
+	for _, card := range matches {
+		if _, ok := labelIDs[card.Repo]; !ok {
+			printLabelOutcome(out, done, "no such label in "+card.Repo, card)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "%s %q: %d items updated\n", action, label, len(changes))
+	if len(missing) > 0 {
+		return &gh.PartialError{
+			Done:  len(changes),
+			Total: len(matches),
+			Err:   fmt.Errorf("label %q does not exist in: %s", label, strings.Join(missing, ", ")),
+		}
+	}
+	return nil
+}
+
+// runLabelRename replaces oldLabel with newLabel on all items matching filter.
+// Both labels are resolved before anything changes, and oldLabel is removed
+// only from items that have newLabel once the additions are done.
+func runLabelRename(ctx context.Context, out io.Writer, confirm bulkConfirm, oldLabel, newLabel string, filter labelFilter, dryRun bool) error {
+	client, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	entry, err := loadLabelSnapshot(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	targets, err := matchLabelTargets(entry, filter, "remove", oldLabel)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		fmt.Fprintf(out, "rename %q: no matching items\n", oldLabel)
+		return nil
+	}
+
+	if dryRun {
+		for _, card := range targets {
+			fmt.Fprintf(out, "would rename %q to %q: %s#%d %s\n", oldLabel, newLabel, card.Repo, card.Number, card.Title)
+		}
+		fmt.Fprintf(out, "%d items (dry run, nothing changed)\n", len(targets))
+		return nil
+	}
+	if ok, err := confirm.ask(fmt.Sprintf("rename %q to %q", oldLabel, newLabel), targets); !ok {
+		return err
+	}
+
+	repos := labelRepos(targets)
+	newIDs, err := client.GetLabelIDs(ctx, repos, newLabel)
+	if err != nil {
+		return err
+	}
+	oldIDs, err := client.GetLabelIDs(ctx, repos, oldLabel)
+	if err != nil {
+		return err
+	}
+
+	// Items in repositories without the new label keep the old one
+	var missing []string
+	for _, repo := range repos {
+		if _, ok := newIDs[repo]; !ok {
+			missing = append(missing, repo)
+		}
+	}
+	why := make(map[string]string, len(targets)) // ContentID -> why it wasn't renamed
+	var adds []gh.LabelChange
+	var added, hasNew []domain.Card
+	for _, card := range targets {
+		newID, ok := newIDs[card.Repo]
+		switch {
+		case !ok:
+			why[card.ContentID] = "no such label in " + card.Repo
+		case containsFold(card.Labels, newLabel):
+			hasNew = append(hasNew, card)
+		default:
+			adds = append(adds, gh.LabelChange{ContentID: card.ContentID, LabelID: newID})
+			added = append(added, card)
+		}
+	}
+
+	// When the additions stop partway, only the batches sent have the new label
+	addErr := client.AddLabels(ctx, adds)
+	sent := sentCount(len(added), addErr)
+	for _, card := range added[sent:] {
+		why[card.ContentID] = "request failed"
+	}
+	hasNew = append(hasNew, added[:sent]...)
+
+	var removes []gh.LabelChange
+	var removed []domain.Card
+	for _, card := range hasNew {
+		if oldID, ok := oldIDs[card.Repo]; ok {
+			removes = append(removes, gh.LabelChange{ContentID: card.ContentID, LabelID: oldID})
+			removed = append(removed, card)
+		} else {
+			why[card.ContentID] = "it has both labels"
+		}
+	}
+	removeErr := client.RemoveLabels(ctx, removes)
+	for _, card := range removed[sentCount(len(removed), removeErr):] {
+		why[card.ContentID] = "request failed; it has both labels"
+	}
+
+	// The snapshot we just cached no longer reflects the labels
+	_ = cache.Clear(entry.Project.Owner, entry.Project.Number)
+
+	done := fmt.Sprintf("renamed %q to %q", oldLabel, newLabel)
+	for _, card := range targets {
+		printLabelOutcome(out, done, why[card.ContentID], card)
+	}
+	switch {
+	case addErr != nil:
+		return addErr
+	case removeErr != nil:
+		return removeErr
+	}
+	fmt.Fprintf(out, "rename %q to %q: %d items updated\n", oldLabel, newLabel, len(removes))
+
+	if len(missing) > 0 {
+		return &gh.PartialError{
+			Done:  len(removes),
+			Total: len(targets),
+			Err:   fmt.Errorf("label %q does not exist in: %s", newLabel, strings.Join(missing, ", ")),
+		}
+	}
+	return nil
+}
+
+// matchLabelTargets returns issues and PRs that match filter and would change.
+// Items that already have the label (add) or lack it (remove) are skipped.
+// Returns an error if filter names a column that does not exist.
+func matchLabelTargets(entry *cache.Entry, filter labelFilter, action, label string) ([]domain.Card, error) {
+	columnID := ""
+	if filter.column != "" {
+		opt, err := store.FindOption(&entry.GroupField, filter.column)
+		if err != nil {
+			return nil, err
+		}
+		columnID = opt.ID
+	}
+
+	var matches []domain.Card
+	for _, card := range entry.Cards {
+		if card.ContentType != domain.ContentTypeIssue && card.ContentType != domain.ContentTypePullRequest {
+			continue
+		}
+		if card.ContentID == "" || card.Repo == "" {
+			continue
+		}
+		if filter.text != "" && !strings.Contains(strings.ToLower(card.Title), strings.ToLower(filter.text)) {
+			continue
+		}
+		if filter.column != "" && card.GroupOptionID != columnID {
+			continue
+		}
+		if filter.label != "" && !containsFold(card.Labels, filter.label) {
+			continue
+		}
+		if filter.repo != "" && !strings.EqualFold(card.Repo, filter.repo) {
+			continue
+		}
+
+		has := containsFold(card.Labels, label)
+		if (action == "add" && has) || (action == "remove" && !has) {
+			continue
+		}
+		matches = append(matches, card)
+	}
+
+	return matches, nil
+}
+
+// printLabelCounts prints each label used across items with its item count, most used first.
+func printLabelCounts(out io.Writer, cards []domain.Card) {
+	counts := make(map[string]int)
+	for _, card := range cards {
+		for _, l := range card.Labels {
+			counts[l]++
+		}
+	}
+
+	labels := make([]string, 0, len(counts))
+	for l := range counts {
+		labels = append(labels, l)
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		if counts[labels[i]] != counts[labels[j]] {
+			return counts[labels[i]] > counts[labels[j]]
+		}
+		return labels[i] < labels[j]
+	})
+
+	if len(labels) == 0 {
+		fmt.Fprintln(out, "No labels in use.")
+		return
+	}
+	for _, l := range labels {
+		fmt.Fprintf(out, "%5d  %s\n", counts[l], l)
+	}
+}
@@ -2,60 +2,184 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"strconv"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/h0rv/ghp/internal/gh"
+	"github.com/h0rv/ghp/internal/gitrepo"
+	"github.com/h0rv/ghp/internal/session"
 	"github.com/h0rv/ghp/internal/store"
 	"github.com/h0rv/ghp/internal/tui"
+	"github.com/h0rv/ghp/internal/uistate"
+	"github.com/h0rv/ghp/internal/workspace"
 	"github.com/spf13/cobra"
 )
 
 var (
 	// CLI flags
-	ownerFlag      string
-	projectFlag    int
-	groupFieldFlag string
+	ownerFlag       string
+	projectFlag     int
+	groupFieldFlag  string
+	recordFlag      string
+	reducedMotion   bool
+	scrollbarFlag   bool
+	foldDiacritics  bool
+	workspaceFlag   string
+	teamFlag        string
+	pageSizeFlag    int
+	maxPagesFlag    int
+	maxItemsFlag    int
+	quietFlag       bool
+	staleDaysFlag   int
+	resumeFlag      bool
+	autoRefreshFlag int
+	altGroupFlag    string
+
+	// Problems found while reading GHP_* environment variables
+	envWarnings []string
 )
 
+// minAutoRefresh is the shortest auto-refresh interval in seconds, which keeps
+// large boards well inside the API rate limit
+const minAutoRefresh = 10
+
 func main() {
 	rootCmd := &cobra.Command{
 		Use:   "ghp",
 		Short: "Terminal UI for GitHub Projects v2",
+		Annotations: map[string]string{
+			cobra.CommandDisplayNameAnnotation: commandName(),
+		},
 		Long: `ghp is a terminal user interface for GitHub Projects v2.
 
 Interactive kanban board with keyboard navigation for managing issues and PRs.
 
 Authentication:
   1. GitHub CLI: Run 'gh auth login' (preferred)
-  2. Environment variable: Set GITHUB_TOKEN
+  2. Environment variable: Set GH_TOKEN or GITHUB_TOKEN
+
+Set GH_HOST to use a GitHub Enterprise Server host.
+The token must have read/write access to projects.
 
-The token must have read/write access to projects.`,
+Defaults for --owner, --project, --group-field, --alt-group-field,
+--page-size, and display flags can be kept in ~/.config/ghp/config.json, with a project per
+repository under "Repos". Flags and GHP_* variables override it.
+
+--resume reopens the board open when ghp last exited, or the project
+picker when that project is gone.
+
+Exit codes:
+  0  Success
+  1  Other error
+  2  Invalid flags or arguments
+  3  Authentication failed or SAML SSO authorization needed
+  4  Owner, project, item, or workspace not found
+  5  GitHub API rate limit exceeded
+  6  Bulk operation only partially applied`,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			applySettings(cmd)
+			loadTemplates()
+			if quietFlag {
+				cmd.SilenceUsage = true
+			} else {
+				printConfigWarnings(cmd.ErrOrStderr())
+			}
+			if err := fetchBudget().Validate(); err != nil {
+				return usageError(err)
+			}
+			if staleDaysFlag < 0 {
+				return usageError(fmt.Errorf("--stale-days must not be negative, got %d", staleDaysFlag))
+			}
+			if autoRefreshFlag < 0 || (autoRefreshFlag > 0 && autoRefreshFlag < minAutoRefresh) {
+				return usageError(fmt.Errorf("--auto-refresh must be 0 or at least %d seconds, got %d", minAutoRefresh, autoRefreshFlag))
+			}
+			return nil
+		},
 		RunE: run,
 	}
 
-	// Define CLI flags
-	rootCmd.Flags().StringVar(&ownerFlag, "owner", "", "GitHub owner (organization or user login). Skips owner prompt.")
-	rootCmd.Flags().IntVar(&projectFlag, "project", 0, "Project number. Requires --owner. Skips project picker.")
-	rootCmd.Flags().StringVar(&groupFieldFlag, "group-field", "", "Field name to group by. Skips field picker.")
+	// Define CLI flags (shared with subcommands)
+	rootCmd.PersistentFlags().StringVar(&ownerFlag, "owner", "", "GitHub owner (organization or user login). Skips owner prompt.")
+	rootCmd.PersistentFlags().IntVar(&projectFlag, "project", 0, "Project number. Requires --owner. Skips project picker.")
+	rootCmd.PersistentFlags().StringVar(&groupFieldFlag, "group-field", "", "Field name or ID to group by. Skips field picker.")
+	rootCmd.PersistentFlags().BoolVarP(&quietFlag, "quiet", "q", false, "Only print requested data and errors, for scripts")
+	rootCmd.PersistentFlags().IntVar(&pageSizeFlag, "page-size", envInt("GHP_PAGE_SIZE", gh.MaxPageSize), "Items fetched per request, 1-100 (env: GHP_PAGE_SIZE)")
+	rootCmd.Flags().IntVar(&maxPagesFlag, "max-pages", envInt("GHP_MAX_PAGES", 0), "Stop loading the board after this many pages, 0 for no limit (env: GHP_MAX_PAGES)")
+	rootCmd.Flags().IntVar(&maxItemsFlag, "max-items", envInt("GHP_MAX_ITEMS", 0), "Stop loading the board after this many items, 0 for no limit (env: GHP_MAX_ITEMS)")
+	rootCmd.Flags().IntVar(&staleDaysFlag, "stale-days", envInt("GHP_STALE_DAYS", 0), "Dim cards not updated in this many days, 0 to never dim; also the age w sweeps Done items from, 30 days when 0 (env: GHP_STALE_DAYS)")
+	rootCmd.Flags().IntVar(&autoRefreshFlag, "auto-refresh", envInt("GHP_AUTO_REFRESH", 0), "Refetch the board every this many seconds and highlight what others changed, 0 to never (env: GHP_AUTO_REFRESH)")
+	rootCmd.Flags().StringVar(&altGroupFlag, "alt-group-field", os.Getenv("GHP_ALT_GROUP_FIELD"), "Field name or ID the board switches to and back from with F (env: GHP_ALT_GROUP_FIELD)")
+	rootCmd.Flags().StringVar(&workspaceFlag, "workspace", "", "Open a saved workspace (see 'ghp workspace')")
+	rootCmd.Flags().StringVar(&teamFlag, "team", "", "Only show items assigned to members of an org team (slug or org/slug)")
+	rootCmd.Flags().BoolVar(&resumeFlag, "resume", os.Getenv("GHP_RESUME") != "", "Reopen the board used last, unless --owner, --project, or --workspace is given (env: GHP_RESUME)")
+	rootCmd.Flags().StringVar(&recordFlag, "record", "", "Record board state transitions to a file for 'ghp replay'")
+	rootCmd.Flags().BoolVar(&reducedMotion, "reduced-motion", os.Getenv("GHP_REDUCED_MOTION") != "", "Show static loading text instead of spinners (env: GHP_REDUCED_MOTION)")
+	rootCmd.Flags().BoolVar(&scrollbarFlag, "scrollbar", os.Getenv("GHP_SCROLLBAR") != "", "Show each column's scroll position as a scrollbar instead of \"N more\" lines (env: GHP_SCROLLBAR)")
+	rootCmd.Flags().BoolVar(&foldDiacritics, "ignore-diacritics", os.Getenv("GHP_IGNORE_DIACRITICS") != "", "Filter matches ignore accents, e.g. \"resume\" matches \"résumé\" (env: GHP_IGNORE_DIACRITICS)")
+
+	// Subcommands
+	rootCmd.AddCommand(newOpenCmd())
+	rootCmd.AddCommand(newMoveCmd())
+	rootCmd.AddCommand(newStatusCmd())
+	rootCmd.AddCommand(newImportCmd())
+	rootCmd.AddCommand(newLabelsCmd())
+	rootCmd.AddCommand(newReplayCmd())
+	rootCmd.AddCommand(newWorkspaceCmd())
+	rootCmd.AddCommand(newFieldsCmd())
+	rootCmd.AddCommand(newProjectsCmd())
+	rootCmd.AddCommand(newItemsCmd())
+	rootCmd.AddCommand(newWatchCmd())
+	rootCmd.AddCommand(newRenderCmd())
+	rootCmd.AddCommand(newDigestCmd())
+	rootCmd.AddCommand(newBenchCmd())
+
+	rootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return usageError(err)
+	})
 
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		var sso *gh.SSORequiredError
+		if errors.As(err, &sso) {
+			if url := sso.AuthorizationURL(ownerFlag); url != "" {
+				fmt.Fprintf(os.Stderr, "Authorize your token for SAML SSO: %s\n", url)
+			}
+		}
+		os.Exit(exitCode(err))
 	}
 }
 
 func run(cmd *cobra.Command, args []string) error {
+	// A workspace supplies defaults for the project flags
+	var ws *workspace.Workspace
+	if workspaceFlag != "" {
+		var err error
+		if ws, err = applyWorkspaceFlags(cmd, workspaceFlag); err != nil {
+			return err
+		}
+	}
+
+	// The last board stands in for flags that weren't given
+	resumed := false
+	if resumeFlag && ws == nil && !cmd.Flags().Changed("owner") && !cmd.Flags().Changed("project") {
+		resumed = resumeLast(cmd)
+	}
+
 	// Validate flags
 	if projectFlag != 0 && ownerFlag == "" {
-		return fmt.Errorf("--project requires --owner to be specified")
+		return usageError(fmt.Errorf("--project requires --owner to be specified"))
 	}
 
 	// Create GitHub client (handles authentication)
-	client, err := gh.New()
+	client, err := newClient()
 	if err != nil {
-		return fmt.Errorf("failed to create GitHub client: %w\n\nPlease authenticate using:\n  gh auth login\nor set the GITHUB_TOKEN environment variable", err)
+		return err
 	}
 
 	// Create store
@@ -65,13 +189,130 @@ func run(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
 	// Create app model
-	app := tui.NewAppModel(client, s, ctx, ownerFlag, projectFlag, groupFieldFlag)
+	app := tui.NewAppModel(client, s, ctx, ownerFlag, projectFlag, groupFieldFlag).
+		WithReducedMotion(reducedMotion).
+		WithScrollbar(scrollbarFlag).
+		WithAltGroupField(altGroupFlag).
+		WithDiacriticFolding(foldDiacritics).
+		WithWorkspace(ws).
+		WithTeam(teamFlag).
+		WithFetchBudget(fetchBudget()).
+		WithStaleAfter(time.Duration(staleDaysFlag) * 24 * time.Hour).
+		WithAutoRefresh(time.Duration(autoRefreshFlag) * time.Second).
+		WithStatusBar(statusSegments).
+		WithAccents(accents).
+		WithPresets(presets).
+		WithTemplates(templates)
+	app = app.WithColumnWidths(minColumnWidth, maxColumnWidth)
+	if resumed {
+		app = app.WithResume()
+	}
+	// Inside a clone, offer the repository's projects before the owner picker
+	if ownerFlag == "" {
+		if repo, err := gitrepo.Origin("."); err == nil {
+			app = app.WithRepo(repo)
+		}
+	}
+
+	// Optionally record the session for later replay
+	if recordFlag != "" {
+		recorder, err := session.NewRecorder(recordFlag)
+		if err != nil {
+			return err
+		}
+		defer recorder.Close()
+		app = app.WithRecorder(recorder)
+	}
 
 	// Run Bubble Tea program
-	p := tea.NewProgram(app, tea.WithAltScreen())
-	if _, err := p.Run(); err != nil {
+	p := tea.NewProgram(app, tea.WithAltScreen(), tea.WithReportFocus(), tea.WithMouseCellMotion())
+	final, err := p.Run()
+	if final, ok := final.(tui.AppModel); ok {
+		final.Close()
+		saveLast(cmd, final)
+	}
+	if err != nil {
 		return fmt.Errorf("program error: %w", err)
 	}
 
 	return nil
 }
+
+// resumeLast points the project flags at the last board and reports whether
+// there was one. Problems reading it are warnings; the pickers still work.
+func resumeLast(cmd *cobra.Command) bool {
+	last, problems, err := uistate.LoadLast()
+	if err != nil {
+		printWarning(cmd, fmt.Sprintf("%v; not resuming", err))
+		return false
+	}
+	for _, p := range problems {
+		printWarning(cmd, p.String())
+	}
+	if last == nil {
+		return false
+	}
+	ownerFlag, projectFlag = last.Owner, last.Project
+	if !cmd.Flags().Changed("group-field") {
+		groupFieldFlag = last.GroupField
+	}
+	return true
+}
+
+// saveLast remembers the board the session ended on for --resume
+func saveLast(cmd *cobra.Command, app tui.AppModel) {
+	owner, number, groupField, ok := app.CurrentBoard()
+	if !ok {
+		return
+	}
+	if err := uistate.SaveLast(uistate.Last{Owner: owner, Project: number, GroupField: groupField}); err != nil {
+		printWarning(cmd, err.Error())
+	}
+}
+
+// printWarning reports a problem that doesn't stop the command, unless --quiet is set
+func printWarning(cmd *cobra.Command, msg string) {
+	if !quietFlag {
+		fmt.Fprintf(cmd.ErrOrStderr(), "warning: %s\n", msg)
+	}
+}
+
+// fetchBudget returns the item loading limits set by flags.
+func fetchBudget() gh.FetchBudget {
+	return gh.FetchBudget{PageSize: pageSizeFlag, MaxPages: maxPagesFlag, MaxItems: maxItemsFlag}
+}
+
+// envInt returns the integer value of an environment variable, or def when unset or invalid.
+// Invalid values are recorded in envWarnings.
+func envInt(name string, def int) int {
+	value, ok := os.LookupEnv(name)
+	if !ok || value == "" {
+		return def
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		envWarnings = append(envWarnings, fmt.Sprintf("%s=%q is not a whole number; using %d", name, value, def))
+		return def
+	}
+	return n
+}
+
+// printConfigWarnings reports settings ghp ignored or replaced with defaults,
+// so a typo doesn't go unnoticed
+func printConfigWarnings(w io.Writer) {
+	for _, msg := range envWarnings {
+		fmt.Fprintf(w, "warning: %s\n", msg)
+	}
+	for _, msg := range settingsWarnings {
+		fmt.Fprintf(w, "warning: %s\n", msg)
+	}
+
+	problems, err := workspace.Check()
+	if err != nil {
+		fmt.Fprintf(w, "warning: %v; ignoring saved workspaces\n", err)
+		return
+	}
+	for _, p := range problems {
+		fmt.Fprintf(w, "warning: %s\n", p)
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/h0rv/ghp/internal/cache"
+	"github.com/h0rv/ghp/internal/gh"
+	"github.com/h0rv/ghp/internal/store"
+	"github.com/spf13/cobra"
+)
+
+// newMoveCmd creates the `ghp move` subcommand, which moves an item to another column.
+func newMoveCmd() *cobra.Command {
+	var (
+		itemFlag int
+		repoFlag string
+		toFlag   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "move",
+		Short: "Move a project item to another column",
+		Long: `Set an item's grouping field to the option named by --to.
+
+--to takes an option name or ID. Case, spaces, and punctuation are ignored,
+and a unique prefix or substring is enough ("prog" for "In Progress").
+"No Status" clears the field.`,
+		Example: `  ghp move --owner myorg --project 1 --item 42 --to done
+  ghp move --owner myorg --project 1 --item 42 --repo myorg/api --to "in review"`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireProjectFlags(); err != nil {
+				return err
+			}
+			if itemFlag <= 0 {
+				return usageError(fmt.Errorf("--item must be a positive issue or PR number"))
+			}
+			if strings.TrimSpace(toFlag) == "" {
+				return usageError(fmt.Errorf("--to is required"))
+			}
+			return runMove(cmd.Context(), progressOut(cmd), itemFlag, repoFlag, toFlag)
+		},
+	}
+
+	cmd.Flags().IntVar(&itemFlag, "item", 0, "Issue or PR number to move")
+	cmd.Flags().StringVar(&repoFlag, "repo", "", "Repository (owner/name) to disambiguate items with the same number")
+	cmd.Flags().StringVar(&toFlag, "to", "", "Column (option name or ID) to move the item to")
+
+	return cmd
+}
+
+// runMove finds the item and sets its grouping field to the option named by to.
+func runMove(ctx context.Context, out io.Writer, number int, repo, to string) error {
+	client, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	entry, err := fetchSnapshot(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	card, err := findItem(entry.Cards, number, repo)
+	if err != nil {
+		return err
+	}
+
+	optionID, name := "", "No Status"
+	if !strings.EqualFold(strings.TrimSpace(to), "No Status") {
+		opt, err := store.FindOption(&entry.GroupField, to)
+		if err != nil {
+			return usageError(err)
+		}
+		optionID, name = opt.ID, opt.Name
+	}
+
+	if card.GroupOptionID == optionID {
+		fmt.Fprintf(out, "%s#%d is already in %s\n", card.Repo, card.Number, name)
+		return nil
+	}
+	if optionID == "" {
+		err = client.ClearItemField(ctx, entry.Project.ID, card.ItemID, entry.GroupField.ID)
+	} else {
+		err = client.UpdateItemField(ctx, entry.Project.ID, card.ItemID, entry.GroupField.ID, gh.SingleSelectValue(optionID))
+	}
+	if err != nil {
+		return err
+	}
+
+	// Keep the cached snapshot in step for the next invocation
+	card.GroupOptionID = optionID
+	_ = cache.Save(entry)
+
+	fmt.Fprintf(out, "Moved %s#%d to %s\n", card.Repo, card.Number, name)
+	return nil
+}
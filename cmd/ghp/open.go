@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/editor"
+	"github.com/h0rv/ghp/internal/gh"
+	"github.com/spf13/cobra"
+)
+
+// newOpenCmd creates the `ghp open` subcommand, which edits an item in $EDITOR.
+func newOpenCmd() *cobra.Command {
+	var (
+		itemFlag int
+		repoFlag string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "open",
+		Short: "Edit a project item's title and body in $EDITOR",
+		Long: `Open a project item as a markdown file in $EDITOR.
+
+The file contains the item title and metadata as front-matter followed by the body.
+When the editor exits, changes to the title and body are saved back to GitHub.`,
+		Example: `  ghp open --owner myorg --project 1 --item 42
+  ghp open --owner myorg --project 1 --item 42 --repo myorg/api`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireProjectFlags(); err != nil {
+				return err
+			}
+			if itemFlag <= 0 {
+				return usageError(fmt.Errorf("--item must be a positive issue or PR number"))
+			}
+			return runOpen(cmd.Context(), progressOut(cmd), itemFlag, repoFlag)
+		},
+	}
+
+	cmd.Flags().IntVar(&itemFlag, "item", 0, "Issue or PR number to open")
+	cmd.Flags().StringVar(&repoFlag, "repo", "", "Repository (owner/name) to disambiguate items with the same number")
+
+	return cmd
+}
+
+// runOpen finds the item, opens it in the editor, and syncs any edits back.
+func runOpen(ctx context.Context, out io.Writer, number int, repo string) error {
+	client, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	project, err := loadProject(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	cards, err := client.GetAllItems(ctx, project.ID, groupFieldName(), pageSizeFlag)
+	if err != nil {
+		return err
+	}
+
+	card, err := findItem(cards, number, repo)
+	if err != nil {
+		return err
+	}
+	if !editor.Editable(card) {
+		return fmt.Errorf("item #%d cannot be edited", number)
+	}
+
+	// Item listings leave bodies out
+	details, err := client.GetItemDetails(ctx, []string{card.ContentID})
+	if err != nil {
+		return err
+	}
+	card.ApplyDetails(details[card.ContentID])
+
+	path, err := editor.WriteTemp(card)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(path)
+
+	editCmd := editor.Cmd(path)
+	editCmd.Stdin = os.Stdin
+	editCmd.Stdout = os.Stdout
+	editCmd.Stderr = os.Stderr
+	if err := editCmd.Run(); err != nil {
+		return fmt.Errorf("editor exited with error: %w", err)
+	}
+
+	title, body, err := editor.ReadTemp(path)
+	if err != nil {
+		return err
+	}
+
+	if title == card.Title && body == strings.TrimSpace(card.Body) {
+		fmt.Fprintln(out, "No changes.")
+		return nil
+	}
+
+	if err := client.UpdateContent(ctx, card.ContentType, card.ContentID, title, body); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Updated %s#%d\n", card.Repo, card.Number)
+	return nil
+}
+
+// findItem locates an issue or PR by number, optionally scoped to a repository.
+// Returns an error if no item matches or the number is ambiguous across repositories.
+func findItem(cards []domain.Card, number int, repo string) (*domain.Card, error) {
+	var matches []*domain.Card
+	for i := range cards {
+		c := &cards[i]
+		if c.Number != number {
+			continue
+		}
+		if repo != "" && !strings.EqualFold(c.Repo, repo) {
+			continue
+		}
+		matches = append(matches, c)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, &gh.NotFoundError{Msg: fmt.Sprintf("item #%d not found in project", number)}
+	case 1:
+		return matches[0], nil
+	default:
+		repos := make([]string, len(matches))
+		for i, m := range matches {
+			repos[i] = m.Repo
+		}
+		return nil, fmt.Errorf("item #%d is ambiguous (%s); use --repo", number, strings.Join(repos, ", "))
+	}
+}
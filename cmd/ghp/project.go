@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/h0rv/ghp/internal/cache"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/gh"
+	"github.com/h0rv/ghp/internal/store"
+)
+
+// requireProjectFlags validates that --owner and --project were both provided.
+// Non-interactive subcommands cannot fall back to the pickers.
+func requireProjectFlags() error {
+	if ownerFlag == "" || projectFlag == 0 {
+		return usageError(fmt.Errorf("--owner and --project are required"))
+	}
+	return nil
+}
+
+// loadProject resolves --owner and finds the project matching --project.
+func loadProject(ctx context.Context, client *gh.Client) (*domain.Project, error) {
+	ownerType, ownerID, err := client.ResolveOwner(ctx, ownerFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := client.ListProjects(ctx, ownerType, ownerID, ownerFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range projects {
+		if projects[i].Number == projectFlag {
+			return &projects[i], nil
+		}
+	}
+
+	return nil, &gh.NotFoundError{Msg: fmt.Sprintf("project #%d not found for owner %s", projectFlag, ownerFlag)}
+}
+
+// groupFieldName returns the field name used to populate each card's group value.
+// Defaults to "Status" when --group-field is not given.
+func groupFieldName() string {
+	if groupFieldFlag != "" {
+		return groupFieldFlag
+	}
+	return "Status"
+}
+
+// newClient creates an authenticated GitHub client with the standard guidance on failure.
+func newClient() (*gh.Client, error) {
+	client, err := gh.New()
+	if err != nil {
+		return nil, &exitError{code: exitAuth, err: fmt.Errorf("failed to create GitHub client: %w\n\nPlease authenticate using:\n  gh auth login\nor set the GITHUB_TOKEN environment variable", err)}
+	}
+	return client, nil
+}
+
+// resolveGroupField picks the grouping field from --group-field (a name or
+// field ID) or the standard heuristic.
+func resolveGroupField(fields []domain.FieldDef) (*domain.FieldDef, error) {
+	if groupFieldFlag != "" {
+		field, err := store.FindGroupField(fields, groupFieldFlag)
+		if store.IsVirtualField(field) {
+			return nil, usageError(fmt.Errorf("grouping by %s is only available on the board", strings.ToLower(field.Name)))
+		}
+		return field, err
+	}
+
+	fieldPtrs := make([]*domain.FieldDef, len(fields))
+	for i := range fields {
+		fieldPtrs[i] = &fields[i]
+	}
+
+	selected, candidates, err := store.SelectGroupField(fieldPtrs)
+	if err != nil {
+		return nil, err
+	}
+	if selected == nil {
+		names := make([]string, len(candidates))
+		for i, c := range candidates {
+			names[i] = c.Name
+		}
+		return nil, fmt.Errorf("multiple grouping fields available (%s); use --group-field", strings.Join(names, ", "))
+	}
+	return selected, nil
+}
+
+// fetchSnapshot loads the project, its grouping field, and all items from GitHub.
+// The result is written to the on-disk cache for later invocations.
+func fetchSnapshot(ctx context.Context, client *gh.Client) (*cache.Entry, error) {
+	project, err := loadProject(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := client.GetProjectFields(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupField, err := resolveGroupField(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	cards, err := client.GetAllItems(ctx, project.ID, groupField.Name, pageSizeFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	viewer := ""
+	if owners, err := client.GetViewerAndOrgs(ctx); err == nil && len(owners) > 0 {
+		viewer = owners[0].Login
+	}
+
+	entry := &cache.Entry{
+		FetchedAt:  time.Now(),
+		Viewer:     viewer,
+		Project:    *project,
+		GroupField: *groupField,
+		Fields:     fields,
+		Cards:      cards,
+	}
+
+	// A failed cache write only costs a future API call
+	_ = cache.Save(entry)
+	_ = cache.AppendHistory(project.Owner, project.Number, cache.NewSnapshot(entry))
+
+	return entry, nil
+}
+
+// loadItemDetails fills in labels, bodies, and timestamps, which item listings leave out.
+func loadItemDetails(ctx context.Context, client *gh.Client, cards []domain.Card) error {
+	contentIDs := make([]string, 0, len(cards))
+	for _, card := range cards {
+		if card.ContentID != "" {
+			contentIDs = append(contentIDs, card.ContentID)
+		}
+	}
+	details, err := client.GetItemDetails(ctx, contentIDs)
+	if err != nil {
+		return err
+	}
+	for i := range cards {
+		if d, ok := details[cards[i].ContentID]; ok {
+			cards[i].ApplyDetails(d)
+		}
+	}
+	return nil
+}
+
+// loadSnapshot returns a cached snapshot younger than maxAge, fetching a new one otherwise.
+func loadSnapshot(ctx context.Context, maxAge time.Duration) (*cache.Entry, error) {
+	entry, err := cache.Load(ownerFlag, projectFlag)
+	if err == nil && entry.Fresh(maxAge) && (groupFieldFlag == "" || store.MatchesField(entry.GroupField, groupFieldFlag)) {
+		return entry, nil
+	}
+
+	client, err := newClient()
+	if err != nil {
+		return nil, err
+	}
+	return fetchSnapshot(ctx, client)
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/gh"
+	"github.com/spf13/cobra"
+)
+
+// projectOutput is one project in the --json output of `ghp projects`.
+type projectOutput struct {
+	Number    int    `json:"number"`
+	Title     string `json:"title"`
+	Items     int    `json:"items"`
+	UpdatedAt string `json:"updatedAt"`
+	ID        string `json:"id"`
+}
+
+// newProjectsCmd creates the `ghp projects` subcommand, which lists an owner's projects.
+func newProjectsCmd() *cobra.Command {
+	var jsonFlag bool
+
+	cmd := &cobra.Command{
+		Use:   "projects",
+		Short: "List an owner's projects",
+		Long: `List the projects of an organization or user with their number, title,
+item count, and last update, without starting the interactive picker.`,
+		Example: `  ghp projects --owner myorg
+  ghp projects --owner myorg --json | jq '.[] | select(.title == "Roadmap") | .number'`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if ownerFlag == "" {
+				return fmt.Errorf("--owner is required")
+			}
+			client, err := newClient()
+			if err != nil {
+				return err
+			}
+			ownerType, ownerID, err := client.ResolveOwner(cmd.Context(), ownerFlag)
+			if err != nil {
+				return err
+			}
+			projects, err := client.ListProjects(cmd.Context(), ownerType, ownerID, ownerFlag)
+			if err != nil {
+				return err
+			}
+			if len(projects) == 0 && !quietFlag {
+				// The owner exists, so another gh account may be the one with access
+				for _, other := range gh.FindOtherAccounts(cmd.Context(), ownerFlag) {
+					fmt.Fprintf(cmd.ErrOrStderr(), "warning: %s\n", other.Hint(ownerFlag))
+				}
+			}
+			if jsonFlag {
+				return printProjectsJSON(cmd.OutOrStdout(), projects)
+			}
+			return printProjects(cmd.OutOrStdout(), projects)
+		},
+	}
+
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Print as JSON, including project node IDs")
+
+	return cmd
+}
+
+// printProjects lists projects as an aligned table.
+func printProjects(out io.Writer, projects []domain.Project) error {
+	if len(projects) == 0 {
+		fmt.Fprintln(out, "No projects found.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NUMBER\tTITLE\tITEMS\tUPDATED")
+	for _, p := range projects {
+		fmt.Fprintf(w, "%d\t%s\t%d\t%s\n", p.Number, p.Title, p.ItemCount, p.UpdatedAt)
+	}
+	return w.Flush()
+}
+
+// printProjectsJSON writes projects as an indented JSON array.
+func printProjectsJSON(out io.Writer, projects []domain.Project) error {
+	result := make([]projectOutput, 0, len(projects))
+	for _, p := range projects {
+		result = append(result, projectOutput{
+			Number:    p.Number,
+			Title:     p.Title,
+			Items:     p.ItemCount,
+			UpdatedAt: p.UpdatedAt,
+			ID:        p.ID,
+		})
+	}
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(result)
+}
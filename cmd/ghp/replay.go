@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/session"
+	"github.com/h0rv/ghp/internal/tui"
+	"github.com/spf13/cobra"
+)
+
+// newReplayCmd creates the `ghp replay` subcommand, which plays back a session recorded with --record.
+func newReplayCmd() *cobra.Command {
+	var speedFlag float64
+
+	cmd := &cobra.Command{
+		Use:   "replay <file>",
+		Short: "Play back a board session recorded with --record",
+		Long: `Play back a session recorded with 'ghp --record <file>'.
+
+Recordings contain board state (cards, selection, filter, and mode) after each
+change, not keystrokes, so replay needs no GitHub access. Gaps between frames
+are capped at a few seconds.
+
+Controls: space pauses, h/l step back and forward, g/G jump to start and end, q quits.`,
+		Example: `  ghp replay session.jsonl
+  ghp replay session.jsonl --speed 2`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if speedFlag <= 0 {
+				return fmt.Errorf("--speed must be positive")
+			}
+
+			f, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to open recording: %w", err)
+			}
+			defer f.Close()
+
+			frames, err := session.Load(f)
+			if err != nil {
+				return err
+			}
+
+			p := tea.NewProgram(tui.NewReplayModel(frames, speedFlag), tea.WithAltScreen())
+			if _, err := p.Run(); err != nil {
+				return fmt.Errorf("program error: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Float64Var(&speedFlag, "speed", 1, "Playback speed multiplier")
+
+	return cmd
+}
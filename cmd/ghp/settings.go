@@ -0,0 +1,156 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/h0rv/ghp/internal/config"
+	"github.com/h0rv/ghp/internal/gitrepo"
+	"github.com/h0rv/ghp/internal/tui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Problems found in the settings file, reported with the other config warnings
+	settingsWarnings []string
+
+	// Header status segments from the settings file
+	statusSegments []string
+
+	// Per-project accent colors from the settings file
+	accents map[string]string
+
+	// Named filter presets from the settings file
+	presets []config.Preset
+
+	// Local templates for new items, from the templates directory
+	templates []config.Template
+
+	// Column width bounds from the settings file, 0 for the defaults
+	minColumnWidth, maxColumnWidth int
+)
+
+// narrowestColumn is the smallest MinColumnWidth accepted, leaving room for
+// a card title beside its number
+const narrowestColumn = 14
+
+// accentPattern matches the colors an accent can be: an ANSI color number or
+// a hex RGB value
+var accentPattern = regexp.MustCompile(`^(25[0-5]|2[0-4][0-9]|1?[0-9]{1,2}|#[0-9a-fA-F]{6}|#[0-9a-fA-F]{3})$`)
+
+// loadTemplates reads the local new item templates. They don't depend on the
+// settings file, so a broken one doesn't hide them.
+func loadTemplates() {
+	var err error
+	if templates, err = config.LoadTemplates(); err != nil {
+		settingsWarnings = append(settingsWarnings, fmt.Sprintf("%v; ignoring templates", err))
+	}
+}
+
+// applySettings fills in the flags left unset from the settings file. Flags
+// win over GHP_* variables, which win over settings. Inside a clone of a
+// repository listed in Repos, that repository's project is the default.
+func applySettings(cmd *cobra.Command) {
+	s, problems, err := config.LoadSettings()
+	if err != nil {
+		settingsWarnings = append(settingsWarnings, fmt.Sprintf("%v; ignoring settings", err))
+		return
+	}
+	for _, p := range problems {
+		settingsWarnings = append(settingsWarnings, p.String())
+	}
+
+	flags := cmd.Flags()
+	unset := func(name, env string) bool {
+		f := flags.Lookup(name)
+		return f != nil && !f.Changed && os.Getenv(env) == ""
+	}
+	if s.PageSize > 0 && unset("page-size", "GHP_PAGE_SIZE") {
+		pageSizeFlag = s.PageSize
+	}
+	if s.UI.StaleDays > 0 && unset("stale-days", "GHP_STALE_DAYS") {
+		staleDaysFlag = s.UI.StaleDays
+	}
+	if s.AltGroupField != "" && unset("alt-group-field", "GHP_ALT_GROUP_FIELD") {
+		altGroupFlag = s.AltGroupField
+	}
+	if s.Resume && unset("resume", "GHP_RESUME") {
+		resumeFlag = true
+	}
+	if s.UI.AutoRefresh > 0 && unset("auto-refresh", "GHP_AUTO_REFRESH") {
+		autoRefreshFlag = s.UI.AutoRefresh
+	}
+	if s.UI.ReducedMotion && unset("reduced-motion", "GHP_REDUCED_MOTION") {
+		reducedMotion = true
+	}
+	if s.UI.Scrollbar && unset("scrollbar", "GHP_SCROLLBAR") {
+		scrollbarFlag = true
+	}
+	if s.UI.IgnoreDiacritics && unset("ignore-diacritics", "GHP_IGNORE_DIACRITICS") {
+		foldDiacritics = true
+	}
+	for _, name := range s.UI.StatusBar {
+		if slices.Contains(tui.StatusSegments, name) {
+			statusSegments = append(statusSegments, name)
+		} else {
+			settingsWarnings = append(settingsWarnings, fmt.Sprintf("unknown status bar segment %q is ignored; choose from %s",
+				name, strings.Join(tui.StatusSegments, ", ")))
+		}
+	}
+	for project, color := range s.UI.Accents {
+		if !accentPattern.MatchString(color) {
+			settingsWarnings = append(settingsWarnings, fmt.Sprintf("accent %q for %s is ignored; use a color number (0-255) or #rrggbb", color, project))
+			continue
+		}
+		if accents == nil {
+			accents = make(map[string]string)
+		}
+		accents[project] = color
+	}
+	switch {
+	case s.UI.MinColumnWidth != 0 && s.UI.MinColumnWidth < narrowestColumn:
+		settingsWarnings = append(settingsWarnings, fmt.Sprintf("MinColumnWidth %d is ignored; use at least %d", s.UI.MinColumnWidth, narrowestColumn))
+	default:
+		minColumnWidth = s.UI.MinColumnWidth
+	}
+	switch {
+	case s.UI.MaxColumnWidth != 0 && s.UI.MaxColumnWidth < max(minColumnWidth, narrowestColumn):
+		settingsWarnings = append(settingsWarnings, fmt.Sprintf("MaxColumnWidth %d is ignored; it must be at least MinColumnWidth", s.UI.MaxColumnWidth))
+	default:
+		maxColumnWidth = s.UI.MaxColumnWidth
+	}
+	for _, p := range s.Presets {
+		if strings.TrimSpace(p.Name) == "" {
+			settingsWarnings = append(settingsWarnings, "a preset without a name is ignored")
+			continue
+		}
+		presets = append(presets, p)
+	}
+
+	// A workspace names its own project
+	if workspaceFlag != "" {
+		return
+	}
+	repo := ""
+	if len(s.Repos) > 0 {
+		repo, _ = gitrepo.Origin(".")
+	}
+	d, ok := s.ForRepo(repo)
+	if !ok {
+		return
+	}
+	// Another owner on the command line makes the default project meaningless
+	if flags.Changed("owner") && !strings.EqualFold(ownerFlag, d.Owner) {
+		return
+	}
+	ownerFlag = d.Owner
+	if !flags.Changed("project") {
+		projectFlag = d.Project
+	}
+	if !flags.Changed("group-field") {
+		groupFieldFlag = d.GroupField
+	}
+}
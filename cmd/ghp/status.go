@@ -0,0 +1,129 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/h0rv/ghp/internal/cache"
+	"github.com/spf13/cobra"
+)
+
+// newStatusCmd creates the `ghp status` subcommand, a compact per-column summary.
+func newStatusCmd() *cobra.Command {
+	var (
+		formatFlag  string
+		allFlag     bool
+		columnsFlag []string
+		maxAgeFlag  time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Print a compact per-column item summary",
+		Long: `Print a summary of item counts per column.
+
+By default only items assigned to you are counted. The summary is served from
+the local cache while it is younger than --max-age, so it is cheap enough to run
+from a tmux status line or shell prompt on every refresh.`,
+		Example: `  ghp status --owner myorg --project 1 --format tmux
+  set -g status-right '#(ghp status --owner myorg --project 1 --format tmux)'`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireProjectFlags(); err != nil {
+				return err
+			}
+			if formatFlag != "tmux" && formatFlag != "text" {
+				return fmt.Errorf("unknown format '%s' (expected tmux or text)", formatFlag)
+			}
+
+			entry, err := loadSnapshot(cmd.Context(), maxAgeFlag)
+			if err != nil {
+				return err
+			}
+
+			counts := countByColumn(entry, !allFlag, columnsFlag)
+			fmt.Println(formatStatus(counts, formatFlag, !allFlag && entry.Viewer != ""))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&formatFlag, "format", "text", "Output format: tmux (single line) or text (one column per line)")
+	cmd.Flags().BoolVar(&allFlag, "all", false, "Count all items instead of only items assigned to you")
+	cmd.Flags().StringSliceVar(&columnsFlag, "columns", nil, "Only include these columns (comma-separated names)")
+	cmd.Flags().DurationVar(&maxAgeFlag, "max-age", 5*time.Minute, "Maximum age of cached data before refetching")
+
+	return cmd
+}
+
+// columnCount is the number of matching items in one column.
+type columnCount struct {
+	Name  string
+	Count int
+}
+
+// countByColumn counts items per column in option order.
+// When mineOnly is set and the viewer is known, only the viewer's items are counted.
+// Columns with no matching items are omitted.
+func countByColumn(entry *cache.Entry, mineOnly bool, columns []string) []columnCount {
+	counts := make(map[string]int)
+	for _, card := range entry.Cards {
+		if mineOnly && entry.Viewer != "" && !assignedTo(card.Assignees, entry.Viewer) {
+			continue
+		}
+		counts[card.GroupOptionID]++
+	}
+
+	var result []columnCount
+	add := func(name string, count int) {
+		if count == 0 {
+			return
+		}
+		if len(columns) > 0 && !containsFold(columns, name) {
+			return
+		}
+		result = append(result, columnCount{Name: name, Count: count})
+	}
+
+	for _, opt := range entry.GroupField.Options {
+		add(opt.Name, counts[opt.ID])
+	}
+	add("No Status", counts[""])
+
+	return result
+}
+
+// formatStatus renders column counts in the requested format.
+func formatStatus(counts []columnCount, format string, mine bool) string {
+	if format == "tmux" {
+		parts := make([]string, len(counts))
+		for i, c := range counts {
+			parts[i] = fmt.Sprintf("%s: %d", c.Name, c.Count)
+		}
+		line := strings.Join(parts, " | ")
+		if line != "" && mine {
+			line += " assigned to me"
+		}
+		return line
+	}
+
+	lines := make([]string, len(counts))
+	for i, c := range counts {
+		lines[i] = fmt.Sprintf("%s\t%d", c.Name, c.Count)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// assignedTo reports whether login appears in assignees (case-insensitive).
+func assignedTo(assignees []string, login string) bool {
+	return containsFold(assignees, login)
+}
+
+// containsFold reports whether s contains target, ignoring case.
+func containsFold(s []string, target string) bool {
+	for _, v := range s {
+		if strings.EqualFold(strings.TrimSpace(v), target) {
+			return true
+		}
+	}
+	return false
+}
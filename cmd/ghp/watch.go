@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/h0rv/ghp/internal/cache"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/watch"
+	"github.com/spf13/cobra"
+)
+
+// minWatchInterval keeps watch from spending the rate limit on one project
+const minWatchInterval = 10 * time.Second
+
+// newWatchCmd creates the `ghp watch` subcommand, which streams item changes.
+func newWatchCmd() *cobra.Command {
+	var (
+		ndjsonFlag   bool
+		intervalFlag time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Print project item changes as they happen",
+		Long: `Poll a project and print an event whenever an item is added, removed,
+moved to another column, or has its title, state, or assignees changed.
+
+With --ndjson every event is one JSON object per line, for dashboards and
+scripts. Events have a "type" of added, removed, moved, changed, or error.
+Failed polls are reported as error events and retried on the next tick.`,
+		Example: `  ghp watch --owner myorg --project 1
+  ghp watch --owner myorg --project 1 --ndjson | jq -c 'select(.type == "moved")'`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireProjectFlags(); err != nil {
+				return err
+			}
+			if intervalFlag < minWatchInterval {
+				return usageError(fmt.Errorf("--interval must be at least %s", minWatchInterval))
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer stop()
+			return runWatch(ctx, cmd.OutOrStdout(), intervalFlag, ndjsonFlag)
+		},
+	}
+
+	cmd.Flags().BoolVar(&ndjsonFlag, "ndjson", false, "Print newline-delimited JSON events")
+	cmd.Flags().DurationVar(&intervalFlag, "interval", 30*time.Second, "Time between polls")
+
+	return cmd
+}
+
+// runWatch polls the project until ctx is done, printing events for each change.
+func runWatch(ctx context.Context, out io.Writer, interval time.Duration, ndjson bool) error {
+	client, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	entry, err := fetchSnapshot(ctx, client)
+	if err != nil {
+		return err
+	}
+	column := func(optionID string) string {
+		return columnName(entry, domain.Card{GroupOptionID: optionID})
+	}
+
+	emit := func(e watch.Event) {
+		if ndjson {
+			data, _ := json.Marshal(e)
+			fmt.Fprintf(out, "%s\n", data)
+			return
+		}
+		fmt.Fprintln(out, formatEvent(e))
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		cards, err := client.GetAllItems(ctx, entry.Project.ID, entry.GroupField.Name, pageSizeFlag)
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return nil
+		}
+		if err != nil {
+			emit(watch.Event{Type: watch.Failed, At: time.Now(), Error: err.Error()})
+			if exitCode(err) == exitAuth || exitCode(err) == exitNotFound {
+				return err
+			}
+			continue
+		}
+
+		now := time.Now()
+		for _, e := range watch.Diff(entry.Cards, cards, column, now) {
+			emit(e)
+		}
+
+		entry.Cards, entry.FetchedAt = cards, now
+		_ = cache.Save(entry)
+		_ = cache.AppendHistory(entry.Project.Owner, entry.Project.Number, cache.NewSnapshot(entry))
+	}
+}
+
+// formatEvent renders an event as one line of text.
+func formatEvent(e watch.Event) string {
+	ref := e.Title
+	if e.Number > 0 {
+		ref = fmt.Sprintf("%s#%d %s", e.Repo, e.Number, e.Title)
+	}
+
+	var what string
+	switch e.Type {
+	case watch.Added:
+		what = fmt.Sprintf("added %s to %s", ref, e.To)
+	case watch.Removed:
+		what = "removed " + ref
+	case watch.Moved:
+		what = fmt.Sprintf("moved %s: %s → %s", ref, e.From, e.To)
+	case watch.Changed:
+		what = fmt.Sprintf("changed %s of %s", strings.Join(e.Changes, ", "), ref)
+	case watch.Failed:
+		what = "poll failed: " + e.Error
+	}
+	return e.At.Format("15:04:05") + " " + what
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/h0rv/ghp/internal/workspace"
+	"github.com/spf13/cobra"
+)
+
+// newWorkspaceCmd creates the `ghp workspace` command for managing saved board layouts.
+func newWorkspaceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "workspace",
+		Short: "Manage saved board layouts",
+		Long: `A workspace is a named combination of project, grouping field, filters,
+sorting, and hidden columns. Launch one with 'ghp --workspace <name>'.
+
+Workspaces can also be saved from the board with W.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return printWorkspaces(cmd.OutOrStdout())
+		},
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "list",
+		Short: "List saved workspaces",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return printWorkspaces(cmd.OutOrStdout())
+		},
+	})
+	cmd.AddCommand(newWorkspaceSaveCmd())
+	cmd.AddCommand(&cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a saved workspace",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := workspace.Delete(args[0]); err != nil {
+				return err
+			}
+			fmt.Fprintf(progressOut(cmd), "Deleted workspace '%s'\n", args[0])
+			return nil
+		},
+	})
+
+	return cmd
+}
+
+// newWorkspaceSaveCmd creates `workspace save`, which stores a workspace from flags.
+func newWorkspaceSaveCmd() *cobra.Command {
+	var (
+		filterFlag string
+		mineFlag   bool
+		teamFlag   string
+		hideFlag   []string
+		sortFlag   string
+		colSorts   []string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "save <name>",
+		Short: "Save a workspace from flags",
+		Example: `  ghp workspace save backend-sprint --owner myorg --project 1 --group-field Status \
+    --filter api --hide-column Done --hide-column "No Status" --sort -updated --column-sort Todo=title`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireProjectFlags(); err != nil {
+				return err
+			}
+			var columnSorts map[string]string
+			for _, s := range colSorts {
+				column, key, ok := strings.Cut(s, "=")
+				if !ok || strings.TrimSpace(column) == "" {
+					return fmt.Errorf("invalid --column-sort %q: use <column>=<sort>", s)
+				}
+				if columnSorts == nil {
+					columnSorts = make(map[string]string)
+				}
+				columnSorts[strings.TrimSpace(column)] = strings.TrimSpace(key)
+			}
+			ws := workspace.Workspace{
+				Name:          args[0],
+				Owner:         ownerFlag,
+				Project:       projectFlag,
+				GroupField:    groupFieldFlag,
+				Filter:        filterFlag,
+				MyOnly:        mineFlag,
+				Team:          teamFlag,
+				HiddenColumns: hideFlag,
+				BoardSort:     sortFlag,
+				ColumnSorts:   columnSorts,
+			}
+			if err := workspace.Save(ws); err != nil {
+				return err
+			}
+			fmt.Fprintf(progressOut(cmd), "Saved workspace '%s'\n", ws.Name)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&filterFlag, "filter", "", "Board text filter")
+	cmd.Flags().BoolVar(&mineFlag, "mine", false, "Only show items assigned to me")
+	cmd.Flags().StringVar(&teamFlag, "team", "", "Only show items assigned to members of this team (slug or org/slug)")
+	cmd.Flags().StringArrayVar(&hideFlag, "hide-column", nil, "Column name to hide (repeatable)")
+	cmd.Flags().StringVar(&sortFlag, "sort", "", "Board sort, e.g. title, number, -updated, assignee")
+	cmd.Flags().StringArrayVar(&colSorts, "column-sort", nil, "Sort for one column as <column>=<sort> (repeatable)")
+
+	return cmd
+}
+
+// printWorkspaces lists saved workspaces with their project and view settings.
+func printWorkspaces(out io.Writer) error {
+	workspaces, err := workspace.List()
+	if err != nil {
+		return err
+	}
+	if len(workspaces) == 0 {
+		fmt.Fprintln(out, "No saved workspaces.")
+		return nil
+	}
+
+	for _, ws := range workspaces {
+		details := []string{fmt.Sprintf("%s/%d", ws.Owner, ws.Project)}
+		if ws.GroupField != "" {
+			details = append(details, "by "+ws.GroupField)
+		}
+		if ws.Filter != "" {
+			details = append(details, "filter "+ws.Filter)
+		}
+		if ws.MyOnly {
+			details = append(details, "@me")
+		}
+		if ws.Team != "" {
+			details = append(details, "team "+ws.Team)
+		}
+		if ws.BoardSort != "" {
+			details = append(details, "sorted "+ws.BoardSort)
+		}
+		if len(ws.ColumnSorts) > 0 {
+			columns := make([]string, 0, len(ws.ColumnSorts))
+			for column, key := range ws.ColumnSorts {
+				columns = append(columns, column+" "+key)
+			}
+			sort.Strings(columns)
+			details = append(details, "sorting "+strings.Join(columns, ", "))
+		}
+		if len(ws.HiddenColumns) > 0 {
+			details = append(details, "hiding "+strings.Join(ws.HiddenColumns, ", "))
+		}
+		fmt.Fprintf(out, "%-20s %s\n", ws.Name, strings.Join(details, " · "))
+	}
+	return nil
+}
+
+// applyWorkspaceFlags loads the named workspace and fills in project flags
+// the user did not set explicitly.
+func applyWorkspaceFlags(cmd *cobra.Command, name string) (*workspace.Workspace, error) {
+	ws, err := workspace.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	flags := cmd.Flags()
+	if !flags.Changed("owner") {
+		ownerFlag = ws.Owner
+	}
+	if !flags.Changed("project") {
+		projectFlag = ws.Project
+	}
+	if !flags.Changed("group-field") {
+		groupFieldFlag = ws.GroupField
+	}
+	return ws, nil
+}
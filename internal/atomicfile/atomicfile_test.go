@@ -0,0 +1,35 @@
+package atomicfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "state.json")
+
+	require.NoError(t, WriteFile(path, []byte("old"), 0o644))
+	require.NoError(t, WriteFile(path, []byte("new"), 0o600))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(data))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "No temp files are left behind")
+
+	// A failed write leaves the old file in place
+	assert.Error(t, WriteFile(filepath.Join(dir, "missing", "state.json"), []byte("x"), 0o644))
+	data, _ = os.ReadFile(path)
+	assert.Equal(t, "new", string(data))
+}
@@ -8,9 +8,22 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"regexp"
 	"strings"
 )
 
+// DefaultHost is the GitHub host used when GH_HOST is not set.
+const DefaultHost = "github.com"
+
+// Host returns the GitHub host to authenticate against.
+// It honors GH_HOST, which gh also sets for extensions, so ghp follows gh's host selection.
+func Host() string {
+	if host := strings.TrimSpace(os.Getenv("GH_HOST")); host != "" {
+		return host
+	}
+	return DefaultHost
+}
+
 // TokenProvider defines the interface for obtaining a GitHub authentication token.
 // Implementations may use different sources (CLI tools, environment variables, etc).
 type TokenProvider interface {
@@ -19,12 +32,19 @@ type TokenProvider interface {
 
 // GhCliProvider obtains tokens by shelling out to the GitHub CLI (`gh auth token`).
 // This is the preferred method as it respects the user's gh CLI authentication state.
-type GhCliProvider struct{}
+type GhCliProvider struct {
+	Hostname string // GitHub host to request a token for; defaults to Host()
+}
 
 // GetToken shells out to `gh auth token` to retrieve the current token.
 // Returns an error if gh CLI is not installed, not authenticated, or the command fails.
 func (g *GhCliProvider) GetToken() (string, error) {
-	cmd := exec.Command("gh", "auth", "token", "--hostname", "github.com")
+	hostname := g.Hostname
+	if hostname == "" {
+		hostname = Host()
+	}
+
+	cmd := exec.Command("gh", "auth", "token", "--hostname", hostname)
 	output, err := cmd.Output()
 	if err != nil {
 		// Check if it's an exec error (gh not found)
@@ -45,14 +65,94 @@ func (g *GhCliProvider) GetToken() (string, error) {
 
 // EnvProvider obtains tokens from the GITHUB_TOKEN environment variable.
 // This is the fallback method when gh CLI is not available.
-type EnvProvider struct{}
+// GH_TOKEN is checked first, matching gh; enterprise hosts use GH_ENTERPRISE_TOKEN
+// or GITHUB_ENTERPRISE_TOKEN instead.
+type EnvProvider struct {
+	Hostname string // GitHub host the token is for; defaults to Host()
+}
 
-// GetToken reads the GITHUB_TOKEN environment variable.
-// Returns an error if the variable is not set or is empty.
+// GetToken reads the token environment variables for the provider's host.
+// Returns an error if no variable is set or all are empty.
 func (e *EnvProvider) GetToken() (string, error) {
-	token := os.Getenv("GITHUB_TOKEN")
+	hostname := e.Hostname
+	if hostname == "" {
+		hostname = Host()
+	}
+
+	vars := []string{"GH_TOKEN", "GITHUB_TOKEN"}
+	if hostname != DefaultHost {
+		vars = []string{"GH_ENTERPRISE_TOKEN", "GITHUB_ENTERPRISE_TOKEN"}
+	}
+
+	for _, name := range vars {
+		if token := os.Getenv(name); token != "" {
+			return token, nil
+		}
+	}
+	return "", fmt.Errorf("%s environment variable not set or empty", vars[len(vars)-1])
+}
+
+// Account is an account the GitHub CLI is logged in to on a host.
+type Account struct {
+	Login  string
+	Active bool // The account gh uses, and so ghp
+}
+
+// accountPattern matches gh auth status's "Logged in to HOST account LOGIN"
+// lines, and the "as LOGIN" wording of older gh releases
+var accountPattern = regexp.MustCompile(`Logged in to \S+ (?:account|as) (\S+)`)
+
+// Accounts lists the accounts the GitHub CLI is logged in to on hostname
+// (Host() when empty). gh releases before multi-account support list one.
+func Accounts(hostname string) ([]Account, error) {
+	if hostname == "" {
+		hostname = Host()
+	}
+	// Some gh releases print the status to stderr, and exit 1 if any
+	// account's token is invalid while still listing the others
+	output, err := exec.Command("gh", "auth", "status", "--hostname", hostname).CombinedOutput()
+	accounts := parseAccounts(string(output))
+	if len(accounts) == 0 && err != nil {
+		return nil, fmt.Errorf("gh auth status failed: %w", err)
+	}
+	return accounts, nil
+}
+
+// parseAccounts reads the accounts from gh auth status output. Without an
+// "Active account" line, as in older releases, the only account is active.
+func parseAccounts(output string) []Account {
+	var accounts []Account
+	activeKnown := false
+	for _, line := range strings.Split(output, "\n") {
+		if m := accountPattern.FindStringSubmatch(line); m != nil {
+			accounts = append(accounts, Account{Login: m[1]})
+			continue
+		}
+		key, value, ok := strings.Cut(strings.TrimSpace(line), "Active account:")
+		if ok && len(accounts) > 0 && strings.Trim(key, "- ") == "" {
+			activeKnown = true
+			accounts[len(accounts)-1].Active = strings.TrimSpace(value) == "true"
+		}
+	}
+	if !activeKnown && len(accounts) == 1 {
+		accounts[0].Active = true
+	}
+	return accounts
+}
+
+// TokenFor returns the token of one of the GitHub CLI's accounts on hostname
+// (Host() when empty), whether or not it is the active one.
+func TokenFor(hostname, login string) (string, error) {
+	if hostname == "" {
+		hostname = Host()
+	}
+	output, err := exec.Command("gh", "auth", "token", "--hostname", hostname, "--user", login).Output()
+	if err != nil {
+		return "", fmt.Errorf("gh auth token for %s failed: %w", login, err)
+	}
+	token := strings.TrimSpace(string(output))
 	if token == "" {
-		return "", errors.New("GITHUB_TOKEN environment variable not set or empty")
+		return "", fmt.Errorf("gh auth token returned empty token for %s", login)
 	}
 	return token, nil
 }
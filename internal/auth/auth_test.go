@@ -104,3 +104,61 @@ func TestTokenProvider_Interface(t *testing.T) {
 	var _ TokenProvider = &GhCliProvider{}
 	var _ TokenProvider = &EnvProvider{}
 }
+
+func TestHost_Default(t *testing.T) {
+	t.Setenv("GH_HOST", "")
+	assert.Equal(t, DefaultHost, Host())
+
+	t.Setenv("GH_HOST", "github.example.com")
+	assert.Equal(t, "github.example.com", Host())
+}
+
+func TestEnvProvider_GetToken_PrefersGhToken(t *testing.T) {
+	t.Setenv("GH_TOKEN", "gh_token")
+	t.Setenv("GITHUB_TOKEN", "github_token")
+
+	provider := &EnvProvider{Hostname: DefaultHost}
+	token, err := provider.GetToken()
+
+	require.NoError(t, err)
+	assert.Equal(t, "gh_token", token)
+}
+
+func TestEnvProvider_GetToken_Enterprise(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "github_token")
+	t.Setenv("GH_ENTERPRISE_TOKEN", "")
+	t.Setenv("GITHUB_ENTERPRISE_TOKEN", "")
+
+	provider := &EnvProvider{Hostname: "github.example.com"}
+	_, err := provider.GetToken()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "GITHUB_ENTERPRISE_TOKEN")
+
+	t.Setenv("GITHUB_ENTERPRISE_TOKEN", "enterprise_token")
+	token, err := provider.GetToken()
+	require.NoError(t, err)
+	assert.Equal(t, "enterprise_token", token)
+}
+
+func TestParseAccounts(t *testing.T) {
+	output := `github.com
+  ✓ Logged in to github.com account octocat (keyring)
+  - Active account: true
+  - Git operations protocol: https
+  - Token: gho_************************************
+
+  ✓ Logged in to github.com account octo-work (keyring)
+  - Active account: false
+  - Git operations protocol: https
+`
+	assert.Equal(t, []Account{
+		{Login: "octocat", Active: true},
+		{Login: "octo-work"},
+	}, parseAccounts(output))
+
+	// Releases before multi-account support show one account, which is active
+	old := "github.com\n  ✓ Logged in to github.com as octocat (oauth_token)\n  ✓ Git operations for github.com configured to use https protocol.\n"
+	assert.Equal(t, []Account{{Login: "octocat", Active: true}}, parseAccounts(old))
+
+	assert.Empty(t, parseAccounts("You are not logged into any GitHub hosts. Run gh auth login to authenticate.\n"))
+}
@@ -0,0 +1,127 @@
+// Package cache persists project snapshots on disk so that non-interactive
+// commands (status lines, prompts) can answer without hitting the API each time,
+// and the board can open instantly, or offline, from the last one.
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/h0rv/ghp/internal/atomicfile"
+	"github.com/h0rv/ghp/internal/domain"
+)
+
+// ErrMiss indicates no cached snapshot exists for the requested project.
+var ErrMiss = errors.New("cache miss")
+
+// Entry is a cached snapshot of a project's items grouped by one field.
+type Entry struct {
+	FetchedAt  time.Time         // When the snapshot was fetched from GitHub
+	Viewer     string            // Authenticated user's login at fetch time
+	Project    domain.Project    // Project metadata
+	GroupField domain.FieldDef   // Field the cards' GroupOptionID refers to
+	Fields     []domain.FieldDef // All project fields, empty in caches from older versions
+	Cards      []domain.Card     // All project items
+}
+
+// Fresh reports whether the entry is younger than maxAge.
+func (e *Entry) Fresh(maxAge time.Duration) bool {
+	return time.Since(e.FetchedAt) < maxAge
+}
+
+// Dir returns the directory holding cache files, honoring XDG_CACHE_HOME.
+func Dir() (string, error) {
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate cache directory: %w", err)
+	}
+	return filepath.Join(base, "ghp"), nil
+}
+
+// Path returns the cache file path for a project.
+func Path(owner string, number int) (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	name := fmt.Sprintf("%s-%d.json", sanitize(owner), number)
+	return filepath.Join(dir, name), nil
+}
+
+// Load reads the cached snapshot for a project, returning ErrMiss if none exists.
+func Load(owner string, number int) (*Entry, error) {
+	path, err := Path(owner, number)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrMiss
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cache: %w", err)
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("failed to decode cache: %w", err)
+	}
+	return &entry, nil
+}
+
+// Save writes a snapshot for a project, replacing any existing one.
+// The file is written atomically so concurrent readers never see partial data.
+func Save(entry *Entry) error {
+	path, err := Path(entry.Project.Owner, entry.Project.Number)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode cache: %w", err)
+	}
+
+	return writeAtomic(path, data)
+}
+
+// writeAtomic replaces a cache file without ever leaving it truncated.
+func writeAtomic(path string, data []byte) error {
+	if err := atomicfile.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write cache: %w", err)
+	}
+	return nil
+}
+
+// Clear removes the cached snapshot for a project, if any.
+// Commands that mutate items call this so later reads do not serve stale data.
+func Clear(owner string, number int) error {
+	path, err := Path(owner, number)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to clear cache: %w", err)
+	}
+	return nil
+}
+
+// sanitize makes an owner login safe and case-insensitive for use in a file name.
+func sanitize(s string) string {
+	s = strings.ToLower(s)
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-' || r == '_' {
+			return r
+		}
+		return '_'
+	}, s)
+}
@@ -0,0 +1,79 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestEntry() *Entry {
+	return &Entry{
+		FetchedAt: time.Now(),
+		Viewer:    "alice",
+		Project:   domain.Project{ID: "proj_1", Number: 3, Title: "Roadmap", Owner: "TestOrg"},
+		GroupField: domain.FieldDef{
+			ID:   "field_status",
+			Name: "Status",
+			Type: domain.FieldTypeSingleSelect,
+			Options: []domain.Option{
+				{ID: "opt_todo", Name: "Todo"},
+			},
+		},
+		Cards: []domain.Card{
+			{ItemID: "item_1", Title: "Fix bug", GroupOptionID: "opt_todo", Assignees: []string{"alice"}},
+		},
+	}
+}
+
+func TestSaveLoad_RoundTrip(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	entry := createTestEntry()
+	require.NoError(t, Save(entry))
+
+	loaded, err := Load("testorg", 3)
+	require.NoError(t, err)
+	assert.Equal(t, entry.Viewer, loaded.Viewer)
+	assert.Equal(t, entry.Project, loaded.Project)
+	assert.Equal(t, entry.GroupField, loaded.GroupField)
+	assert.Equal(t, entry.Cards, loaded.Cards)
+	assert.WithinDuration(t, entry.FetchedAt, loaded.FetchedAt, time.Second)
+}
+
+func TestLoad_Miss(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	_, err := Load("nobody", 1)
+	assert.ErrorIs(t, err, ErrMiss)
+}
+
+func TestEntry_Fresh(t *testing.T) {
+	entry := &Entry{FetchedAt: time.Now().Add(-2 * time.Minute)}
+
+	assert.True(t, entry.Fresh(5*time.Minute))
+	assert.False(t, entry.Fresh(time.Minute))
+}
+
+func TestPath_NormalizesOwner(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", "/cache")
+
+	path, err := Path("My.Org", 1)
+	require.NoError(t, err)
+	assert.Equal(t, "/cache/ghp/my_org-1.json", path)
+}
+
+func TestClear(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	require.NoError(t, Save(createTestEntry()))
+	require.NoError(t, Clear("TestOrg", 3))
+
+	_, err := Load("TestOrg", 3)
+	assert.ErrorIs(t, err, ErrMiss)
+
+	// Clearing a missing entry is not an error
+	assert.NoError(t, Clear("TestOrg", 3))
+}
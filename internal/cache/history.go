@@ -0,0 +1,123 @@
+package cache
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// maxHistory is the number of snapshots retained per project.
+const maxHistory = 1000
+
+// Snapshot records which column every item was in at a point in time.
+// A sequence of snapshots is enough to reconstruct how long items stayed in each column.
+type Snapshot struct {
+	At           time.Time         // When the snapshot was taken
+	GroupFieldID string            // Field the column IDs belong to
+	Columns      map[string]string // ItemID -> option ID ("" for no status)
+}
+
+// NewSnapshot builds a history snapshot from a cache entry.
+func NewSnapshot(entry *Entry) Snapshot {
+	columns := make(map[string]string, len(entry.Cards))
+	for _, card := range entry.Cards {
+		columns[card.ItemID] = card.GroupOptionID
+	}
+	return Snapshot{
+		At:           entry.FetchedAt,
+		GroupFieldID: entry.GroupField.ID,
+		Columns:      columns,
+	}
+}
+
+// HistoryPath returns the snapshot history file path for a project.
+func HistoryPath(owner string, number int) (string, error) {
+	path, err := Path(owner, number)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(path, ".json") + ".history.jsonl", nil
+}
+
+// LoadHistory reads all recorded snapshots for a project, oldest first.
+// Returns an empty slice if no history has been recorded.
+func LoadHistory(owner string, number int) ([]Snapshot, error) {
+	path, err := HistoryPath(owner, number)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+	defer f.Close()
+
+	var history []Snapshot
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var snap Snapshot
+		if err := json.Unmarshal(scanner.Bytes(), &snap); err != nil {
+			// Skip a corrupt line (e.g. from an interrupted write) rather than losing all history
+			continue
+		}
+		history = append(history, snap)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+
+	return history, nil
+}
+
+// AppendHistory records a snapshot for a project.
+// Snapshots identical to the most recent one are skipped since they add no
+// information, and only the newest maxHistory snapshots are kept.
+func AppendHistory(owner string, number int, snap Snapshot) error {
+	history, err := LoadHistory(owner, number)
+	if err != nil {
+		return err
+	}
+
+	if n := len(history); n > 0 {
+		last := history[n-1]
+		if last.GroupFieldID == snap.GroupFieldID && maps.Equal(last.Columns, snap.Columns) {
+			return nil
+		}
+	}
+
+	history = append(history, snap)
+	if len(history) > maxHistory {
+		history = history[len(history)-maxHistory:]
+	}
+
+	path, err := HistoryPath(owner, number)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	var b strings.Builder
+	for _, s := range history {
+		data, err := json.Marshal(s)
+		if err != nil {
+			return fmt.Errorf("failed to encode history: %w", err)
+		}
+		b.Write(data)
+		b.WriteByte('\n')
+	}
+
+	return writeAtomic(path, []byte(b.String()))
+}
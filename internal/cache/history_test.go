@@ -0,0 +1,41 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendHistory_SkipsUnchanged(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	entry := createTestEntry()
+	snap := NewSnapshot(entry)
+	assert.Equal(t, map[string]string{"item_1": "opt_todo"}, snap.Columns)
+
+	require.NoError(t, AppendHistory("TestOrg", 3, snap))
+
+	// Same columns an hour later adds nothing
+	same := snap
+	same.At = snap.At.Add(time.Hour)
+	require.NoError(t, AppendHistory("TestOrg", 3, same))
+
+	moved := Snapshot{At: snap.At.Add(2 * time.Hour), GroupFieldID: snap.GroupFieldID, Columns: map[string]string{"item_1": "opt_done"}}
+	require.NoError(t, AppendHistory("TestOrg", 3, moved))
+
+	history, err := LoadHistory("testorg", 3)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, "opt_todo", history[0].Columns["item_1"])
+	assert.Equal(t, "opt_done", history[1].Columns["item_1"])
+}
+
+func TestLoadHistory_Empty(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	history, err := LoadHistory("nobody", 1)
+	require.NoError(t, err)
+	assert.Empty(t, history)
+}
@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PresenceTTL is how long a session's presence counts after its last heartbeat.
+// Sessions that exit without cleaning up drop out after this.
+const PresenceTTL = 30 * time.Second
+
+// Presence announces a running ghp session on a project to other local sessions,
+// so they can warn about changes that may conflict with their optimistic state.
+type Presence struct {
+	PID       int       // Process ID of the session
+	Pending   int       // Mutations sent but not yet confirmed by GitHub
+	UpdatedAt time.Time // Last heartbeat
+}
+
+// PresenceDir returns the directory holding presence files for a project.
+func PresenceDir(owner string, number int) (string, error) {
+	path, err := Path(owner, number)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(path, ".json") + ".presence", nil
+}
+
+// WritePresence records this session's presence, one file per process.
+func WritePresence(owner string, number int, p Presence) error {
+	dir, err := PresenceDir(owner, number)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create presence directory: %w", err)
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("failed to encode presence: %w", err)
+	}
+	return writeAtomic(filepath.Join(dir, strconv.Itoa(p.PID)+".json"), data)
+}
+
+// RemovePresence deletes a session's presence file, if any.
+func RemovePresence(owner string, number int, pid int) error {
+	dir, err := PresenceDir(owner, number)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(filepath.Join(dir, strconv.Itoa(pid)+".json"))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove presence: %w", err)
+	}
+	return nil
+}
+
+// OtherPresences returns the live sessions on a project other than pid.
+// Presence files older than PresenceTTL are ignored and removed.
+func OtherPresences(owner string, number int, pid int, now time.Time) ([]Presence, error) {
+	dir, err := PresenceDir(owner, number)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read presence: %w", err)
+	}
+
+	var others []Presence
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".json") || strings.HasPrefix(f.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(dir, f.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		var p Presence
+		if err := json.Unmarshal(data, &p); err != nil || p.PID == pid {
+			continue
+		}
+		if now.Sub(p.UpdatedAt) > PresenceTTL {
+			// Best effort: a session that crashed leaves its file behind
+			_ = os.Remove(path)
+			continue
+		}
+		others = append(others, p)
+	}
+	return others, nil
+}
@@ -0,0 +1,36 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPresence_OtherSessions(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	now := time.Now()
+
+	require.NoError(t, WritePresence("TestOrg", 3, Presence{PID: 100, Pending: 0, UpdatedAt: now}))
+	require.NoError(t, WritePresence("testorg", 3, Presence{PID: 200, Pending: 2, UpdatedAt: now}))
+	require.NoError(t, WritePresence("testorg", 3, Presence{PID: 300, UpdatedAt: now.Add(-2 * PresenceTTL)}))
+	require.NoError(t, WritePresence("testorg", 4, Presence{PID: 400, UpdatedAt: now}))
+
+	others, err := OtherPresences("testorg", 3, 100, now)
+	require.NoError(t, err)
+	require.Len(t, others, 1, "Own and stale sessions are skipped")
+	assert.Equal(t, 200, others[0].PID)
+	assert.Equal(t, 2, others[0].Pending)
+
+	// Stale files are cleaned up
+	dir, err := PresenceDir("testorg", 3)
+	require.NoError(t, err)
+	assert.NoFileExists(t, dir+"/300.json")
+
+	require.NoError(t, RemovePresence("testorg", 3, 200))
+	others, err = OtherPresences("testorg", 3, 100, now)
+	require.NoError(t, err)
+	assert.Empty(t, others)
+	assert.NoError(t, RemovePresence("testorg", 3, 200), "Removing twice is fine")
+}
@@ -0,0 +1,94 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/h0rv/ghp/internal/atomicfile"
+)
+
+// Preset is a named combination of board filters and sort, such as
+// "My bugs", recalled from the board's preset menu. Pinned presets also
+// show in the quick filter bar under the board's header.
+type Preset struct {
+	Name   string
+	Filter string // Text filter
+	MyOnly bool   // Only items assigned to the viewer
+	Label  string // Only items with this label, ignoring case
+	Sort   string // Board sort key, "" for project order
+	Pinned bool   // In the quick filter bar, applied with alt+number
+}
+
+// SavePreset adds p to the settings file's Presets, replacing the one with
+// the same name (ignoring case). The rest of the file is kept, though its
+// keys are rewritten in sorted order.
+func SavePreset(p Preset) error {
+	path, err := SettingsPath()
+	if err != nil {
+		return err
+	}
+
+	raw := make(map[string]json.RawMessage)
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return fmt.Errorf("failed to read settings: %w", err)
+	default:
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return fmt.Errorf("failed to decode settings: %w", err)
+		}
+	}
+
+	// Presets may be stored under any case of the key, like the other settings
+	key := "Presets"
+	for k := range raw {
+		if strings.EqualFold(k, key) {
+			key = k
+		}
+	}
+	var presets []Preset
+	if existing, ok := raw[key]; ok {
+		if err := json.Unmarshal(existing, &presets); err != nil {
+			return fmt.Errorf("failed to decode presets: %w", err)
+		}
+	}
+	replaced := false
+	for i := range presets {
+		if strings.EqualFold(presets[i].Name, p.Name) {
+			presets[i], replaced = p, true
+		}
+	}
+	if !replaced {
+		presets = append(presets, p)
+	}
+
+	if raw[key], err = json.Marshal(presets); err != nil {
+		return fmt.Errorf("failed to encode presets: %w", err)
+	}
+	data, err = json.MarshalIndent(raw, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode settings: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	// The rest of the file is the user's; replace it whole or not at all,
+	// keeping its permissions and writing through a symlinked dotfile
+	perm := os.FileMode(0o644)
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		path = resolved
+	}
+	if info, err := os.Stat(path); err == nil {
+		perm = info.Mode().Perm()
+	}
+	if err := atomicfile.WriteFile(path, append(data, '\n'), perm); err != nil {
+		return fmt.Errorf("failed to write settings: %w", err)
+	}
+	return nil
+}
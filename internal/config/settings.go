@@ -0,0 +1,114 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Settings are the user's defaults from config.json in ghp's config
+// directory. Flags and GHP_* environment variables take precedence.
+type Settings struct {
+	Owner         string // Default owner login
+	Project       int    // Default project number (with Owner)
+	GroupField    string // Default grouping field name
+	AltGroupField string // Grouping field the board toggles to with F
+	PageSize      int    // Items fetched per request, 0 for the built-in default
+	Resume        bool   // Reopen the last board when no project is given
+
+	// Project to open per repository ("owner/name"), used when ghp runs
+	// inside a clone of it. Takes precedence over Owner and Project.
+	Repos map[string]RepoDefaults
+
+	// Named filter and sort combinations for the board's preset menu
+	Presets []Preset
+
+	UI UISettings
+}
+
+// RepoDefaults is the project a repository's clones open.
+type RepoDefaults struct {
+	Owner      string
+	Project    int
+	GroupField string
+}
+
+// UISettings are board display preferences.
+type UISettings struct {
+	ReducedMotion    bool // Static loading text instead of spinners
+	IgnoreDiacritics bool // Filters ignore accents
+	StaleDays        int  // Dim cards not updated in this many days, 0 to never dim
+	AutoRefresh      int  // Refetch the board every this many seconds, 0 to never
+	Scrollbar        bool // Columns show a scrollbar instead of "↑/↓ N more"
+	MinColumnWidth   int  // Narrowest a column gets before the board scrolls sideways, 0 for 20
+	MaxColumnWidth   int  // Widest a column gets on wide terminals, 0 for 35
+
+	// Header status segments in order, empty for the default layout
+	StatusBar []string
+
+	// Color of the selected column border and board title per project
+	// ("owner/number"), as an ANSI color number or "#rrggbb"
+	Accents map[string]string
+}
+
+// SettingsPath returns the settings file path, honoring XDG_CONFIG_HOME.
+func SettingsPath() (string, error) {
+	base, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate config directory: %w", err)
+	}
+	return filepath.Join(base, "ghp", "config.json"), nil
+}
+
+// LoadSettings reads the settings file. A missing file gives empty Settings.
+// Unknown keys and invalid values are returned as problems and left at their
+// defaults.
+func LoadSettings() (*Settings, Problems, error) {
+	path, err := SettingsPath()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &Settings{}, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read settings: %w", err)
+	}
+
+	var s Settings
+	problems, err := Decode(path, data, &s)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode settings: %w", err)
+	}
+
+	if s.Project > 0 && s.Owner == "" {
+		problems = append(problems, Problem{File: path, Msg: "Project needs an Owner and is ignored"})
+		s.Project = 0
+	}
+	for repo, d := range s.Repos {
+		if d.Owner == "" || d.Project <= 0 {
+			problems = append(problems, Problem{File: path, Msg: fmt.Sprintf("Repos entry %q needs an Owner and Project and is ignored", repo)})
+			delete(s.Repos, repo)
+		}
+	}
+	return &s, problems, nil
+}
+
+// ForRepo returns the project defaults for a repository ("owner/name",
+// matched case-insensitively), falling back to the global ones. ok is false
+// when neither names a project owner.
+func (s *Settings) ForRepo(repo string) (d RepoDefaults, ok bool) {
+	if repo != "" {
+		for name, rd := range s.Repos {
+			if strings.EqualFold(name, repo) {
+				return rd, true
+			}
+		}
+	}
+	d = RepoDefaults{Owner: s.Owner, Project: s.Project, GroupField: s.GroupField}
+	return d, d.Owner != ""
+}
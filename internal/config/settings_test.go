@@ -0,0 +1,99 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadSettings(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+
+	s, problems, err := LoadSettings()
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+	_, ok := s.ForRepo("")
+	assert.False(t, ok, "No file, no defaults")
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "ghp"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ghp", "config.json"), []byte(`{
+  "Owner": "myorg",
+  "Project": 1,
+  "PageSize": 50,
+  "Repos": {
+    "myorg/api": {"Owner": "myorg", "Project": 4, "GroupField": "Stage"},
+    "myorg/web": {"Project": 5}
+  },
+  "UI": {"StaleDays": 14, "ReducedMotoin": true}
+}`), 0o644))
+
+	s, problems, err = LoadSettings()
+	require.NoError(t, err)
+	assert.Equal(t, 50, s.PageSize)
+	assert.Equal(t, 14, s.UI.StaleDays)
+	require.Len(t, problems, 2)
+	assert.Contains(t, problems[0].Msg, `unknown key "ReducedMotoin"`)
+	assert.Equal(t, "ReducedMotion", problems[0].Suggestion)
+	assert.Contains(t, problems[1].Msg, `"myorg/web" needs an Owner and Project`)
+
+	d, ok := s.ForRepo("MyOrg/API")
+	assert.True(t, ok)
+	assert.Equal(t, RepoDefaults{Owner: "myorg", Project: 4, GroupField: "Stage"}, d)
+
+	d, ok = s.ForRepo("myorg/web")
+	assert.True(t, ok)
+	assert.Equal(t, RepoDefaults{Owner: "myorg", Project: 1}, d, "Invalid and unknown repos use the global defaults")
+}
+
+func TestSavePreset(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "ghp"), 0o755))
+	target := filepath.Join(dir, "dotfiles.json")
+	require.NoError(t, os.WriteFile(target, []byte(`{"Owner": "myorg", "presets": [{"Name": "Mine", "MyOnly": true}]}`), 0o600))
+	require.NoError(t, os.Symlink(target, filepath.Join(dir, "ghp", "config.json")))
+
+	require.NoError(t, SavePreset(Preset{Name: "My bugs", MyOnly: true, Label: "bug", Sort: "-updated"}))
+	require.NoError(t, SavePreset(Preset{Name: "mine", Filter: "api"}))
+
+	s, problems, err := LoadSettings()
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+	assert.Equal(t, "myorg", s.Owner, "Other settings are kept")
+	assert.Equal(t, []Preset{
+		{Name: "mine", Filter: "api"},
+		{Name: "My bugs", MyOnly: true, Label: "bug", Sort: "-updated"},
+	}, s.Presets, "Saving a preset by an existing name replaces it")
+
+	info, err := os.Lstat(filepath.Join(dir, "ghp", "config.json"))
+	require.NoError(t, err)
+	assert.Equal(t, os.ModeSymlink, info.Mode().Type(), "A symlinked config stays a symlink")
+	info, err = os.Stat(target)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm(), "Permissions are kept")
+}
+
+func TestLoadTemplates(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	templates, err := LoadTemplates()
+	require.NoError(t, err)
+	assert.Empty(t, templates, "No templates directory is fine")
+
+	tdir := filepath.Join(dir, "ghp", "templates")
+	require.NoError(t, os.MkdirAll(tdir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(tdir, "bug.md"), []byte("---\nname: Bug report\ntitle: \"[bug] \"\nlabels: bug\n---\n\nSteps to reproduce:\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(tdir, "chore.md"), []byte("Checklist:\n- [ ] done\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(tdir, "notes.txt"), []byte("not a template"), 0o644))
+
+	templates, err = LoadTemplates()
+	require.NoError(t, err)
+	assert.Equal(t, []Template{
+		{Name: "Bug report", Title: "[bug] ", Body: "Steps to reproduce:\n"},
+		{Name: "chore", Body: "Checklist:\n- [ ] done\n"},
+	}, templates)
+}
@@ -0,0 +1,115 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Template pre-fills the board's new item form. Local templates are markdown
+// files in TemplatesDir. Like a repository's issue templates, a file may
+// start with front matter naming the template and its default title:
+//
+//	---
+//	name: Bug report
+//	title: "[bug] "
+//	---
+//	Steps to reproduce: ...
+type Template struct {
+	Name  string // From front matter, else the file name without ".md"
+	Title string
+	Body  string
+}
+
+// TemplatesDir returns the directory of local templates, beside the settings
+// file.
+func TemplatesDir() (string, error) {
+	path, err := SettingsPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(path), "templates"), nil
+}
+
+// LoadTemplates reads the local templates, sorted by file name. A missing
+// directory gives none.
+func LoadTemplates() ([]Template, error) {
+	dir, err := TemplatesDir()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read templates: %w", err)
+	}
+
+	var names []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.EqualFold(filepath.Ext(e.Name()), ".md") {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+
+	templates := make([]Template, 0, len(names))
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read template: %w", err)
+		}
+		templates = append(templates, ParseTemplate(strings.TrimSuffix(name, filepath.Ext(name)), string(data)))
+	}
+	return templates, nil
+}
+
+// ParseTemplate reads a template file's front matter and body. name is used
+// when the front matter doesn't give one. Other front matter keys, such as
+// labels and about, are ignored.
+func ParseTemplate(name, data string) Template {
+	t := Template{Name: name, Body: data}
+	data = strings.ReplaceAll(data, "\r\n", "\n")
+	rest, ok := strings.CutPrefix(data, "---\n")
+	if !ok {
+		return t
+	}
+	front, body, ok := strings.Cut(rest, "\n---")
+	if !ok {
+		return t
+	}
+	// The closing line may end the file
+	if body, ok = strings.CutPrefix(body, "\n"); !ok && body != "" {
+		return t
+	}
+
+	t.Body = strings.TrimLeft(body, "\n")
+	for _, line := range strings.Split(front, "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		value = unquote(strings.TrimSpace(value))
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "name":
+			if value != "" {
+				t.Name = value
+			}
+		case "title":
+			t.Title = value
+		}
+	}
+	return t
+}
+
+// unquote removes one pair of matching quotes around a front matter value
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,222 @@
+// Package config reads the user's settings file and validates the JSON files
+// ghp reads its settings from, so a typo produces a precise warning instead
+// of being silently ignored.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/h0rv/ghp/internal/suggest"
+)
+
+// Problem is a mistake found in a config file that ghp worked around.
+type Problem struct {
+	File       string // Path of the file
+	Line       int    // 1-based line, 0 when unknown
+	Msg        string // What is wrong
+	Suggestion string // Likely intended value, "" if none
+}
+
+func (p Problem) String() string {
+	s := p.File
+	if p.Line > 0 {
+		s = fmt.Sprintf("%s:%d", s, p.Line)
+	}
+	s += ": " + p.Msg
+	if p.Suggestion != "" {
+		s += fmt.Sprintf(" (did you mean %q?)", p.Suggestion)
+	}
+	return s
+}
+
+// Problems lists the problems found in one or more files.
+type Problems []Problem
+
+func (ps Problems) Error() string {
+	lines := make([]string, len(ps))
+	for i, p := range ps {
+		lines[i] = p.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Decode unmarshals JSON data read from file into v, which must be a pointer.
+// Syntax errors are fatal and carry the line and column. Unknown keys and values
+// of the wrong type are returned as problems; the fields they affect keep their
+// defaults so the rest of the file still applies.
+func Decode(file string, data []byte, v any) (Problems, error) {
+	var problems Problems
+
+	if err := json.Unmarshal(data, v); err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+		switch {
+		case errors.As(err, &syntaxErr):
+			line, col := position(data, syntaxErr.Offset)
+			return nil, fmt.Errorf("%s:%d:%d: %v", file, line, col, syntaxErr)
+		case errors.As(err, &typeErr):
+			// Unmarshal still fills in everything else
+			name := typeErr.Field
+			if name == "" {
+				name = "value"
+			}
+			line, _ := position(data, typeErr.Offset)
+			problems = append(problems, Problem{
+				File: file,
+				Line: line,
+				Msg:  fmt.Sprintf("%s must be %s, not %s; using the default", name, describeType(typeErr.Type), typeErr.Value),
+			})
+		default:
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+	}
+
+	w := walker{file: file, data: data, dec: json.NewDecoder(bytes.NewReader(data))}
+	if err := w.value(reflect.TypeOf(v)); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	problems = append(problems, w.problems...)
+
+	sort.SliceStable(problems, func(i, j int) bool { return problems[i].Line < problems[j].Line })
+	return problems, nil
+}
+
+// walker finds object keys that don't match any field of the target type
+type walker struct {
+	file     string
+	data     []byte
+	dec      *json.Decoder
+	problems Problems
+}
+
+// value consumes one JSON value, checking keys against t (nil skips checks)
+func (w *walker) value(t reflect.Type) error {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	tok, err := w.dec.Token()
+	if err != nil {
+		return err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		for w.dec.More() {
+			keyTok, err := w.dec.Token()
+			if err != nil {
+				return err
+			}
+			key, _ := keyTok.(string)
+
+			var next reflect.Type
+			switch {
+			case t == nil:
+			case t.Kind() == reflect.Map:
+				next = t.Elem()
+			case t.Kind() == reflect.Struct:
+				if f, ok := fieldByKey(t, key); ok {
+					next = f.Type
+				} else {
+					line, _ := position(w.data, w.dec.InputOffset())
+					w.problems = append(w.problems, Problem{
+						File:       w.file,
+						Line:       line,
+						Msg:        fmt.Sprintf("unknown key %q is ignored", key),
+						Suggestion: suggest.Closest(key, fieldKeys(t)),
+					})
+				}
+			}
+			if err := w.value(next); err != nil {
+				return err
+			}
+		}
+		_, err = w.dec.Token() // '}'
+		return err
+
+	case json.Delim('['):
+		var elem reflect.Type
+		if t != nil && (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+			elem = t.Elem()
+		}
+		for w.dec.More() {
+			if err := w.value(elem); err != nil {
+				return err
+			}
+		}
+		_, err = w.dec.Token() // ']'
+		return err
+	}
+	return nil
+}
+
+// fieldKey returns the JSON key of a struct field, or "" if it is not decoded
+func fieldKey(f reflect.StructField) string {
+	if !f.IsExported() {
+		return ""
+	}
+	tag, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	switch tag {
+	case "-":
+		return ""
+	case "":
+		return f.Name
+	}
+	return tag
+}
+
+// fieldKeys returns the JSON keys of a struct's fields
+func fieldKeys(t reflect.Type) []string {
+	var keys []string
+	for i := 0; i < t.NumField(); i++ {
+		if key := fieldKey(t.Field(i)); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// fieldByKey finds the field a key decodes into, matching case-insensitively like encoding/json
+func fieldByKey(t reflect.Type, key string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		if name := fieldKey(t.Field(i)); name != "" && strings.EqualFold(name, key) {
+			return t.Field(i), true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// position converts a byte offset into a 1-based line and column
+func position(data []byte, offset int64) (line, col int) {
+	offset = min(max(offset, 0), int64(len(data)))
+	before := data[:offset]
+	line = bytes.Count(before, []byte("\n")) + 1
+	col = int(offset) - bytes.LastIndexByte(before, '\n')
+	return line, col
+}
+
+// describeType names a Go type the way a config author would
+func describeType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Bool:
+		return "true or false"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "a whole number"
+	case reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.String:
+		return "a string"
+	case reflect.Slice, reflect.Array:
+		return "a list"
+	case reflect.Map, reflect.Struct:
+		return "an object"
+	}
+	return t.String()
+}
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testSettings struct {
+	Name    string
+	Count   int
+	Enabled bool
+	Tags    []string
+	Nested  []struct {
+		Title string `json:"title"`
+	}
+	Extra map[string]string
+}
+
+func TestDecode_UnknownKeys(t *testing.T) {
+	data := []byte(`{
+  "Name": "board",
+  "Cuont": 3,
+  "nested": [
+    {"title": "a", "titel": "b"}
+  ],
+  "Extra": {"anything": "goes"},
+  "Wholly": true
+}`)
+
+	var s testSettings
+	problems, err := Decode("settings.json", data, &s)
+	require.NoError(t, err)
+	assert.Equal(t, "board", s.Name, "Known keys still apply")
+	assert.Equal(t, "a", s.Nested[0].Title)
+
+	require.Len(t, problems, 3)
+	assert.Equal(t, `settings.json:3: unknown key "Cuont" is ignored (did you mean "Count"?)`, problems[0].String())
+	assert.Equal(t, 5, problems[1].Line)
+	assert.Equal(t, "title", problems[1].Suggestion)
+	assert.Equal(t, `settings.json:8: unknown key "Wholly" is ignored`, problems[2].String(), "No suggestion when nothing is close")
+}
+
+func TestDecode_InvalidValues(t *testing.T) {
+	data := []byte("{\n  \"Name\": \"board\",\n  \"Count\": \"three\"\n}")
+
+	var s testSettings
+	problems, err := Decode("settings.json", data, &s)
+	require.NoError(t, err)
+	assert.Equal(t, "board", s.Name)
+	assert.Zero(t, s.Count, "Invalid values fall back to the default")
+	require.Len(t, problems, 1)
+	assert.Equal(t, 3, problems[0].Line)
+	assert.Contains(t, problems[0].Msg, "Count must be a whole number, not string")
+
+	_, err = Decode("settings.json", []byte("{\n  \"Name\": \"board\"\n  \"Count\": 1\n}"), &s)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "settings.json:3:", "Syntax errors point at the line and column")
+}
@@ -8,6 +8,10 @@ type Project struct {
 	Number int    // Project number within the owner's namespace
 	Title  string // Project title
 	Owner  string // Owner login (organization or user)
+
+	// Only filled in by project listings
+	ItemCount int    // Number of items in the project
+	UpdatedAt string // ISO 8601 timestamp of the last change
 }
 
 // FieldDef represents a project field definition with its metadata.
@@ -17,6 +21,10 @@ type FieldDef struct {
 	Type    string   // Field type (e.g., "SINGLE_SELECT", "TEXT", etc.)
 	Options []Option // Available options for SINGLE_SELECT fields
 	Order   int      // Field order in the project (from API response order)
+
+	// Active and upcoming iterations for ITERATION fields, named
+	// "Title (start date)"
+	Iterations []Option
 }
 
 // Option represents a single option value for a SINGLE_SELECT field.
@@ -29,28 +37,148 @@ type Option struct {
 
 // Card represents a project item (Issue, PR, or Draft) in a normalized format.
 type Card struct {
-	ItemID        string   // GitHub ProjectV2Item node ID
-	ContentType   string   // Type: "Issue", "PullRequest", "DraftIssue", or "Private"
-	Title         string   // Item title
-	URL           string   // Item URL (may be empty for drafts or private items)
-	Repo          string   // Repository nameWithOwner (e.g., "owner/repo"), only for Issue/PR
-	Number        int      // Issue/PR number, only for Issue/PR (0 for drafts/private)
-	GroupOptionID string   // Current value of the grouping field (option ID), empty if unset
-	Assignees     []string // Login names of assigned users
-	Body          string   // Issue/PR body (for detail view)
-	State         string   // Issue/PR state (OPEN, CLOSED, MERGED)
-	Labels        []string // Label names
-	Author        string   // Author login (issue/PR creator)
-	CreatedAt     string   // ISO8601 timestamp of creation
+	ItemID        string    // GitHub ProjectV2Item node ID
+	ContentID     string    // Node ID of the underlying Issue, PR, or DraftIssue (empty for private items)
+	ContentType   string    // Type: "Issue", "PullRequest", "DraftIssue", "Restricted", or "Private"
+	Title         string    // Item title
+	URL           string    // Item URL (may be empty for drafts or private items)
+	Repo          string    // Repository nameWithOwner (e.g., "owner/repo"), only for Issue/PR
+	Number        int       // Issue/PR number, only for Issue/PR (0 for drafts/private)
+	GroupOptionID string    // Current value of the grouping field (option ID), empty if unset
+	Assignees     []string  // Login names of assigned users
+	AuthorIsBot   bool      // Created by a bot or GitHub App (e.g., dependabot)
+	State         string    // Issue/PR state (OPEN, CLOSED, MERGED)
+	AccessHint    string    // Why a restricted item is unreadable and how to regain access
+	Parent        *IssueRef // Parent issue tracking this one, nil if none
+	PR            *PRStatus // Review and CI status, only for PRs
+
+	// Details, not part of item listings (see gh.GetItemDetails and ApplyDetails)
+	Body      string   // Issue/PR body
+	Labels    []string // Label names
+	Author    string   // Author login (issue/PR creator)
+	CreatedAt string   // ISO8601 timestamp of creation
+	UpdatedAt string   // ISO8601 timestamp of the last content update
+
+	// Single-select field values: field name -> option ID.
+	// Used to sort by fields other than the grouping field.
+	FieldValues map[string]string
+}
+
+// IssueRef identifies an issue outside of a card, such as a card's parent.
+type IssueRef struct {
+	Repo   string // Repository nameWithOwner
+	Number int
+	Title  string
+}
+
+// PRStatus is the review, CI, and merge state of a pull request.
+type PRStatus struct {
+	Draft          bool
+	ReviewDecision string // APPROVED, CHANGES_REQUESTED, REVIEW_REQUIRED, or empty
+	Checks         string // Combined state of the head commit's checks, empty if it has none
+	Mergeable      string // MERGEABLE, CONFLICTING, or UNKNOWN
+}
+
+// Check is one CI check run or commit status on a pull request's head commit.
+type Check struct {
+	Name  string
+	State string // SUCCESS, FAILURE, PENDING, or another check conclusion
+	URL   string // Details page, may be empty
+}
+
+// LinkedItem is an issue or pull request connected to another one, by a
+// closing reference or a mention.
+type LinkedItem struct {
+	ContentID   string // Issue or PR node ID
+	ContentType string // ContentTypeIssue or ContentTypePullRequest
+	Repo        string // Repository nameWithOwner
+	Number      int
+	Title       string
+	State       string // OPEN, CLOSED, or MERGED
+	URL         string
+	Relation    string // "closes", "closed by", or "mentioned in"
+}
+
+// ItemDetails holds the parts of an item's content that listings leave out.
+type ItemDetails struct {
+	Body        string
+	Labels      []string
+	Author      string
+	AuthorIsBot bool
+	CreatedAt   string
+	UpdatedAt   string
+}
+
+// ApplyDetails copies fetched details onto the card.
+func (c *Card) ApplyDetails(d ItemDetails) {
+	c.Body = d.Body
+	c.Labels = d.Labels
+	c.Author = d.Author
+	c.AuthorIsBot = d.AuthorIsBot
+	c.CreatedAt = d.CreatedAt
+	c.UpdatedAt = d.UpdatedAt
+}
+
+// ProjectInfo is the part of a project meant to orient people new to it.
+type ProjectInfo struct {
+	ShortDescription string
+	Readme           string // Markdown
+	URL              string
+	Public           bool
+	Workflows        []Workflow
+}
+
+// Workflow is a built-in project automation, such as "Item closed".
+type Workflow struct {
+	Name    string
+	Enabled bool
+}
+
+// FieldValue is an item's value for one project field.
+type FieldValue struct {
+	FieldID string // Field node ID
+	Field   string // Field name
+	Type    string // Field type (e.g., "TEXT", "DATE")
+	Text    string // Value as shown: text, number, date, option name, or iteration title
+	ID      string // Option or iteration ID, only for SINGLE_SELECT and ITERATION
 }
 
 // Comment represents a comment on an Issue or PR.
 type Comment struct {
-	ID        string // GitHub comment node ID
-	Author    string // Author login (may be empty if user deleted)
-	Body      string // Comment body text
-	CreatedAt string // ISO8601 timestamp
-	UpdatedAt string // ISO8601 timestamp
+	ID          string // GitHub comment node ID
+	URL         string // Permalink, including the #issuecomment-N anchor
+	Author      string // Author login (may be empty if user deleted)
+	AuthorIsBot bool   // Author is a bot or GitHub App
+	Body        string // Comment body text
+	CreatedAt   string // ISO8601 timestamp
+	UpdatedAt   string // ISO8601 timestamp
+}
+
+// CommentPage is a page of an issue or pull request's comments, oldest
+// first. Pages are fetched from the newest back.
+type CommentPage struct {
+	Comments    []Comment
+	OlderCursor string         // Cursor for the page before, "" when this one is the oldest
+	Threads     []ReviewThread // Pull request review threads, on the newest page only
+}
+
+// ReviewThread is a pull request review conversation on a line of a file.
+type ReviewThread struct {
+	Path       string // File path in the repository
+	Line       int    // Line in the file, 0 when the thread is outdated
+	IsResolved bool
+	IsOutdated bool      // The code changed since the thread started
+	Comments   []Comment // The first starts the thread; the rest reply
+}
+
+// PRMeta holds review and lifecycle metadata for a pull request.
+type PRMeta struct {
+	ContentID      string // Pull request node ID
+	CreatedAt      string // ISO8601 timestamp of creation
+	ClosedAt       string // ISO8601 timestamp of close or merge, empty if open
+	State          string // OPEN, CLOSED, or MERGED
+	ReviewCount    int    // Number of submitted reviews
+	ReviewDecision string // APPROVED, CHANGES_REQUESTED, REVIEW_REQUIRED, or empty
 }
 
 // FieldType constants for commonly used field types.
@@ -67,5 +195,6 @@ const (
 	ContentTypeIssue       = "Issue"
 	ContentTypePullRequest = "PullRequest"
 	ContentTypeDraftIssue  = "DraftIssue"
+	ContentTypeRestricted  = "Restricted" // Content the token may not read (SAML SSO, private repo)
 	ContentTypePrivate     = "Private"
 )
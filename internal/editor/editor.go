@@ -0,0 +1,176 @@
+// Package editor round-trips project items through the user's $EDITOR.
+// A card is rendered as a markdown document with YAML-style front-matter,
+// and the edited document is parsed back into a title and body.
+package editor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/h0rv/ghp/internal/domain"
+)
+
+// frontMatterDelim separates the front-matter block from the body.
+const frontMatterDelim = "---"
+
+// ErrNoFrontMatter indicates the edited document lost its front-matter block.
+var ErrNoFrontMatter = errors.New("document has no front-matter block")
+
+// Command returns the editor command line configured by the user.
+// $VISUAL takes precedence over $EDITOR, falling back to vi.
+func Command() string {
+	if v := strings.TrimSpace(os.Getenv("VISUAL")); v != "" {
+		return v
+	}
+	if e := strings.TrimSpace(os.Getenv("EDITOR")); e != "" {
+		return e
+	}
+	return "vi"
+}
+
+// Cmd builds an exec.Cmd that opens path in the user's editor.
+// The editor command may include arguments (e.g. "code --wait").
+func Cmd(path string) *exec.Cmd {
+	parts := strings.Fields(Command())
+	args := append(parts[1:], path)
+	return exec.Command(parts[0], args...)
+}
+
+// Render formats a card as a markdown document for editing.
+// Only the title and body are synced back; the remaining front-matter keys
+// are informational and ignored by Parse.
+func Render(card *domain.Card) string {
+	var b strings.Builder
+
+	b.WriteString(frontMatterDelim + "\n")
+	fmt.Fprintf(&b, "title: %s\n", card.Title)
+	fmt.Fprintf(&b, "type: %s\n", card.ContentType)
+	if card.Repo != "" {
+		fmt.Fprintf(&b, "repo: %s\n", card.Repo)
+	}
+	if card.Number > 0 {
+		fmt.Fprintf(&b, "number: %d\n", card.Number)
+	}
+	if card.State != "" {
+		fmt.Fprintf(&b, "state: %s\n", card.State)
+	}
+	if card.URL != "" {
+		fmt.Fprintf(&b, "url: %s\n", card.URL)
+	}
+	if len(card.Assignees) > 0 {
+		fmt.Fprintf(&b, "assignees: %s\n", strings.Join(card.Assignees, ", "))
+	}
+	if len(card.Labels) > 0 {
+		fmt.Fprintf(&b, "labels: %s\n", strings.Join(card.Labels, ", "))
+	}
+	b.WriteString(frontMatterDelim + "\n\n")
+	b.WriteString(card.Body)
+	if card.Body != "" && !strings.HasSuffix(card.Body, "\n") {
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// Parse extracts the title and body from an edited document.
+// Returns ErrNoFrontMatter if the front-matter block is missing or unterminated,
+// and an error if the title was removed.
+func Parse(content string) (title string, body string, err error) {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	lines := strings.Split(content, "\n")
+
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != frontMatterDelim {
+		return "", "", ErrNoFrontMatter
+	}
+
+	end := -1
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == frontMatterDelim {
+			end = i
+			break
+		}
+		key, value, ok := strings.Cut(lines[i], ":")
+		if ok && strings.TrimSpace(key) == "title" {
+			title = strings.TrimSpace(value)
+		}
+	}
+	if end < 0 {
+		return "", "", ErrNoFrontMatter
+	}
+	if title == "" {
+		return "", "", errors.New("title must not be empty")
+	}
+
+	body = strings.Join(lines[end+1:], "\n")
+	body = strings.TrimSpace(body)
+
+	return title, body, nil
+}
+
+// WriteTemp writes the rendered card to a new temporary markdown file and
+// returns its path. The caller is responsible for removing the file.
+func WriteTemp(card *domain.Card) (string, error) {
+	f, err := os.CreateTemp("", "ghp-item-*.md")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(Render(card)); err != nil {
+		os.Remove(f.Name())
+		return "", fmt.Errorf("failed to write temp file: %w", err)
+	}
+
+	return f.Name(), nil
+}
+
+// ReadTemp reads and parses an edited temporary file.
+func ReadTemp(path string) (title string, body string, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read edited file: %w", err)
+	}
+	return Parse(string(data))
+}
+
+// WriteDraft writes text being composed, such as a comment, to a new
+// temporary markdown file and returns its path. Unlike WriteTemp there is no
+// front-matter. The caller is responsible for removing the file.
+func WriteDraft(text string) (string, error) {
+	f, err := os.CreateTemp("", "ghp-draft-*.md")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(text); err != nil {
+		os.Remove(f.Name())
+		return "", fmt.Errorf("failed to write temp file: %w", err)
+	}
+
+	return f.Name(), nil
+}
+
+// ReadDraft reads back a file from WriteDraft, without the final newline
+// most editors add.
+func ReadDraft(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read edited file: %w", err)
+	}
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+	return strings.TrimSuffix(text, "\n"), nil
+}
+
+// Editable reports whether a card's content can be edited.
+// Private items have no accessible content to update.
+func Editable(card *domain.Card) bool {
+	switch card.ContentType {
+	case domain.ContentTypeIssue, domain.ContentTypePullRequest, domain.ContentTypeDraftIssue:
+		return card.ContentID != ""
+	}
+	return false
+}
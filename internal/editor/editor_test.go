@@ -0,0 +1,117 @@
+package editor
+
+import (
+	"os"
+	"testing"
+
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestCard() *domain.Card {
+	return &domain.Card{
+		ItemID:      "item_1",
+		ContentID:   "issue_1",
+		ContentType: domain.ContentTypeIssue,
+		Title:       "Fix: the bug",
+		Body:        "Steps to reproduce\n\n1. run it",
+		Repo:        "test/repo",
+		Number:      42,
+		State:       "OPEN",
+		Assignees:   []string{"alice", "bob"},
+		Labels:      []string{"bug"},
+	}
+}
+
+func TestRender_IncludesFrontMatter(t *testing.T) {
+	doc := Render(createTestCard())
+
+	assert.Contains(t, doc, "---\ntitle: Fix: the bug\n")
+	assert.Contains(t, doc, "repo: test/repo\n")
+	assert.Contains(t, doc, "number: 42\n")
+	assert.Contains(t, doc, "assignees: alice, bob\n")
+	assert.Contains(t, doc, "---\n\nSteps to reproduce")
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+	card := createTestCard()
+
+	title, body, err := Parse(Render(card))
+
+	require.NoError(t, err)
+	assert.Equal(t, card.Title, title)
+	assert.Equal(t, card.Body, body)
+}
+
+func TestParse_EditedValues(t *testing.T) {
+	doc := "---\ntitle:  New title \nrepo: ignored/repo\n---\n\nNew body\r\n"
+
+	title, body, err := Parse(doc)
+
+	require.NoError(t, err)
+	assert.Equal(t, "New title", title)
+	assert.Equal(t, "New body", body)
+}
+
+func TestParse_MissingFrontMatter(t *testing.T) {
+	_, _, err := Parse("just a body")
+	assert.ErrorIs(t, err, ErrNoFrontMatter)
+
+	_, _, err = Parse("---\ntitle: unterminated\n")
+	assert.ErrorIs(t, err, ErrNoFrontMatter)
+}
+
+func TestParse_EmptyTitle(t *testing.T) {
+	_, _, err := Parse("---\ntitle:\n---\nbody")
+	assert.Error(t, err)
+}
+
+func TestCommand_Precedence(t *testing.T) {
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", "")
+	assert.Equal(t, "vi", Command())
+
+	t.Setenv("EDITOR", "nano")
+	assert.Equal(t, "nano", Command())
+
+	t.Setenv("VISUAL", "code --wait")
+	assert.Equal(t, "code --wait", Command())
+
+	cmd := Cmd("/tmp/item.md")
+	assert.Equal(t, []string{"code", "--wait", "/tmp/item.md"}, cmd.Args)
+}
+
+func TestWriteTemp_ReadTemp(t *testing.T) {
+	card := createTestCard()
+
+	path, err := WriteTemp(card)
+	require.NoError(t, err)
+	defer os.Remove(path)
+
+	title, body, err := ReadTemp(path)
+	require.NoError(t, err)
+	assert.Equal(t, card.Title, title)
+	assert.Equal(t, card.Body, body)
+}
+
+func TestWriteDraft_ReadDraft(t *testing.T) {
+	path, err := WriteDraft("LGTM\n\n- one nit")
+	require.NoError(t, err)
+	defer os.Remove(path)
+	text, err := ReadDraft(path)
+	require.NoError(t, err)
+	assert.Equal(t, "LGTM\n\n- one nit", text)
+
+	// Editors usually end the file with a newline
+	require.NoError(t, os.WriteFile(path, []byte("LGTM\n\n- one nit\n- another\n"), 0o600))
+	text, err = ReadDraft(path)
+	require.NoError(t, err)
+	assert.Equal(t, "LGTM\n\n- one nit\n- another", text)
+}
+
+func TestEditable(t *testing.T) {
+	assert.True(t, Editable(createTestCard()))
+	assert.False(t, Editable(&domain.Card{ContentType: domain.ContentTypePrivate}))
+	assert.False(t, Editable(&domain.Card{ContentType: domain.ContentTypeIssue}))
+}
@@ -0,0 +1,61 @@
+// Package filter implements the text matching used by board and CLI filters.
+package filter
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Matcher matches text against a filter query.
+//
+// Unquoted queries match case-insensitively using Unicode case folding, and
+// optionally ignore diacritics ("resume" matches "Résumé"). A query wrapped in
+// double quotes is matched literally: case and diacritics must match exactly.
+type Matcher struct {
+	query          string
+	exact          bool
+	foldDiacritics bool
+}
+
+// New creates a Matcher for query.
+// foldDiacritics enables diacritic-insensitive matching for unquoted queries.
+func New(query string, foldDiacritics bool) Matcher {
+	query = strings.TrimSpace(query)
+	if len(query) >= 2 && strings.HasPrefix(query, `"`) && strings.HasSuffix(query, `"`) {
+		return Matcher{query: query[1 : len(query)-1], exact: true}
+	}
+	return Matcher{query: Fold(query, foldDiacritics), foldDiacritics: foldDiacritics}
+}
+
+// Empty reports whether the matcher accepts everything.
+func (m Matcher) Empty() bool {
+	return m.query == ""
+}
+
+// Match reports whether text contains the query.
+func (m Matcher) Match(text string) bool {
+	if m.query == "" {
+		return true
+	}
+	if m.exact {
+		return strings.Contains(text, m.query)
+	}
+	return strings.Contains(Fold(text, m.foldDiacritics), m.query)
+}
+
+// Fold returns s case-folded for comparison, with diacritics removed if requested.
+func Fold(s string, diacritics bool) string {
+	if diacritics {
+		// Decompose accented characters, then drop the combining marks
+		t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+		if stripped, _, err := transform.String(t, s); err == nil {
+			s = stripped
+		}
+	}
+	return cases.Fold().String(s)
+}
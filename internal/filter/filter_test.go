@@ -0,0 +1,92 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatcher(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		text           string
+		foldDiacritics bool
+		want           bool
+	}{
+		{"empty query matches all", "", "anything", false, true},
+		{"case insensitive", "fix BUG", "Fix bug in parser", false, true},
+		{"unicode case folding", "straße", "STRASSE", false, true},
+		{"greek final sigma", "ΟΔΟΣ", "οδος", false, true},
+		{"diacritics kept by default", "resume", "Résumé draft", false, false},
+		{"diacritics folded", "resume", "Résumé draft", true, true},
+		{"accented query folded", "café", "CAFE menu", true, true},
+		{"quoted is exact", `"Fix"`, "fix bug", true, false},
+		{"quoted matches literally", `"Résumé"`, "Update Résumé", true, true},
+		{"quoted keeps diacritics", `"Resume"`, "Update Résumé", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, New(tt.query, tt.foldDiacritics).Match(tt.text))
+		})
+	}
+}
+
+func TestQuery(t *testing.T) {
+	bug := &domain.Card{
+		Title:       "Fix crash on start",
+		ContentType: domain.ContentTypeIssue,
+		Repo:        "myorg/api",
+		State:       "OPEN",
+		Labels:      []string{"Bug", "good first issue"},
+		Assignees:   []string{"alice"},
+	}
+	pr := &domain.Card{
+		Title:       "fix: urgent crash",
+		ContentType: domain.ContentTypePullRequest,
+		Repo:        "myorg/web",
+		State:       "MERGED",
+		PR:          &domain.PRStatus{Draft: true},
+	}
+	draft := &domain.Card{Title: "Urgent idea", ContentType: domain.ContentTypeDraftIssue}
+
+	tests := []struct {
+		query string
+		want  []*domain.Card
+	}{
+		{"", []*domain.Card{bug, pr, draft}},
+		{"urgent", []*domain.Card{pr, draft}},
+		{"label:bug", []*domain.Card{bug}},
+		{`label:"good first issue"`, []*domain.Card{bug}},
+		{"-label:bug", []*domain.Card{pr, draft}},
+		{"assignee:@me", []*domain.Card{bug}},
+		{"assignee:bob,alice crash", []*domain.Card{bug}},
+		{"repo:api", []*domain.Card{bug}},
+		{"repo:myorg/web", []*domain.Card{pr}},
+		{"is:pr", []*domain.Card{pr}},
+		{"is:draft", []*domain.Card{pr, draft}},
+		{"state:open", []*domain.Card{bug, draft}},
+		{"is:issue is:open crash", []*domain.Card{bug}},
+		{"is:merged urgent", []*domain.Card{pr}},
+		{"fix:", []*domain.Card{pr}},
+		{"label:bug urgent", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			q := Parse(tt.query, false)
+			var got []*domain.Card
+			for _, card := range []*domain.Card{bug, pr, draft} {
+				if q.Match(card, "alice") {
+					got = append(got, card)
+				}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	assert.True(t, Parse("-label:bug crash", false).Uses("label"))
+	assert.False(t, Parse("labels: crash", false).Uses("label"), "Unknown keys are title text")
+	assert.False(t, Parse("assignee:@me", false).Match(bug, ""), "@me matches nobody without a viewer")
+}
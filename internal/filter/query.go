@@ -0,0 +1,166 @@
+package filter
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/h0rv/ghp/internal/domain"
+)
+
+// Qualifiers a Query understands. Other "key:value" words are title text, so
+// titles such as "fix: crash" still match.
+var Qualifiers = []string{"label", "assignee", "repo", "is", "state"}
+
+// Query is a board filter. Qualifiers narrow by an item's attributes and the
+// remaining words match its title like a Matcher:
+//
+//	label:bug assignee:@me repo:api is:pr state:open urgent
+//
+// Every qualifier must hold. Commas separate alternatives (label:bug,crash),
+// a leading "-" negates one (-label:wontfix), and values with spaces are
+// quoted (label:"good first issue").
+type Query struct {
+	text  Matcher
+	terms []term
+}
+
+// term is one qualifier of a Query
+type term struct {
+	key    string
+	values []string // Lowercased alternatives
+	negate bool
+}
+
+// Parse splits query into qualifiers and title text.
+// foldDiacritics applies to the title text as in New.
+func Parse(query string, foldDiacritics bool) Query {
+	var q Query
+	var text []string
+	for _, word := range splitWords(query) {
+		t, ok := parseTerm(word)
+		if !ok {
+			text = append(text, word)
+			continue
+		}
+		q.terms = append(q.terms, t)
+	}
+	q.text = New(strings.Join(text, " "), foldDiacritics)
+	return q
+}
+
+// parseTerm reads a "key:value" word with a known key
+func parseTerm(word string) (term, bool) {
+	t := term{}
+	if strings.HasPrefix(word, "-") {
+		t.negate = true
+		word = word[1:]
+	}
+	key, value, ok := strings.Cut(word, ":")
+	key = strings.ToLower(key)
+	if !ok || value == "" || !slices.Contains(Qualifiers, key) {
+		return term{}, false
+	}
+	t.key = key
+	for _, v := range strings.Split(strings.Trim(value, `"`), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			t.values = append(t.values, strings.ToLower(v))
+		}
+	}
+	return t, len(t.values) > 0
+}
+
+// splitWords splits s at spaces outside double quotes, keeping the quotes
+func splitWords(s string) []string {
+	var words []string
+	var word strings.Builder
+	quoted := false
+	for _, r := range s {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			word.WriteRune(r)
+		case unicode.IsSpace(r) && !quoted:
+			if word.Len() > 0 {
+				words = append(words, word.String())
+				word.Reset()
+			}
+		default:
+			word.WriteRune(r)
+		}
+	}
+	if word.Len() > 0 {
+		words = append(words, word.String())
+	}
+	return words
+}
+
+// Empty reports whether the query accepts every item.
+func (q Query) Empty() bool {
+	return q.text.Empty() && len(q.terms) == 0
+}
+
+// Uses reports whether the query has a qualifier with key, such as "label",
+// whose values may need loading before it can match.
+func (q Query) Uses(key string) bool {
+	return slices.ContainsFunc(q.terms, func(t term) bool { return t.key == key })
+}
+
+// Match reports whether card matches the query. viewer is the login that
+// "@me" stands for; without one, "@me" matches nobody.
+func (q Query) Match(card *domain.Card, viewer string) bool {
+	if !q.text.Match(card.Title) {
+		return false
+	}
+	for _, t := range q.terms {
+		if slices.ContainsFunc(t.values, func(v string) bool { return matchValue(card, t.key, v, viewer) }) == t.negate {
+			return false
+		}
+	}
+	return true
+}
+
+// matchValue reports whether card has the lowercased value v for key
+func matchValue(card *domain.Card, key, v, viewer string) bool {
+	switch key {
+	case "label":
+		return containsFold(card.Labels, v)
+	case "assignee":
+		if v == "@me" {
+			v = viewer
+		}
+		return v != "" && containsFold(card.Assignees, v)
+	case "repo":
+		// Either owner/name or just the name
+		repo := strings.ToLower(card.Repo)
+		return repo != "" && (repo == v || strings.HasSuffix(repo, "/"+v))
+	case "is":
+		switch v {
+		case "issue":
+			return card.ContentType == domain.ContentTypeIssue
+		case "pr":
+			return card.ContentType == domain.ContentTypePullRequest
+		case "draft":
+			return card.ContentType == domain.ContentTypeDraftIssue || (card.PR != nil && card.PR.Draft)
+		case "open", "closed", "merged":
+			return matchState(card, v)
+		}
+	case "state":
+		return matchState(card, v)
+	}
+	return false
+}
+
+// matchState reports whether card is in the lowercased state; draft issues
+// count as open
+func matchState(card *domain.Card, state string) bool {
+	if card.ContentType == domain.ContentTypeDraftIssue {
+		return state == "open"
+	}
+	return strings.EqualFold(card.State, state)
+}
+
+// containsFold reports whether list has s, ignoring case
+func containsFold(list []string, s string) bool {
+	return slices.ContainsFunc(list, func(item string) bool { return strings.EqualFold(item, s) })
+}
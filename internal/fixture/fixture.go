@@ -0,0 +1,105 @@
+// Package fixture generates synthetic project boards for benchmarks and
+// performance checks, so large boards can be measured without a GitHub
+// project of that size.
+package fixture
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/store"
+)
+
+var (
+	statuses   = []string{"Backlog", "Todo", "In Progress", "In Review", "Done"}
+	priorities = []string{"P0", "P1", "P2", "P3"}
+	repos      = []string{"acme/api", "acme/web", "acme/cli", "acme/infra", "acme/docs", "acme/mobile"}
+	users      = []string{"alice", "bob", "carol", "dave", "erin", "frank", "grace", "heidi"}
+	labels     = []string{"bug", "enhancement", "docs", "performance", "security", "good first issue"}
+	words      = []string{
+		"add", "fix", "remove", "refactor", "update", "support", "cache", "login", "search", "export",
+		"pagination", "timeout", "crash", "settings", "dashboard", "webhook", "retry", "résumé", "upload", "billing",
+	}
+)
+
+// Board returns a store loaded with a project of n cards grouped by a Status
+// field, with a Priority field for sorting. The same seed gives the same
+// board. A few cards have no status, and the mix of issues, pull requests,
+// drafts, assignees, and labels resembles a busy real project.
+func Board(n int, seed uint64) *store.Store {
+	r := rand.New(rand.NewPCG(seed, seed))
+
+	status := domain.FieldDef{ID: "field-status", Name: "Status", Type: domain.FieldTypeSingleSelect}
+	for i, name := range statuses {
+		status.Options = append(status.Options, domain.Option{ID: fmt.Sprintf("status-%d", i), Name: name})
+	}
+	priority := domain.FieldDef{ID: "field-priority", Name: "Priority", Type: domain.FieldTypeSingleSelect}
+	for i, name := range priorities {
+		priority.Options = append(priority.Options, domain.Option{ID: fmt.Sprintf("priority-%d", i), Name: name})
+	}
+
+	s := store.New()
+	s.SetProject(&domain.Project{ID: "proj-bench", Number: 1, Title: "Synthetic board", Owner: "acme"})
+	s.SetViewerLogin(users[0])
+	s.SetFields([]domain.FieldDef{status, priority})
+	s.SetGroupField(&status)
+
+	now := time.Now()
+	cards := make([]*domain.Card, n)
+	for i := range cards {
+		card := &domain.Card{
+			ItemID:      fmt.Sprintf("item-%d", i),
+			ContentID:   fmt.Sprintf("content-%d", i),
+			ContentType: domain.ContentTypeIssue,
+			Title:       title(r),
+			Repo:        repos[r.IntN(len(repos))],
+			Number:      i + 1,
+			State:       "OPEN",
+			FieldValues: map[string]string{priority.Name: priority.Options[r.IntN(len(priority.Options))].ID},
+			UpdatedAt:   now.Add(-time.Duration(r.IntN(90*24)) * time.Hour).Format(time.RFC3339),
+		}
+		card.CreatedAt = card.UpdatedAt
+		card.URL = fmt.Sprintf("https://github.com/%s/issues/%d", card.Repo, card.Number)
+
+		switch k := r.IntN(10); {
+		case k < 2:
+			card.ContentType = domain.ContentTypePullRequest
+			card.URL = fmt.Sprintf("https://github.com/%s/pull/%d", card.Repo, card.Number)
+		case k == 2:
+			card.ContentType = domain.ContentTypeDraftIssue
+			card.Repo, card.Number, card.URL = "", 0, ""
+		}
+		if r.IntN(20) > 0 {
+			card.GroupOptionID = status.Options[r.IntN(len(status.Options))].ID
+		}
+		if card.GroupOptionID == status.Options[len(status.Options)-1].ID && card.ContentType != domain.ContentTypeDraftIssue {
+			card.State = "CLOSED"
+		}
+		for range r.IntN(3) {
+			card.Assignees = append(card.Assignees, users[r.IntN(len(users))])
+		}
+		for range r.IntN(3) {
+			card.Labels = append(card.Labels, labels[r.IntN(len(labels))])
+		}
+		card.Author = users[r.IntN(len(users))]
+		if r.IntN(25) == 0 {
+			card.Author, card.AuthorIsBot = "dependabot", true
+		}
+		cards[i] = card
+	}
+	s.UpsertCards(cards)
+	return s
+}
+
+// title makes a short title from the word list
+func title(r *rand.Rand) string {
+	parts := make([]string, 3+r.IntN(5))
+	for i := range parts {
+		parts[i] = words[r.IntN(len(words))]
+	}
+	parts[0] = strings.ToUpper(parts[0][:1]) + parts[0][1:]
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,29 @@
+package fixture
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoard(t *testing.T) {
+	s := Board(500, 1)
+	require.Len(t, s.GetAllCards(), 500)
+	require.NotNil(t, s.GetGroupField())
+
+	columns, err := s.GetColumns()
+	require.NoError(t, err)
+	total := 0
+	for _, ids := range columns {
+		total += len(ids)
+	}
+	assert.Equal(t, 500, total, "Every card lands in a column")
+
+	again := Board(500, 1)
+	for _, card := range s.GetAllCards() {
+		other, err := again.GetCard(card.ItemID)
+		require.NoError(t, err)
+		assert.Equal(t, card.Title, other.Title, "Same seed, same board")
+	}
+}
@@ -0,0 +1,53 @@
+package gh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/h0rv/ghp/internal/auth"
+)
+
+// OtherAccount is a GitHub CLI account, other than the active one, that can
+// see an owner's projects.
+type OtherAccount struct {
+	Login    string
+	Projects int // How many of the owner's projects it sees
+}
+
+// Hint suggests switching to the account to see owner's projects.
+func (a OtherAccount) Hint(owner string) string {
+	return fmt.Sprintf("gh account %s can see %d of %s's projects; run 'gh auth switch --user %s' to use it",
+		a.Login, a.Projects, owner, a.Login)
+}
+
+// FindOtherAccounts asks each of the GitHub CLI's inactive accounts whether it
+// can see owner's projects, for when the active account sees none. It only
+// offers a hint, so accounts that fail to answer are skipped.
+func FindOtherAccounts(ctx context.Context, owner string) []OtherAccount {
+	accounts, err := auth.Accounts("")
+	if err != nil {
+		return nil
+	}
+
+	var found []OtherAccount
+	for _, account := range accounts {
+		if account.Active {
+			continue
+		}
+		token, err := auth.TokenFor("", account.Login)
+		if err != nil {
+			continue
+		}
+		client := newWithToken(token)
+		ownerType, ownerID, err := client.ResolveOwner(ctx, owner)
+		if err != nil {
+			continue
+		}
+		projects, err := client.ListProjects(ctx, ownerType, ownerID, owner)
+		if err != nil || len(projects) == 0 {
+			continue
+		}
+		found = append(found, OtherAccount{Login: account.Login, Projects: len(projects)})
+	}
+	return found
+}
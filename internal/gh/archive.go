@@ -0,0 +1,53 @@
+package gh
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/machinebox/graphql"
+)
+
+// archiveBatchSize is the number of items archived per GraphQL request.
+const archiveBatchSize = 25
+
+// ArchiveItems archives project items, batching them into aliased mutations.
+// It returns the number of items archived before any error; batches are
+// applied in order, so those are the first archived entries of itemIDs.
+func (c *Client) ArchiveItems(ctx context.Context, projectID string, itemIDs []string) (int, error) {
+	return c.runArchiveBatches(ctx, "archiveProjectV2Item", "archive", projectID, itemIDs)
+}
+
+// UnarchiveItems restores archived project items to the board. Like
+// ArchiveItems, it returns how many of the first itemIDs were restored.
+func (c *Client) UnarchiveItems(ctx context.Context, projectID string, itemIDs []string) (int, error) {
+	return c.runArchiveBatches(ctx, "unarchiveProjectV2Item", "unarchive", projectID, itemIDs)
+}
+
+// runArchiveBatches applies an archive or unarchive mutation to items in batches.
+func (c *Client) runArchiveBatches(ctx context.Context, mutation, verb, projectID string, itemIDs []string) (int, error) {
+	for start := 0; start < len(itemIDs); start += archiveBatchSize {
+		end := min(start+archiveBatchSize, len(itemIDs))
+		batch := itemIDs[start:end]
+
+		var params, fields []string
+		for i := range batch {
+			params = append(params, fmt.Sprintf("$item%d: ID!", i))
+			fields = append(fields, fmt.Sprintf("a%d: %s(input: {projectId: $project, itemId: $item%d}) { item { id } }", i, mutation, i))
+		}
+
+		req := graphql.NewRequest(fmt.Sprintf("mutation($project: ID!, %s) {\n%s\n}",
+			strings.Join(params, ", "), strings.Join(fields, "\n")))
+		req.Var("project", projectID)
+		for i, itemID := range batch {
+			req.Var(fmt.Sprintf("item%d", i), itemID)
+		}
+
+		var resp map[string]interface{}
+		if err := c.makeRequest(ctx, req, &resp); err != nil {
+			return start, fmt.Errorf("failed to %s items %d-%d: %w", verb, start+1, end, err)
+		}
+	}
+
+	return len(itemIDs), nil
+}
@@ -0,0 +1,120 @@
+package gh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/machinebox/graphql"
+)
+
+// User is a GitHub user who can be assigned to issues and pull requests.
+type User struct {
+	ID    string
+	Login string
+	Name  string // Display name, may be empty
+}
+
+// ListAssignableUsers returns the users who can be assigned to issues and
+// pull requests in a repository.
+func (c *Client) ListAssignableUsers(ctx context.Context, owner, repo string) ([]User, error) {
+	var users []User
+	cursor := ""
+
+	for {
+		req := graphql.NewRequest(`
+			query($owner: String!, $repo: String!, $cursor: String) {
+				repository(owner: $owner, name: $repo) {
+					assignableUsers(first: 100, after: $cursor) {
+						nodes {
+							id
+							login
+							name
+						}
+						pageInfo {
+							hasNextPage
+							endCursor
+						}
+					}
+				}
+			}
+		`)
+		req.Var("owner", owner)
+		req.Var("repo", repo)
+		if cursor != "" {
+			req.Var("cursor", cursor)
+		}
+
+		var resp struct {
+			Repository *struct {
+				AssignableUsers struct {
+					Nodes []struct {
+						ID    string `json:"id"`
+						Login string `json:"login"`
+						Name  string `json:"name"`
+					} `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"assignableUsers"`
+			} `json:"repository"`
+		}
+
+		if err := c.makeRequest(ctx, req, &resp); err != nil {
+			return nil, fmt.Errorf("failed to list assignable users: %w", err)
+		}
+		if resp.Repository == nil {
+			return nil, notFoundf("repository '%s/%s' not found", owner, repo)
+		}
+
+		page := resp.Repository.AssignableUsers
+		for _, node := range page.Nodes {
+			users = append(users, User{ID: node.ID, Login: node.Login, Name: node.Name})
+		}
+
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			break
+		}
+		cursor = page.PageInfo.EndCursor
+	}
+
+	return users, nil
+}
+
+// AddAssignees assigns users to an issue or pull request by node IDs.
+func (c *Client) AddAssignees(ctx context.Context, contentID string, userIDs []string) error {
+	req := graphql.NewRequest(`
+		mutation($assignableId: ID!, $assigneeIds: [ID!]!, $clientMutationId: String) {
+			addAssigneesToAssignable(input: {assignableId: $assignableId, assigneeIds: $assigneeIds, clientMutationId: $clientMutationId}) {
+				clientMutationId
+			}
+		}
+	`)
+	req.Var("assignableId", contentID)
+	req.Var("assigneeIds", userIDs)
+
+	var resp struct{}
+	if err := c.runMutation(ctx, req, &resp, true); err != nil {
+		return fmt.Errorf("failed to add assignees: %w", err)
+	}
+	return nil
+}
+
+// RemoveAssignees unassigns users from an issue or pull request by node IDs.
+func (c *Client) RemoveAssignees(ctx context.Context, contentID string, userIDs []string) error {
+	req := graphql.NewRequest(`
+		mutation($assignableId: ID!, $assigneeIds: [ID!]!, $clientMutationId: String) {
+			removeAssigneesFromAssignable(input: {assignableId: $assignableId, assigneeIds: $assigneeIds, clientMutationId: $clientMutationId}) {
+				clientMutationId
+			}
+		}
+	`)
+	req.Var("assignableId", contentID)
+	req.Var("assigneeIds", userIDs)
+
+	var resp struct{}
+	if err := c.runMutation(ctx, req, &resp, true); err != nil {
+		return fmt.Errorf("failed to remove assignees: %w", err)
+	}
+	return nil
+}
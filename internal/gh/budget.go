@@ -0,0 +1,58 @@
+package gh
+
+import "fmt"
+
+// MaxPageSize is the most items GitHub returns per page of a connection.
+const MaxPageSize = 100
+
+// FetchBudget bounds how many project items are listed before stopping.
+// Zero MaxPages or MaxItems means no limit.
+type FetchBudget struct {
+	PageSize int // Items requested per page (1-100)
+	MaxPages int // Pages fetched before stopping
+	MaxItems int // Items fetched before stopping
+}
+
+// DefaultFetchBudget fetches every item in full pages.
+func DefaultFetchBudget() FetchBudget {
+	return FetchBudget{PageSize: MaxPageSize}
+}
+
+// Validate reports a budget the API cannot honor.
+func (b FetchBudget) Validate() error {
+	if b.PageSize < 1 || b.PageSize > MaxPageSize {
+		return fmt.Errorf("page size must be between 1 and %d, got %d", MaxPageSize, b.PageSize)
+	}
+	if b.MaxPages < 0 {
+		return fmt.Errorf("max pages must not be negative, got %d", b.MaxPages)
+	}
+	if b.MaxItems < 0 {
+		return fmt.Errorf("max items must not be negative, got %d", b.MaxItems)
+	}
+	return nil
+}
+
+// NextPageSize returns how many items to request after pages pages holding
+// items items were fetched, or 0 when the budget is spent.
+// The last page is shortened so MaxItems is not overshot.
+func (b FetchBudget) NextPageSize(pages, items int) int {
+	size := b.PageSize
+	if size < 1 || size > MaxPageSize {
+		size = MaxPageSize
+	}
+	if b.MaxPages > 0 && pages >= b.MaxPages {
+		return 0
+	}
+	if b.MaxItems > 0 {
+		if items >= b.MaxItems {
+			return 0
+		}
+		size = min(size, b.MaxItems-items)
+	}
+	return size
+}
+
+// Unlimited returns the budget with its page and item caps lifted.
+func (b FetchBudget) Unlimited() FetchBudget {
+	return FetchBudget{PageSize: b.PageSize}
+}
@@ -5,6 +5,11 @@ package gh
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/h0rv/ghp/internal/auth"
 	"github.com/machinebox/graphql"
@@ -15,6 +20,23 @@ import (
 type Client struct {
 	gql   *graphql.Client
 	token string
+	rate  atomic.Pointer[RateLimit] // Quota as of the latest response
+}
+
+// RateLimit is the API quota reported with a response.
+type RateLimit struct {
+	Remaining int
+	Limit     int
+	Reset     time.Time
+}
+
+// RateLimit returns the quota reported by the latest response, and false
+// before any response has reported one.
+func (c *Client) RateLimit() (RateLimit, bool) {
+	if rl := c.rate.Load(); rl != nil {
+		return *rl, true
+	}
+	return RateLimit{}, false
 }
 
 // New creates a new GitHub GraphQL client.
@@ -25,18 +47,115 @@ func New() (*Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to obtain GitHub token: %w", err)
 	}
+	return newWithToken(token), nil
+}
 
-	client := graphql.NewClient("https://api.github.com/graphql")
+// newWithToken creates a client for the configured host that authenticates
+// with token.
+func newWithToken(token string) *Client {
+	httpClient := &http.Client{Transport: metaTransport{base: http.DefaultTransport}}
+	client := graphql.NewClient(graphQLEndpoint(auth.Host()), graphql.WithHTTPClient(httpClient))
 
 	return &Client{
 		gql:   client,
 		token: token,
-	}, nil
+	}
+}
+
+// graphQLEndpoint returns the GraphQL API URL for a GitHub host.
+// GitHub Enterprise Server serves the API under /api/graphql on the instance host.
+func graphQLEndpoint(host string) string {
+	if host == auth.DefaultHost {
+		return "https://api.github.com/graphql"
+	}
+	return "https://" + host + "/api/graphql"
 }
 
 // makeRequest executes a GraphQL request with authentication.
 // This is a helper method to avoid repeating the authorization header setup.
+// SAML SSO failures are returned as *SSORequiredError, and rejected tokens and
+// rate limits wrap ErrUnauthorized and ErrRateLimited.
 func (c *Client) makeRequest(ctx context.Context, req *graphql.Request, resp interface{}) error {
 	req.Header.Set("Authorization", "Bearer "+c.token)
-	return c.gql.Run(ctx, req, resp)
+	var meta responseMeta
+	err := c.gql.Run(withResponseMeta(ctx, &meta), req, resp)
+	if meta.rate.Limit > 0 {
+		c.rate.Store(&meta.rate)
+	}
+	switch {
+	case isSAMLError(err):
+		return &SSORequiredError{URL: meta.ssoURL, Err: err}
+	case meta.status == http.StatusUnauthorized:
+		return fmt.Errorf("%w (HTTP 401); run 'gh auth login' or check GITHUB_TOKEN", ErrUnauthorized)
+	case meta.rateLimited || isRateLimitError(err):
+		if meta.reset != "" {
+			return fmt.Errorf("%w; resets at %s", ErrRateLimited, meta.reset)
+		}
+		return ErrRateLimited
+	case err == nil && meta.status >= 400:
+		// The GraphQL client only reports bad statuses it can't decode
+		return fmt.Errorf("GitHub returned HTTP %d", meta.status)
+	}
+	return err
+}
+
+// responseMetaKey keys the *responseMeta in a request context.
+type responseMetaKey struct{}
+
+// responseMeta holds response details the GraphQL client does not expose.
+type responseMeta struct {
+	status      int
+	ssoURL      string // From X-GitHub-SSO, e.g. "required; url=https://..."
+	rateLimited bool
+	reset       string    // When the rate limit resets, local time
+	rate        RateLimit // From the X-RateLimit-* headers, zero if absent
+}
+
+// withResponseMeta returns a context whose requests record response details into dst.
+func withResponseMeta(ctx context.Context, dst *responseMeta) context.Context {
+	return context.WithValue(ctx, responseMetaKey{}, dst)
+}
+
+// metaTransport fills in the responseMeta of each request's context.
+type metaTransport struct {
+	base http.RoundTripper
+}
+
+func (t metaTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		return res, err
+	}
+	dst, ok := req.Context().Value(responseMetaKey{}).(*responseMeta)
+	if !ok {
+		return res, err
+	}
+
+	dst.status = res.StatusCode
+	for _, part := range strings.Split(res.Header.Get("X-GitHub-SSO"), ";") {
+		if url, ok := strings.CutPrefix(strings.TrimSpace(part), "url="); ok {
+			dst.ssoURL = url
+		}
+	}
+
+	remaining, errRemaining := strconv.Atoi(res.Header.Get("X-RateLimit-Remaining"))
+	limit, errLimit := strconv.Atoi(res.Header.Get("X-RateLimit-Limit"))
+	if errRemaining == nil && errLimit == nil {
+		dst.rate = RateLimit{Remaining: remaining, Limit: limit}
+		if reset, err := strconv.ParseInt(res.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			dst.rate.Reset = time.Unix(reset, 0)
+		}
+	}
+
+	// Primary limits zero the remaining count; secondary limits send Retry-After
+	limited := res.Header.Get("X-RateLimit-Remaining") == "0"
+	if res.StatusCode == http.StatusTooManyRequests || (res.StatusCode == http.StatusForbidden && (limited || res.Header.Get("Retry-After") != "")) {
+		dst.rateLimited = true
+	}
+	if dst.rateLimited {
+		if reset, err := strconv.ParseInt(res.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			dst.reset = time.Unix(reset, 0).Format("15:04")
+		}
+	}
+	return res, err
 }
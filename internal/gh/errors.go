@@ -0,0 +1,59 @@
+package gh
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrUnauthorized indicates GitHub rejected the token.
+	ErrUnauthorized = errors.New("GitHub rejected the token")
+	// ErrRateLimited indicates the API rate limit was exceeded.
+	ErrRateLimited = errors.New("GitHub API rate limit exceeded")
+)
+
+// NotFoundError reports an owner, project, repository, user, or item that does not exist.
+type NotFoundError struct {
+	Msg string
+}
+
+func (e *NotFoundError) Error() string { return e.Msg }
+
+// notFoundf returns a *NotFoundError with a formatted message.
+func notFoundf(format string, args ...any) error {
+	return &NotFoundError{Msg: fmt.Sprintf(format, args...)}
+}
+
+// PartialError reports a bulk operation that stopped after applying some of its changes.
+type PartialError struct {
+	Done  int // Changes applied
+	Total int // Changes attempted
+	Err   error
+}
+
+func (e *PartialError) Error() string {
+	return fmt.Sprintf("%v (%d of %d changes applied)", e.Err, e.Done, e.Total)
+}
+
+func (e *PartialError) Unwrap() error { return e.Err }
+
+// isRateLimitError reports whether a GraphQL error is GitHub's rate limit message.
+// GitHub sends it with status 200, so the transport can't tell.
+func isRateLimitError(err error) bool {
+	return err != nil && strings.Contains(strings.ToLower(err.Error()), "rate limit")
+}
+
+// isForbiddenError reports whether a GraphQL error is GitHub refusing the
+// token a resource (type FORBIDDEN), such as a repository behind OAuth App
+// access restrictions. The GraphQL client keeps only the message, so it is
+// matched by wording.
+func isForbiddenError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "resource not accessible") ||
+		strings.Contains(msg, "access restrictions") ||
+		strings.Contains(msg, "forbidden")
+}
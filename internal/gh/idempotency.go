@@ -0,0 +1,59 @@
+package gh
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/url"
+
+	"github.com/machinebox/graphql"
+)
+
+// mutationIDKey keys the client mutation ID carried by a context.
+type mutationIDKey struct{}
+
+// NewMutationID returns a random client mutation ID.
+func NewMutationID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// WithMutationID returns a context whose mutations are sent with id as their
+// clientMutationId. Callers create one ID per user action, so every request
+// made for that action, including retries, carries the same ID.
+func WithMutationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, mutationIDKey{}, id)
+}
+
+// MutationID returns the client mutation ID carried by ctx, or "" if none.
+func MutationID(ctx context.Context) string {
+	id, _ := ctx.Value(mutationIDKey{}).(string)
+	return id
+}
+
+// runMutation sends a mutation whose query declares $clientMutationId,
+// attaching the context's mutation ID or a fresh one.
+// With idempotent set, a request lost to the network is retried once under the
+// same ID; mutations that create things are never retried.
+func (c *Client) runMutation(ctx context.Context, req *graphql.Request, resp interface{}, idempotent bool) error {
+	id := MutationID(ctx)
+	if id == "" {
+		id = NewMutationID()
+	}
+	req.Var("clientMutationId", id)
+
+	err := c.makeRequest(ctx, req, resp)
+	if idempotent && isNetworkError(err) && ctx.Err() == nil {
+		err = c.makeRequest(ctx, req, resp)
+	}
+	return err
+}
+
+// isNetworkError reports whether err happened in transport rather than being
+// returned by the API, so the request may never have arrived.
+func isNetworkError(err error) bool {
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
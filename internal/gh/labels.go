@@ -0,0 +1,189 @@
+package gh
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/machinebox/graphql"
+)
+
+// labelBatchSize is the number of aliased operations sent per GraphQL request.
+// GitHub limits request complexity, so large bulk changes are split into batches.
+const labelBatchSize = 25
+
+// LabelChange identifies a label to add to or remove from an issue or PR.
+type LabelChange struct {
+	ContentID string // Issue or PR node ID
+	LabelID   string // Label node ID (labels are scoped to a repository)
+}
+
+// GetLabelIDs looks up a label by name in each of the given repositories.
+// Repositories are "owner/name" strings. The result maps repository to label ID;
+// repositories without a label of that name are omitted.
+func (c *Client) GetLabelIDs(ctx context.Context, repos []string, name string) (map[string]string, error) {
+	result := make(map[string]string, len(repos))
+
+	for start := 0; start < len(repos); start += labelBatchSize {
+		end := min(start+labelBatchSize, len(repos))
+		batch := repos[start:end]
+
+		var params, fields []string
+		vars := make(map[string]interface{})
+		for i, repo := range batch {
+			owner, repoName, ok := strings.Cut(repo, "/")
+			if !ok {
+				return nil, fmt.Errorf("invalid repository %q", repo)
+			}
+			params = append(params, fmt.Sprintf("$o%d: String!, $n%d: String!", i, i))
+			fields = append(fields, fmt.Sprintf("r%d: repository(owner: $o%d, name: $n%d) { label(name: $label) { id } }", i, i, i))
+			vars[fmt.Sprintf("o%d", i)] = owner
+			vars[fmt.Sprintf("n%d", i)] = repoName
+		}
+
+		req := graphql.NewRequest(fmt.Sprintf("query($label: String!, %s) {\n%s\n}",
+			strings.Join(params, ", "), strings.Join(fields, "\n")))
+		req.Var("label", name)
+		for k, v := range vars {
+			req.Var(k, v)
+		}
+
+		var resp map[string]*struct {
+			Label *struct {
+				ID string `json:"id"`
+			} `json:"label"`
+		}
+
+		if err := c.makeRequest(ctx, req, &resp); err != nil {
+			return nil, fmt.Errorf("failed to look up label %q: %w", name, err)
+		}
+
+		for i, repo := range batch {
+			r := resp[fmt.Sprintf("r%d", i)]
+			if r != nil && r.Label != nil {
+				result[repo] = r.Label.ID
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// AddLabels applies each label change, batching them into aliased mutations.
+func (c *Client) AddLabels(ctx context.Context, changes []LabelChange) error {
+	return c.runLabelBatches(ctx, "addLabelsToLabelable", changes)
+}
+
+// RemoveLabels removes each label change, batching them into aliased mutations.
+func (c *Client) RemoveLabels(ctx context.Context, changes []LabelChange) error {
+	return c.runLabelBatches(ctx, "removeLabelsFromLabelable", changes)
+}
+
+// runLabelBatches sends label mutations in batches of labelBatchSize.
+// Each batch is a single request with one aliased mutation per change.
+func (c *Client) runLabelBatches(ctx context.Context, mutation string, changes []LabelChange) error {
+	for start := 0; start < len(changes); start += labelBatchSize {
+		end := min(start+labelBatchSize, len(changes))
+		batch := changes[start:end]
+
+		var params, fields []string
+		for i := range batch {
+			params = append(params, fmt.Sprintf("$id%d: ID!, $label%d: ID!", i, i))
+			fields = append(fields, fmt.Sprintf("m%d: %s(input: {labelableId: $id%d, labelIds: [$label%d]}) { clientMutationId }", i, mutation, i, i))
+		}
+
+		req := graphql.NewRequest(fmt.Sprintf("mutation(%s) {\n%s\n}",
+			strings.Join(params, ", "), strings.Join(fields, "\n")))
+		for i, change := range batch {
+			req.Var(fmt.Sprintf("id%d", i), change.ContentID)
+			req.Var(fmt.Sprintf("label%d", i), change.LabelID)
+		}
+
+		var resp map[string]interface{}
+		if err := c.makeRequest(ctx, req, &resp); err != nil {
+			err = fmt.Errorf("failed to update labels (items %d-%d): %w", start+1, end, err)
+			if start > 0 {
+				return &PartialError{Done: start, Total: len(changes), Err: err}
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Label is a repository label.
+type Label struct {
+	ID          string
+	Name        string
+	Color       string // Hex without "#", e.g. "d73a4a"
+	Description string
+}
+
+// ListLabels returns all labels of a repository, sorted by name.
+func (c *Client) ListLabels(ctx context.Context, owner, repo string) ([]Label, error) {
+	var labels []Label
+	cursor := ""
+
+	for {
+		req := graphql.NewRequest(`
+			query($owner: String!, $repo: String!, $cursor: String) {
+				repository(owner: $owner, name: $repo) {
+					labels(first: 100, after: $cursor, orderBy: {field: NAME, direction: ASC}) {
+						nodes {
+							id
+							name
+							color
+							description
+						}
+						pageInfo {
+							hasNextPage
+							endCursor
+						}
+					}
+				}
+			}
+		`)
+		req.Var("owner", owner)
+		req.Var("repo", repo)
+		if cursor != "" {
+			req.Var("cursor", cursor)
+		}
+
+		var resp struct {
+			Repository *struct {
+				Labels struct {
+					Nodes []struct {
+						ID          string `json:"id"`
+						Name        string `json:"name"`
+						Color       string `json:"color"`
+						Description string `json:"description"`
+					} `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"labels"`
+			} `json:"repository"`
+		}
+
+		if err := c.makeRequest(ctx, req, &resp); err != nil {
+			return nil, fmt.Errorf("failed to list labels: %w", err)
+		}
+		if resp.Repository == nil {
+			return nil, notFoundf("repository '%s/%s' not found", owner, repo)
+		}
+
+		page := resp.Repository.Labels
+		for _, node := range page.Nodes {
+			labels = append(labels, Label{ID: node.ID, Name: node.Name, Color: node.Color, Description: node.Description})
+		}
+
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			break
+		}
+		cursor = page.PageInfo.EndCursor
+	}
+
+	return labels, nil
+}
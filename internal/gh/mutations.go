@@ -4,20 +4,55 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/h0rv/ghp/internal/domain"
 	"github.com/machinebox/graphql"
 )
 
-// UpdateItemField updates a project item's SINGLE_SELECT field value.
-// This is used to move items between columns in the board view.
-func (c *Client) UpdateItemField(ctx context.Context, projectID string, itemID string, fieldID string, optionID string) error {
+// FieldInput is a value to set on a project field, built with one of the
+// constructors below for the field's type.
+type FieldInput struct {
+	key   string
+	value interface{}
+}
+
+// SingleSelectValue sets a SINGLE_SELECT field to one of its options.
+func SingleSelectValue(optionID string) FieldInput {
+	return FieldInput{key: "singleSelectOptionId", value: optionID}
+}
+
+// TextValue sets a TEXT field.
+func TextValue(text string) FieldInput {
+	return FieldInput{key: "text", value: text}
+}
+
+// NumberValue sets a NUMBER field.
+func NumberValue(n float64) FieldInput {
+	return FieldInput{key: "number", value: n}
+}
+
+// DateValue sets a DATE field; date is YYYY-MM-DD.
+func DateValue(date string) FieldInput {
+	return FieldInput{key: "date", value: date}
+}
+
+// IterationValue sets an ITERATION field to one of its iterations.
+func IterationValue(iterationID string) FieldInput {
+	return FieldInput{key: "iterationId", value: iterationID}
+}
+
+// UpdateItemField sets a project item's field value. Moving items between
+// columns sets the grouping field with a SingleSelectValue.
+// Setting a value is idempotent, so a request lost to the network is retried.
+func (c *Client) UpdateItemField(ctx context.Context, projectID string, itemID string, fieldID string, value FieldInput) error {
 	req := graphql.NewRequest(`
-		mutation($projectId: ID!, $itemId: ID!, $fieldId: ID!, $value: ProjectV2FieldValue!) {
+		mutation($projectId: ID!, $itemId: ID!, $fieldId: ID!, $value: ProjectV2FieldValue!, $clientMutationId: String) {
 			updateProjectV2ItemFieldValue(
 				input: {
 					projectId: $projectId
 					itemId: $itemId
 					fieldId: $fieldId
 					value: $value
+					clientMutationId: $clientMutationId
 				}
 			) {
 				projectV2Item {
@@ -31,7 +66,7 @@ func (c *Client) UpdateItemField(ctx context.Context, projectID string, itemID s
 	req.Var("itemId", itemID)
 	req.Var("fieldId", fieldID)
 	req.Var("value", map[string]interface{}{
-		"singleSelectOptionId": optionID,
+		value.key: value.value,
 	})
 
 	var resp struct {
@@ -42,13 +77,245 @@ func (c *Client) UpdateItemField(ctx context.Context, projectID string, itemID s
 		} `json:"updateProjectV2ItemFieldValue"`
 	}
 
-	if err := c.makeRequest(ctx, req, &resp); err != nil {
+	if err := c.runMutation(ctx, req, &resp, true); err != nil {
 		return fmt.Errorf("failed to update item field: %w", err)
 	}
 
 	return nil
 }
 
+// UpdateItemPosition moves a project item to just after afterID in the
+// project's manual order, or to the top when afterID is empty.
+func (c *Client) UpdateItemPosition(ctx context.Context, projectID string, itemID string, afterID string) error {
+	req := graphql.NewRequest(`
+		mutation($projectId: ID!, $itemId: ID!, $afterId: ID, $clientMutationId: String) {
+			updateProjectV2ItemPosition(
+				input: {
+					projectId: $projectId
+					itemId: $itemId
+					afterId: $afterId
+					clientMutationId: $clientMutationId
+				}
+			) {
+				clientMutationId
+			}
+		}
+	`)
+
+	req.Var("projectId", projectID)
+	req.Var("itemId", itemID)
+	if afterID != "" {
+		req.Var("afterId", afterID)
+	} else {
+		req.Var("afterId", nil)
+	}
+
+	var resp struct {
+		UpdateProjectV2ItemPosition struct {
+			ClientMutationID string `json:"clientMutationId"`
+		} `json:"updateProjectV2ItemPosition"`
+	}
+
+	if err := c.runMutation(ctx, req, &resp, true); err != nil {
+		return fmt.Errorf("failed to update item position: %w", err)
+	}
+
+	return nil
+}
+
+// ClearItemField removes a project item's value for a field.
+func (c *Client) ClearItemField(ctx context.Context, projectID string, itemID string, fieldID string) error {
+	req := graphql.NewRequest(`
+		mutation($projectId: ID!, $itemId: ID!, $fieldId: ID!, $clientMutationId: String) {
+			clearProjectV2ItemFieldValue(
+				input: {
+					projectId: $projectId
+					itemId: $itemId
+					fieldId: $fieldId
+					clientMutationId: $clientMutationId
+				}
+			) {
+				projectV2Item {
+					id
+				}
+			}
+		}
+	`)
+
+	req.Var("projectId", projectID)
+	req.Var("itemId", itemID)
+	req.Var("fieldId", fieldID)
+
+	var resp struct {
+		ClearProjectV2ItemFieldValue struct {
+			ProjectV2Item struct {
+				ID string `json:"id"`
+			} `json:"projectV2Item"`
+		} `json:"clearProjectV2ItemFieldValue"`
+	}
+
+	if err := c.runMutation(ctx, req, &resp, true); err != nil {
+		return fmt.Errorf("failed to clear item field: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateContent updates the title and body of an item's underlying content.
+// Issues, pull requests, and draft issues each use a different mutation, so the
+// content type selects which one is sent. contentID is the content node ID, not the item ID.
+func (c *Client) UpdateContent(ctx context.Context, contentType string, contentID string, title string, body string) error {
+	switch contentType {
+	case domain.ContentTypeIssue:
+		return c.UpdateIssue(ctx, contentID, title, body)
+	case domain.ContentTypePullRequest:
+		return c.UpdatePullRequest(ctx, contentID, title, body)
+	case domain.ContentTypeDraftIssue:
+		return c.UpdateDraftIssue(ctx, contentID, title, body)
+	}
+	return fmt.Errorf("cannot edit %s items", contentType)
+}
+
+// UpdateIssue sets an issue's title and body.
+func (c *Client) UpdateIssue(ctx context.Context, issueID, title, body string) error {
+	return c.updateText(ctx, "updateIssue", "id", issueID, title, body)
+}
+
+// UpdatePullRequest sets a pull request's title and body.
+func (c *Client) UpdatePullRequest(ctx context.Context, pullRequestID, title, body string) error {
+	return c.updateText(ctx, "updatePullRequest", "pullRequestId", pullRequestID, title, body)
+}
+
+// UpdateDraftIssue sets a draft issue's title and body. draftIssueID is the
+// DraftIssue node ID, not the project item ID.
+func (c *Client) UpdateDraftIssue(ctx context.Context, draftIssueID, title, body string) error {
+	return c.updateText(ctx, "updateProjectV2DraftIssue", "draftIssueId", draftIssueID, title, body)
+}
+
+// updateText sends one of the title and body mutations, whose inputs differ
+// only in the name of the ID field. Setting the same text twice is harmless.
+func (c *Client) updateText(ctx context.Context, mutation, idField, contentID, title, body string) error {
+	if contentID == "" {
+		return fmt.Errorf("missing content ID")
+	}
+
+	req := graphql.NewRequest(fmt.Sprintf(`
+		mutation($id: ID!, $title: String!, $body: String!, $clientMutationId: String) {
+			%s(input: {%s: $id, title: $title, body: $body, clientMutationId: $clientMutationId}) {
+				clientMutationId
+			}
+		}
+	`, mutation, idField))
+	req.Var("id", contentID)
+	req.Var("title", title)
+	req.Var("body", body)
+
+	var resp struct{}
+	if err := c.runMutation(ctx, req, &resp, true); err != nil {
+		return fmt.Errorf("failed to update content: %w", err)
+	}
+	return nil
+}
+
+// CloseIssue closes an issue as completed.
+func (c *Client) CloseIssue(ctx context.Context, issueID string) error {
+	return c.setState(ctx, "closeIssue", "close issue", "issueId", issueID)
+}
+
+// ReopenIssue reopens a closed issue.
+func (c *Client) ReopenIssue(ctx context.Context, issueID string) error {
+	return c.setState(ctx, "reopenIssue", "reopen issue", "issueId", issueID)
+}
+
+// ClosePullRequest closes a pull request without merging it.
+func (c *Client) ClosePullRequest(ctx context.Context, pullRequestID string) error {
+	return c.setState(ctx, "closePullRequest", "close pull request", "pullRequestId", pullRequestID)
+}
+
+// ReopenPullRequest reopens a closed, unmerged pull request.
+func (c *Client) ReopenPullRequest(ctx context.Context, pullRequestID string) error {
+	return c.setState(ctx, "reopenPullRequest", "reopen pull request", "pullRequestId", pullRequestID)
+}
+
+// setState sends one of the close/reopen mutations, whose inputs differ only
+// in the name of the ID field. They are idempotent: closing a closed issue
+// leaves it closed.
+func (c *Client) setState(ctx context.Context, mutation, action, idField, contentID string) error {
+	if contentID == "" {
+		return fmt.Errorf("missing content ID")
+	}
+
+	req := graphql.NewRequest(fmt.Sprintf(`
+		mutation($id: ID!, $clientMutationId: String) {
+			%s(input: {%s: $id, clientMutationId: $clientMutationId}) {
+				clientMutationId
+			}
+		}
+	`, mutation, idField))
+	req.Var("id", contentID)
+
+	var resp struct{}
+	if err := c.runMutation(ctx, req, &resp, true); err != nil {
+		return fmt.Errorf("failed to %s: %w", action, err)
+	}
+	return nil
+}
+
+// AddAssignee assigns a user to an issue or pull request.
+// contentID is the issue or PR node ID; login is resolved to a user ID first.
+func (c *Client) AddAssignee(ctx context.Context, contentID string, login string) error {
+	userID, err := c.userID(ctx, login)
+	if err != nil {
+		return err
+	}
+	return c.AddAssignees(ctx, contentID, []string{userID})
+}
+
+// ReassignItem hands an issue, PR, or draft from one assignee to another, as
+// moving a card between assignee columns does. An empty from only assigns to,
+// and an empty to only unassigns from; other assignees are kept.
+func (c *Client) ReassignItem(ctx context.Context, contentID, from, to string) error {
+	if to != "" {
+		if err := c.AddAssignee(ctx, contentID, to); err != nil {
+			return err
+		}
+	}
+	if from == "" || from == to {
+		return nil
+	}
+	userID, err := c.userID(ctx, from)
+	if err != nil {
+		return err
+	}
+	return c.RemoveAssignees(ctx, contentID, []string{userID})
+}
+
+// userID resolves a login to its user node ID
+func (c *Client) userID(ctx context.Context, login string) (string, error) {
+	userReq := graphql.NewRequest(`
+		query($login: String!) {
+			user(login: $login) {
+				id
+			}
+		}
+	`)
+	userReq.Var("login", login)
+
+	var userResp struct {
+		User *struct {
+			ID string `json:"id"`
+		} `json:"user"`
+	}
+
+	if err := c.makeRequest(ctx, userReq, &userResp); err != nil {
+		return "", fmt.Errorf("failed to look up user: %w", err)
+	}
+	if userResp.User == nil {
+		return "", notFoundf("user '%s' not found", login)
+	}
+	return userResp.User.ID, nil
+}
+
 // AddComment adds a comment to an issue or pull request.
 // Uses the REST-style addComment mutation which requires the issue/PR node ID.
 func (c *Client) AddComment(ctx context.Context, owner, repo string, number int, body string) error {
@@ -60,8 +327,8 @@ func (c *Client) AddComment(ctx context.Context, owner, repo string, number int,
 
 	// Then add the comment
 	req := graphql.NewRequest(`
-		mutation($subjectId: ID!, $body: String!) {
-			addComment(input: {subjectId: $subjectId, body: $body}) {
+		mutation($subjectId: ID!, $body: String!, $clientMutationId: String) {
+			addComment(input: {subjectId: $subjectId, body: $body, clientMutationId: $clientMutationId}) {
 				commentEdge {
 					node {
 						id
@@ -84,13 +351,51 @@ func (c *Client) AddComment(ctx context.Context, owner, repo string, number int,
 		} `json:"addComment"`
 	}
 
-	if err := c.makeRequest(ctx, req, &resp); err != nil {
+	if err := c.runMutation(ctx, req, &resp, false); err != nil {
 		return fmt.Errorf("failed to add comment: %w", err)
 	}
 
 	return nil
 }
 
+// Pull request review events for AddPullRequestReview.
+const (
+	ReviewApprove        = "APPROVE"
+	ReviewRequestChanges = "REQUEST_CHANGES"
+	ReviewComment        = "COMMENT"
+)
+
+// AddPullRequestReview submits a review on a pull request with one of the
+// Review* events. GitHub requires a body to request changes or comment;
+// approvals may leave it empty.
+func (c *Client) AddPullRequestReview(ctx context.Context, pullRequestID, event, body string) error {
+	req := graphql.NewRequest(`
+		mutation($pullRequestId: ID!, $event: PullRequestReviewEvent!, $body: String, $clientMutationId: String) {
+			addPullRequestReview(input: {pullRequestId: $pullRequestId, event: $event, body: $body, clientMutationId: $clientMutationId}) {
+				pullRequestReview {
+					id
+				}
+			}
+		}
+	`)
+	req.Var("pullRequestId", pullRequestID)
+	req.Var("event", event)
+	req.Var("body", body)
+
+	var resp struct {
+		AddPullRequestReview struct {
+			PullRequestReview struct {
+				ID string `json:"id"`
+			} `json:"pullRequestReview"`
+		} `json:"addPullRequestReview"`
+	}
+
+	if err := c.runMutation(ctx, req, &resp, false); err != nil {
+		return fmt.Errorf("failed to submit review: %w", err)
+	}
+	return nil
+}
+
 // getIssueOrPRNodeID retrieves the GraphQL node ID for an issue or PR.
 func (c *Client) getIssueOrPRNodeID(ctx context.Context, owner, repo string, number int) (string, error) {
 	req := graphql.NewRequest(`
@@ -125,8 +430,192 @@ func (c *Client) getIssueOrPRNodeID(ctx context.Context, owner, repo string, num
 	}
 
 	if resp.Repository.IssueOrPullRequest.ID == "" {
-		return "", fmt.Errorf("issue or PR #%d not found in %s/%s", number, owner, repo)
+		return "", notFoundf("issue or PR #%d not found in %s/%s", number, owner, repo)
 	}
 
 	return resp.Repository.IssueOrPullRequest.ID, nil
 }
+
+// AddDraftIssue creates a draft issue directly in a project.
+// Returns the new project item ID.
+func (c *Client) AddDraftIssue(ctx context.Context, projectID string, title string, body string) (string, error) {
+	req := graphql.NewRequest(`
+		mutation($projectId: ID!, $title: String!, $body: String) {
+			addProjectV2DraftIssue(input: {projectId: $projectId, title: $title, body: $body}) {
+				projectItem {
+					id
+				}
+			}
+		}
+	`)
+
+	req.Var("projectId", projectID)
+	req.Var("title", title)
+	req.Var("body", body)
+
+	var resp struct {
+		AddProjectV2DraftIssue struct {
+			ProjectItem struct {
+				ID string `json:"id"`
+			} `json:"projectItem"`
+		} `json:"addProjectV2DraftIssue"`
+	}
+
+	if err := c.makeRequest(ctx, req, &resp); err != nil {
+		return "", fmt.Errorf("failed to add draft issue: %w", err)
+	}
+
+	return resp.AddProjectV2DraftIssue.ProjectItem.ID, nil
+}
+
+// CreateIssue opens a new issue in a repository.
+// Returns the issue node ID, which can be added to a project with AddItem.
+func (c *Client) CreateIssue(ctx context.Context, owner, repo string, title string, body string) (string, error) {
+	repoID, err := c.getRepositoryID(ctx, owner, repo)
+	if err != nil {
+		return "", err
+	}
+
+	req := graphql.NewRequest(`
+		mutation($repositoryId: ID!, $title: String!, $body: String) {
+			createIssue(input: {repositoryId: $repositoryId, title: $title, body: $body}) {
+				issue {
+					id
+				}
+			}
+		}
+	`)
+
+	req.Var("repositoryId", repoID)
+	req.Var("title", title)
+	req.Var("body", body)
+
+	var resp struct {
+		CreateIssue struct {
+			Issue struct {
+				ID string `json:"id"`
+			} `json:"issue"`
+		} `json:"createIssue"`
+	}
+
+	if err := c.makeRequest(ctx, req, &resp); err != nil {
+		return "", fmt.Errorf("failed to create issue: %w", err)
+	}
+
+	return resp.CreateIssue.Issue.ID, nil
+}
+
+// AddItem adds an existing issue or pull request to a project.
+// Returns the new project item ID.
+func (c *Client) AddItem(ctx context.Context, projectID string, contentID string) (string, error) {
+	req := graphql.NewRequest(`
+		mutation($projectId: ID!, $contentId: ID!) {
+			addProjectV2ItemById(input: {projectId: $projectId, contentId: $contentId}) {
+				item {
+					id
+				}
+			}
+		}
+	`)
+
+	req.Var("projectId", projectID)
+	req.Var("contentId", contentID)
+
+	var resp struct {
+		AddProjectV2ItemById struct {
+			Item struct {
+				ID string `json:"id"`
+			} `json:"item"`
+		} `json:"addProjectV2ItemById"`
+	}
+
+	if err := c.makeRequest(ctx, req, &resp); err != nil {
+		return "", fmt.Errorf("failed to add item to project: %w", err)
+	}
+
+	return resp.AddProjectV2ItemById.Item.ID, nil
+}
+
+// ConvertedIssue is the issue a draft issue became
+type ConvertedIssue struct {
+	ID     string
+	Number int
+	URL    string
+}
+
+// ConvertDraftIssue turns a project's draft issue into an issue in a
+// repository. itemID is the project item ID, which stays the same.
+func (c *Client) ConvertDraftIssue(ctx context.Context, itemID, owner, repo string) (ConvertedIssue, error) {
+	repoID, err := c.getRepositoryID(ctx, owner, repo)
+	if err != nil {
+		return ConvertedIssue{}, err
+	}
+
+	req := graphql.NewRequest(`
+		mutation($itemId: ID!, $repositoryId: ID!) {
+			convertProjectV2DraftIssueItemToIssue(input: {itemId: $itemId, repositoryId: $repositoryId}) {
+				item {
+					content {
+						... on Issue {
+							id
+							number
+							url
+						}
+					}
+				}
+			}
+		}
+	`)
+
+	req.Var("itemId", itemID)
+	req.Var("repositoryId", repoID)
+
+	var resp struct {
+		ConvertProjectV2DraftIssueItemToIssue struct {
+			Item struct {
+				Content struct {
+					ID     string `json:"id"`
+					Number int    `json:"number"`
+					URL    string `json:"url"`
+				} `json:"content"`
+			} `json:"item"`
+		} `json:"convertProjectV2DraftIssueItemToIssue"`
+	}
+
+	if err := c.makeRequest(ctx, req, &resp); err != nil {
+		return ConvertedIssue{}, fmt.Errorf("failed to convert draft issue: %w", err)
+	}
+
+	content := resp.ConvertProjectV2DraftIssueItemToIssue.Item.Content
+	return ConvertedIssue{ID: content.ID, Number: content.Number, URL: content.URL}, nil
+}
+
+// getRepositoryID retrieves the GraphQL node ID for a repository.
+func (c *Client) getRepositoryID(ctx context.Context, owner, repo string) (string, error) {
+	req := graphql.NewRequest(`
+		query($owner: String!, $repo: String!) {
+			repository(owner: $owner, name: $repo) {
+				id
+			}
+		}
+	`)
+
+	req.Var("owner", owner)
+	req.Var("repo", repo)
+
+	var resp struct {
+		Repository *struct {
+			ID string `json:"id"`
+		} `json:"repository"`
+	}
+
+	if err := c.makeRequest(ctx, req, &resp); err != nil {
+		return "", fmt.Errorf("failed to look up repository: %w", err)
+	}
+
+	if resp.Repository == nil {
+		return "", notFoundf("repository %s/%s not found", owner, repo)
+	}
+
+	return resp.Repository.ID, nil
+}
@@ -3,6 +3,8 @@ package gh
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/h0rv/ghp/internal/domain"
 	"github.com/machinebox/graphql"
@@ -117,7 +119,7 @@ func (c *Client) ResolveOwner(ctx context.Context, login string) (OwnerType, str
 		return OwnerTypeUser, resp.User.ID, nil
 	}
 
-	return "", "", fmt.Errorf("login '%s' not found (neither organization nor user)", login)
+	return "", "", notFoundf("login '%s' not found (neither organization nor user)", login)
 }
 
 // ListProjects lists all projects for a given owner.
@@ -136,6 +138,10 @@ func (c *Client) ListProjects(ctx context.Context, ownerType OwnerType, ownerID
 								id
 								number
 								title
+								updatedAt
+								items {
+									totalCount
+								}
 							}
 						}
 					}
@@ -152,6 +158,10 @@ func (c *Client) ListProjects(ctx context.Context, ownerType OwnerType, ownerID
 								id
 								number
 								title
+								updatedAt
+								items {
+									totalCount
+								}
 							}
 						}
 					}
@@ -168,9 +178,13 @@ func (c *Client) ListProjects(ctx context.Context, ownerType OwnerType, ownerID
 		Node struct {
 			ProjectsV2 struct {
 				Nodes []struct {
-					ID     string `json:"id"`
-					Number int    `json:"number"`
-					Title  string `json:"title"`
+					ID        string `json:"id"`
+					Number    int    `json:"number"`
+					Title     string `json:"title"`
+					UpdatedAt string `json:"updatedAt"`
+					Items     struct {
+						TotalCount int `json:"totalCount"`
+					} `json:"items"`
 				} `json:"nodes"`
 			} `json:"projectsV2"`
 		} `json:"node"`
@@ -183,10 +197,81 @@ func (c *Client) ListProjects(ctx context.Context, ownerType OwnerType, ownerID
 	projects := make([]domain.Project, 0, len(resp.Node.ProjectsV2.Nodes))
 	for _, node := range resp.Node.ProjectsV2.Nodes {
 		projects = append(projects, domain.Project{
-			ID:     node.ID,
-			Number: node.Number,
-			Title:  node.Title,
-			Owner:  login,
+			ID:        node.ID,
+			Number:    node.Number,
+			Title:     node.Title,
+			Owner:     login,
+			ItemCount: node.Items.TotalCount,
+			UpdatedAt: node.UpdatedAt,
+		})
+	}
+
+	return projects, nil
+}
+
+// ListRepoProjects lists the projects a repository is linked to, which may
+// belong to different owners.
+func (c *Client) ListRepoProjects(ctx context.Context, owner, name string) ([]domain.Project, error) {
+	req := graphql.NewRequest(`
+		query($owner: String!, $name: String!, $first: Int!) {
+			repository(owner: $owner, name: $name) {
+				projectsV2(first: $first) {
+					nodes {
+						id
+						number
+						title
+						updatedAt
+						owner {
+							... on Organization { login }
+							... on User { login }
+						}
+						items {
+							totalCount
+						}
+					}
+				}
+			}
+		}
+	`)
+	req.Var("owner", owner)
+	req.Var("name", name)
+	req.Var("first", 100)
+
+	var resp struct {
+		Repository *struct {
+			ProjectsV2 struct {
+				Nodes []struct {
+					ID        string `json:"id"`
+					Number    int    `json:"number"`
+					Title     string `json:"title"`
+					UpdatedAt string `json:"updatedAt"`
+					Owner     struct {
+						Login string `json:"login"`
+					} `json:"owner"`
+					Items struct {
+						TotalCount int `json:"totalCount"`
+					} `json:"items"`
+				} `json:"nodes"`
+			} `json:"projectsV2"`
+		} `json:"repository"`
+	}
+
+	if err := c.makeRequest(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("failed to list projects for %s/%s: %w", owner, name, err)
+	}
+	if resp.Repository == nil {
+		return nil, notFoundf("repository %s/%s not found", owner, name)
+	}
+
+	projects := make([]domain.Project, 0, len(resp.Repository.ProjectsV2.Nodes))
+	for _, node := range resp.Repository.ProjectsV2.Nodes {
+		projects = append(projects, domain.Project{
+			ID:        node.ID,
+			Number:    node.Number,
+			Title:     node.Title,
+			Owner:     node.Owner.Login,
+			ItemCount: node.Items.TotalCount,
+			UpdatedAt: node.UpdatedAt,
 		})
 	}
 
@@ -200,30 +285,7 @@ func (c *Client) GetProjectFields(ctx context.Context, projectID string) ([]doma
 		query($projectId: ID!) {
 			node(id: $projectId) {
 				... on ProjectV2 {
-					fields(first: 50) {
-						nodes {
-							... on ProjectV2Field {
-								id
-								name
-								dataType
-							}
-							... on ProjectV2SingleSelectField {
-								id
-								name
-								dataType
-								options {
-									id
-									name
-									color
-								}
-							}
-							... on ProjectV2IterationField {
-								id
-								name
-								dataType
-							}
-						}
-					}
+					` + projectFieldsSelection + `
 				}
 			}
 		}
@@ -233,16 +295,7 @@ func (c *Client) GetProjectFields(ctx context.Context, projectID string) ([]doma
 	var resp struct {
 		Node struct {
 			Fields struct {
-				Nodes []struct {
-					ID       string `json:"id"`
-					Name     string `json:"name"`
-					DataType string `json:"dataType"`
-					Options  []struct {
-						ID    string `json:"id"`
-						Name  string `json:"name"`
-						Color string `json:"color"`
-					} `json:"options"`
-				} `json:"nodes"`
+				Nodes []fieldNode `json:"nodes"`
 			} `json:"fields"`
 		} `json:"node"`
 	}
@@ -251,8 +304,67 @@ func (c *Client) GetProjectFields(ctx context.Context, projectID string) ([]doma
 		return nil, fmt.Errorf("failed to get project fields: %w", err)
 	}
 
-	fields := make([]domain.FieldDef, 0, len(resp.Node.Fields.Nodes))
-	for idx, node := range resp.Node.Fields.Nodes {
+	return toFieldDefs(resp.Node.Fields.Nodes), nil
+}
+
+// projectFieldsSelection selects a project's fields for GetProjectFields and GetProjectByNumber.
+const projectFieldsSelection = `
+	fields(first: 50) {
+		nodes {
+			... on ProjectV2Field {
+				id
+				name
+				dataType
+			}
+			... on ProjectV2SingleSelectField {
+				id
+				name
+				dataType
+				options {
+					id
+					name
+					color
+				}
+			}
+			... on ProjectV2IterationField {
+				id
+				name
+				dataType
+				configuration {
+					iterations {
+						id
+						title
+						startDate
+					}
+				}
+			}
+		}
+	}
+`
+
+// fieldNode is a project field as returned by the API
+type fieldNode struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	DataType string `json:"dataType"`
+	Options  []struct {
+		ID    string `json:"id"`
+		Name  string `json:"name"`
+		Color string `json:"color"`
+	} `json:"options"`
+	Configuration *struct {
+		Iterations []struct {
+			ID        string `json:"id"`
+			Title     string `json:"title"`
+			StartDate string `json:"startDate"`
+		} `json:"iterations"`
+	} `json:"configuration"`
+}
+
+// toFieldDefs converts field nodes to domain fields, preserving API order
+func toFieldDefs(nodes []fieldNode) []domain.FieldDef {
+	fields := make([]domain.FieldDef, 0, len(nodes))
+	for idx, node := range nodes {
 		field := domain.FieldDef{
 			ID:   node.ID,
 			Name: node.Name,
@@ -273,90 +385,83 @@ func (c *Client) GetProjectFields(ctx context.Context, projectID string) ([]doma
 			}
 		}
 
+		// Iteration fields list their current and upcoming iterations
+		if node.Configuration != nil {
+			for i, it := range node.Configuration.Iterations {
+				field.Iterations = append(field.Iterations, domain.Option{
+					ID:    it.ID,
+					Name:  fmt.Sprintf("%s (%s)", it.Title, it.StartDate),
+					Order: i,
+				})
+			}
+		}
+
 		// Store field order as well
 		field.Order = idx
 		fields = append(fields, field)
 	}
+	return fields
+}
+
+// GetProjectByNumber fetches a project and its fields by owner login and
+// project number in a single request, without resolving the owner first.
+func (c *Client) GetProjectByNumber(ctx context.Context, login string, number int) (domain.Project, []domain.FieldDef, error) {
+	req := graphql.NewRequest(`
+		query($login: String!, $number: Int!) {
+			repositoryOwner(login: $login) {
+				... on ProjectV2Owner {
+					projectV2(number: $number) {
+						id
+						number
+						title
+						` + projectFieldsSelection + `
+					}
+				}
+			}
+		}
+	`)
+	req.Var("login", login)
+	req.Var("number", number)
 
-	return fields, nil
+	var resp struct {
+		RepositoryOwner *struct {
+			ProjectV2 *struct {
+				ID     string `json:"id"`
+				Number int    `json:"number"`
+				Title  string `json:"title"`
+				Fields struct {
+					Nodes []fieldNode `json:"nodes"`
+				} `json:"fields"`
+			} `json:"projectV2"`
+		} `json:"repositoryOwner"`
+	}
+
+	if err := c.makeRequest(ctx, req, &resp); err != nil {
+		return domain.Project{}, nil, fmt.Errorf("failed to get project #%d: %w", number, err)
+	}
+	if resp.RepositoryOwner == nil {
+		return domain.Project{}, nil, notFoundf("login '%s' not found (neither organization nor user)", login)
+	}
+	p := resp.RepositoryOwner.ProjectV2
+	if p == nil {
+		return domain.Project{}, nil, notFoundf("project #%d not found for owner %s", number, login)
+	}
+
+	project := domain.Project{ID: p.ID, Number: p.Number, Title: p.Title, Owner: login}
+	return project, toFieldDefs(p.Fields.Nodes), nil
 }
 
 // GetItems fetches project items with pagination.
-// Fetches grouping field value and assignees for filtering.
+// This is the slim board query: title, number, state, grouping and other
+// single-select values, assignees, authors, parent issues, and update times. Bodies, labels,
+// and creation times are left out to keep big boards cheap; fetch them with GetItemDetails.
 // Returns cards, next cursor, and whether there are more items.
 func (c *Client) GetItems(ctx context.Context, projectID string, groupFieldName string, cursor string, limit int) ([]domain.Card, string, bool, error) {
 	query := `
 		query($projectId: ID!, $first: Int!, $after: String, $fieldName: String!) {
 			node(id: $projectId) {
 				... on ProjectV2 {
-					items(first: $first, after: $after) {
-						pageInfo {
-							hasNextPage
-							endCursor
-						}
-						nodes {
-							id
-							fieldValueByName(name: $fieldName) {
-								... on ProjectV2ItemFieldSingleSelectValue {
-									optionId
-								}
-							}
-							content {
-								__typename
-								... on Issue {
-									title
-									body
-									url
-									number
-									state
-									createdAt
-									author {
-										login
-									}
-									repository {
-										nameWithOwner
-									}
-									assignees(first: 10) {
-										nodes {
-											login
-										}
-									}
-									labels(first: 10) {
-										nodes {
-											name
-										}
-									}
-								}
-								... on PullRequest {
-									title
-									body
-									url
-									number
-									state
-									createdAt
-									author {
-										login
-									}
-									repository {
-										nameWithOwner
-									}
-									assignees(first: 10) {
-										nodes {
-											login
-										}
-									}
-									labels(first: 10) {
-										nodes {
-											name
-										}
-									}
-								}
-								... on DraftIssue {
-									title
-								}
-							}
-						}
-					}
+					` + itemsSelection + `
 				}
 			}
 		}
@@ -374,52 +479,137 @@ func (c *Client) GetItems(ctx context.Context, projectID string, groupFieldName
 
 	var resp struct {
 		Node struct {
-			Items struct {
-				PageInfo struct {
-					HasNextPage bool   `json:"hasNextPage"`
-					EndCursor   string `json:"endCursor"`
-				} `json:"pageInfo"`
-				Nodes []struct {
-					ID               string `json:"id"`
-					FieldValueByName *struct {
-						OptionID string `json:"optionId"`
-					} `json:"fieldValueByName"`
-					Content *struct {
-						Typename  string `json:"__typename"`
-						Title     string `json:"title"`
-						Body      string `json:"body"`
-						URL       string `json:"url"`
-						Number    int    `json:"number"`
-						State     string `json:"state"`
-						CreatedAt string `json:"createdAt"`
-						Author    *struct {
-							Login string `json:"login"`
-						} `json:"author"`
-						Repository *struct {
-							NameWithOwner string `json:"nameWithOwner"`
-						} `json:"repository"`
-						Assignees *struct {
-							Nodes []struct {
-								Login string `json:"login"`
-							} `json:"nodes"`
-						} `json:"assignees"`
-						Labels *struct {
-							Nodes []struct {
-								Name string `json:"name"`
-							} `json:"nodes"`
-						} `json:"labels"`
-					} `json:"content"`
-				} `json:"nodes"`
-			} `json:"items"`
+			Items itemsPage `json:"items"`
 		} `json:"node"`
 	}
 
-	if err := c.makeRequest(ctx, req, &resp); err != nil {
-		return nil, "", false, fmt.Errorf("failed to get items: %w", err)
+	err := c.makeRequest(ctx, req, &resp)
+	cards, err := resp.Node.Items.cards(err)
+	if err != nil {
+		return nil, "", false, err
 	}
+	return cards, resp.Node.Items.PageInfo.EndCursor, resp.Node.Items.PageInfo.HasNextPage, nil
+}
 
-	cards := make([]domain.Card, 0, len(resp.Node.Items.Nodes))
-	for _, node := range resp.Node.Items.Nodes {
+// GetFirstItemsByNumber fetches the first page of a project's items by
+// owner and project number, so it can run alongside GetProjectByNumber
+// before the project's ID is known. Returns like GetItems.
+func (c *Client) GetFirstItemsByNumber(ctx context.Context, login string, number int, groupFieldName string, limit int) ([]domain.Card, string, bool, error) {
+	req := graphql.NewRequest(`
+		query($login: String!, $number: Int!, $first: Int!, $after: String, $fieldName: String!) {
+			repositoryOwner(login: $login) {
+				... on ProjectV2Owner {
+					projectV2(number: $number) {
+						` + itemsSelection + `
+					}
+				}
+			}
+		}
+	`)
+	req.Var("login", login)
+	req.Var("number", number)
+	req.Var("first", limit)
+	req.Var("after", nil)
+	req.Var("fieldName", groupFieldName)
+
+	var resp struct {
+		RepositoryOwner *struct {
+			ProjectV2 *struct {
+				Items itemsPage `json:"items"`
+			} `json:"projectV2"`
+		} `json:"repositoryOwner"`
+	}
+	err := c.makeRequest(ctx, req, &resp)
+	if resp.RepositoryOwner == nil || resp.RepositoryOwner.ProjectV2 == nil {
+		if err != nil {
+			return nil, "", false, fmt.Errorf("failed to get items: %w", err)
+		}
+		return nil, "", false, notFoundf("project #%d not found for owner %s", number, login)
+	}
+	page := resp.RepositoryOwner.ProjectV2.Items
+	cards, err := page.cards(err)
+	if err != nil {
+		return nil, "", false, err
+	}
+	return cards, page.PageInfo.EndCursor, page.PageInfo.HasNextPage, nil
+}
+
+// itemsPage is a page of project items as returned by the API
+type itemsPage struct {
+	PageInfo struct {
+		HasNextPage bool   `json:"hasNextPage"`
+		EndCursor   string `json:"endCursor"`
+	} `json:"pageInfo"`
+	Nodes []struct {
+		ID               string `json:"id"`
+		FieldValueByName *struct {
+			OptionID string `json:"optionId"`
+		} `json:"fieldValueByName"`
+		FieldValues struct {
+			Nodes []struct {
+				OptionID string `json:"optionId"`
+				Field    *struct {
+					Name string `json:"name"`
+				} `json:"field"`
+			} `json:"nodes"`
+		} `json:"fieldValues"`
+		Content *struct {
+			Typename   string `json:"__typename"`
+			ID         string `json:"id"`
+			Title      string `json:"title"`
+			URL        string `json:"url"`
+			Number     int    `json:"number"`
+			State      string `json:"state"`
+			UpdatedAt  string `json:"updatedAt"`
+			Repository *struct {
+				NameWithOwner string `json:"nameWithOwner"`
+			} `json:"repository"`
+			Author    *actor `json:"author"`
+			Assignees *struct {
+				Nodes []struct {
+					Login string `json:"login"`
+				} `json:"nodes"`
+			} `json:"assignees"`
+			Parent *struct {
+				Title      string `json:"title"`
+				Number     int    `json:"number"`
+				Repository struct {
+					NameWithOwner string `json:"nameWithOwner"`
+				} `json:"repository"`
+			} `json:"parent"`
+			IsDraft        bool         `json:"isDraft"`
+			ReviewDecision string       `json:"reviewDecision"`
+			Mergeable      string       `json:"mergeable"`
+			Commits        *headCommits `json:"commits"`
+		} `json:"content"`
+	} `json:"nodes"`
+}
+
+// cards converts the page's items, given the error the request returned
+// with it.
+func (p itemsPage) cards(err error) ([]domain.Card, error) {
+	// Items the token may not read come back null alongside a FORBIDDEN or
+	// SAML error; keep the rest of the page and mark those items restricted.
+	// Any other error fails the page.
+	if err != nil {
+		restricted := false
+		for _, node := range p.Nodes {
+			if node.Content == nil {
+				restricted = true
+				break
+			}
+		}
+		if !restricted || !(isSAMLError(err) || isForbiddenError(err)) {
+			return nil, fmt.Errorf("failed to get items: %w", err)
+		}
+	}
+	accessHint := NoAccessHint
+	if isSAMLError(err) {
+		accessHint = SSOHint
+	}
+
+	cards := make([]domain.Card, 0, len(p.Nodes))
+	for _, node := range p.Nodes {
 		card := domain.Card{
 			ItemID: node.ID,
 		}
@@ -429,12 +619,31 @@ func (c *Client) GetItems(ctx context.Context, projectID string, groupFieldName
 			card.GroupOptionID = node.FieldValueByName.OptionID
 		}
 
+		// Extract other single-select values (non-single-select values decode empty)
+		for _, v := range node.FieldValues.Nodes {
+			if v.Field == nil || v.Field.Name == "" || v.OptionID == "" {
+				continue
+			}
+			if card.FieldValues == nil {
+				card.FieldValues = make(map[string]string)
+			}
+			card.FieldValues[v.Field.Name] = v.OptionID
+		}
+
 		// Handle content union (Issue/PR/Draft/null)
 		if node.Content == nil {
-			// Null content (private or deleted item)
-			card.ContentType = domain.ContentTypePrivate
-			card.Title = "(private item)"
+			// Null content: the token can't read the item's repository
+			card.ContentType = domain.ContentTypeRestricted
+			card.Title = "(restricted item)"
+			card.AccessHint = accessHint
 		} else {
+			card.ContentID = node.Content.ID
+			card.UpdatedAt = node.Content.UpdatedAt
+			if a := node.Content.Author; a != nil {
+				card.Author = a.Login
+				card.AuthorIsBot = a.isBot()
+			}
+
 			// Extract assignees
 			if node.Content.Assignees != nil {
 				card.Assignees = make([]string, 0, len(node.Content.Assignees.Nodes))
@@ -443,41 +652,34 @@ func (c *Client) GetItems(ctx context.Context, projectID string, groupFieldName
 				}
 			}
 
-			// Extract labels
-			if node.Content.Labels != nil {
-				card.Labels = make([]string, 0, len(node.Content.Labels.Nodes))
-				for _, l := range node.Content.Labels.Nodes {
-					card.Labels = append(card.Labels, l.Name)
-				}
-			}
-
-			// Extract author and createdAt
-			card.CreatedAt = node.Content.CreatedAt
-			if node.Content.Author != nil {
-				card.Author = node.Content.Author.Login
-			}
-
 			switch node.Content.Typename {
 			case "Issue":
 				card.ContentType = domain.ContentTypeIssue
 				card.Title = node.Content.Title
-				card.Body = node.Content.Body
 				card.URL = node.Content.URL
 				card.Number = node.Content.Number
 				card.State = node.Content.State
 				if node.Content.Repository != nil {
 					card.Repo = node.Content.Repository.NameWithOwner
 				}
+				if p := node.Content.Parent; p != nil {
+					card.Parent = &domain.IssueRef{Repo: p.Repository.NameWithOwner, Number: p.Number, Title: p.Title}
+				}
 			case "PullRequest":
 				card.ContentType = domain.ContentTypePullRequest
 				card.Title = node.Content.Title
-				card.Body = node.Content.Body
 				card.URL = node.Content.URL
 				card.Number = node.Content.Number
 				card.State = node.Content.State
 				if node.Content.Repository != nil {
 					card.Repo = node.Content.Repository.NameWithOwner
 				}
+				card.PR = &domain.PRStatus{
+					Draft:          node.Content.IsDraft,
+					ReviewDecision: node.Content.ReviewDecision,
+					Checks:         node.Content.Commits.checksState(),
+					Mergeable:      node.Content.Mergeable,
+				}
 			case "DraftIssue":
 				card.ContentType = domain.ContentTypeDraftIssue
 				card.Title = node.Content.Title
@@ -492,89 +694,817 @@ func (c *Client) GetItems(ctx context.Context, projectID string, groupFieldName
 		cards = append(cards, card)
 	}
 
-	return cards, resp.Node.Items.PageInfo.EndCursor, resp.Node.Items.PageInfo.HasNextPage, nil
+	return cards, nil
+}
+
+// itemsSelection selects a page of project items for GetItems and
+// GetFirstItemsByNumber.
+const itemsSelection = `
+	items(first: $first, after: $after) {
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		nodes {
+			id
+			fieldValueByName(name: $fieldName) {
+				... on ProjectV2ItemFieldSingleSelectValue {
+					optionId
+				}
+			}
+			fieldValues(first: 20) {
+				nodes {
+					... on ProjectV2ItemFieldSingleSelectValue {
+						optionId
+						field {
+							... on ProjectV2SingleSelectField {
+								name
+							}
+						}
+					}
+				}
+			}
+			content {
+				__typename
+				... on Issue {
+					id
+					title
+					url
+					number
+					state
+					updatedAt
+					repository {
+						nameWithOwner
+					}
+					author {
+						__typename
+						login
+					}
+					assignees(first: 10) {
+						nodes {
+							login
+						}
+					}
+					parent {
+						title
+						number
+						repository {
+							nameWithOwner
+						}
+					}
+				}
+				... on PullRequest {
+					id
+					title
+					url
+					number
+					state
+					updatedAt
+					isDraft
+					reviewDecision
+					mergeable
+					commits(last: 1) {
+						nodes {
+							commit {
+								statusCheckRollup {
+									state
+								}
+							}
+						}
+					}
+					repository {
+						nameWithOwner
+					}
+					author {
+						__typename
+						login
+					}
+					assignees(first: 10) {
+						nodes {
+							login
+						}
+					}
+				}
+				... on DraftIssue {
+					id
+					title
+					updatedAt
+				}
+			}
+		}
+	}
+`
+
+// Access hints for restricted items.
+const (
+	SSOHint      = "Organization requires SAML SSO: authorize your token (gh auth refresh, or Configure SSO in token settings)"
+	NoAccessHint = "Your token cannot read this item's repository"
+)
+
+// GetAllItems fetches every item in a project by following pagination cursors.
+// Intended for non-interactive commands that need the complete item list up front.
+func (c *Client) GetAllItems(ctx context.Context, projectID string, groupFieldName string, pageSize int) ([]domain.Card, error) {
+	var all []domain.Card
+	cursor := ""
+
+	for {
+		cards, nextCursor, hasMore, err := c.GetItems(ctx, projectID, groupFieldName, cursor, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, cards...)
+
+		if !hasMore || nextCursor == "" {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	return all, nil
+}
+
+// actor is the author of an issue, pull request, or comment
+type actor struct {
+	Typename string `json:"__typename"`
+	Login    string `json:"login"`
+}
+
+// isBot reports whether the actor is a bot or GitHub App, such as dependabot.
+// GraphQL drops the "[bot]" login suffix REST uses, but check it anyway.
+func (a actor) isBot() bool {
+	return a.Typename == "Bot" || strings.HasSuffix(a.Login, "[bot]")
+}
+
+// detailsBatchSize is the number of node IDs looked up per GetItemDetails request.
+const detailsBatchSize = 50
+
+// GetItemDetails fetches the body, labels, author, and timestamps of issues,
+// pull requests, and draft issues by content node ID. The result maps content
+// ID to details; unknown IDs are omitted.
+func (c *Client) GetItemDetails(ctx context.Context, contentIDs []string) (map[string]domain.ItemDetails, error) {
+	result := make(map[string]domain.ItemDetails, len(contentIDs))
+
+	for start := 0; start < len(contentIDs); start += detailsBatchSize {
+		end := min(start+detailsBatchSize, len(contentIDs))
+
+		req := graphql.NewRequest(`
+			query($ids: [ID!]!) {
+				nodes(ids: $ids) {
+					... on Issue {
+						id
+						body
+						createdAt
+						updatedAt
+						author {
+							__typename
+							login
+						}
+						labels(first: 20) {
+							nodes {
+								name
+							}
+						}
+					}
+					... on PullRequest {
+						id
+						body
+						createdAt
+						updatedAt
+						author {
+							__typename
+							login
+						}
+						labels(first: 20) {
+							nodes {
+								name
+							}
+						}
+					}
+					... on DraftIssue {
+						id
+						body
+						createdAt
+						updatedAt
+						creator {
+							__typename
+							login
+						}
+					}
+				}
+			}
+		`)
+		req.Var("ids", contentIDs[start:end])
+
+		var resp struct {
+			Nodes []*struct {
+				ID        string `json:"id"`
+				Body      string `json:"body"`
+				CreatedAt string `json:"createdAt"`
+				UpdatedAt string `json:"updatedAt"`
+				Author    *actor `json:"author"`
+				Creator   *actor `json:"creator"`
+				Labels    *struct {
+					Nodes []struct {
+						Name string `json:"name"`
+					} `json:"nodes"`
+				} `json:"labels"`
+			} `json:"nodes"`
+		}
+
+		if err := c.makeRequest(ctx, req, &resp); err != nil {
+			return nil, fmt.Errorf("failed to get item details: %w", err)
+		}
+
+		for _, node := range resp.Nodes {
+			if node == nil || node.ID == "" {
+				continue
+			}
+			details := domain.ItemDetails{
+				Body:      node.Body,
+				CreatedAt: node.CreatedAt,
+				UpdatedAt: node.UpdatedAt,
+			}
+			if node.Author != nil {
+				details.Author, details.AuthorIsBot = node.Author.Login, node.Author.isBot()
+			} else if node.Creator != nil {
+				details.Author, details.AuthorIsBot = node.Creator.Login, node.Creator.isBot()
+			}
+			if node.Labels != nil {
+				details.Labels = make([]string, 0, len(node.Labels.Nodes))
+				for _, l := range node.Labels.Nodes {
+					details.Labels = append(details.Labels, l.Name)
+				}
+			}
+			result[node.ID] = details
+		}
+	}
+
+	return result, nil
 }
 
-// GetComments fetches comments for an issue or pull request.
-func (c *Client) GetComments(ctx context.Context, owner, repo string, number int) ([]domain.Comment, error) {
+// commentsPageSize is the number of comments fetched per GetComments page.
+const commentsPageSize = 100
+
+// commentNode is an issue, pull request, or review comment
+type commentNode struct {
+	ID        string `json:"id"`
+	URL       string `json:"url"`
+	Author    *actor `json:"author"`
+	Body      string `json:"body"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+func (n commentNode) comment() domain.Comment {
+	comment := domain.Comment{
+		ID:        n.ID,
+		URL:       n.URL,
+		Body:      n.Body,
+		CreatedAt: n.CreatedAt,
+		UpdatedAt: n.UpdatedAt,
+	}
+	// Deleted users leave no author
+	if n.Author != nil {
+		comment.Author = n.Author.Login
+		comment.AuthorIsBot = n.Author.isBot()
+	}
+	return comment
+}
+
+// GetComments fetches a page of comments for an issue or pull request, the
+// newest page when before is "" and otherwise the page ending at that cursor.
+// The newest page of a pull request also has its review threads, up to 50
+// threads of 50 comments.
+func (c *Client) GetComments(ctx context.Context, owner, repo string, number int, before string) (domain.CommentPage, error) {
 	req := graphql.NewRequest(`
-		query($owner: String!, $repo: String!, $number: Int!) {
+		query($owner: String!, $repo: String!, $number: Int!, $last: Int!, $before: String, $threads: Boolean!) {
 			repository(owner: $owner, name: $repo) {
 				issueOrPullRequest(number: $number) {
 					... on Issue {
-						comments(first: 100) {
-							nodes {
-								id
-								author {
-									login
-								}
-								body
-								createdAt
-								updatedAt
-							}
+						comments(last: $last, before: $before) {
+							pageInfo { hasPreviousPage startCursor }
+							nodes { ...issueComment }
 						}
 					}
 					... on PullRequest {
-						comments(first: 100) {
+						comments(last: $last, before: $before) {
+							pageInfo { hasPreviousPage startCursor }
+							nodes { ...issueComment }
+						}
+						reviewThreads(first: 50) @include(if: $threads) {
 							nodes {
-								id
-								author {
-									login
+								path
+								line
+								isResolved
+								isOutdated
+								comments(first: 50) {
+									nodes { ...reviewComment }
 								}
-								body
-								createdAt
-								updatedAt
 							}
 						}
 					}
 				}
 			}
 		}
+
+		fragment issueComment on IssueComment {
+			id url body createdAt updatedAt
+			author { __typename login }
+		}
+
+		fragment reviewComment on PullRequestReviewComment {
+			id url body createdAt updatedAt
+			author { __typename login }
+		}
 	`)
 	req.Var("owner", owner)
 	req.Var("repo", repo)
 	req.Var("number", number)
+	req.Var("last", commentsPageSize)
+	if before != "" {
+		req.Var("before", before)
+	}
+	req.Var("threads", before == "")
 
 	var resp struct {
 		Repository struct {
 			IssueOrPullRequest struct {
 				Comments struct {
+					PageInfo struct {
+						HasPreviousPage bool   `json:"hasPreviousPage"`
+						StartCursor     string `json:"startCursor"`
+					} `json:"pageInfo"`
+					Nodes []commentNode `json:"nodes"`
+				} `json:"comments"`
+				ReviewThreads struct {
 					Nodes []struct {
-						ID     string `json:"id"`
-						Author *struct {
-							Login string `json:"login"`
-						} `json:"author"`
-						Body      string `json:"body"`
-						CreatedAt string `json:"createdAt"`
-						UpdatedAt string `json:"updatedAt"`
+						Path       string `json:"path"`
+						Line       int    `json:"line"`
+						IsResolved bool   `json:"isResolved"`
+						IsOutdated bool   `json:"isOutdated"`
+						Comments   struct {
+							Nodes []commentNode `json:"nodes"`
+						} `json:"comments"`
 					} `json:"nodes"`
-				} `json:"comments"`
+				} `json:"reviewThreads"`
 			} `json:"issueOrPullRequest"`
 		} `json:"repository"`
 	}
 
 	if err := c.makeRequest(ctx, req, &resp); err != nil {
-		return nil, fmt.Errorf("failed to get comments: %w", err)
+		return domain.CommentPage{}, fmt.Errorf("failed to get comments: %w", err)
 	}
 
-	comments := make([]domain.Comment, 0, len(resp.Repository.IssueOrPullRequest.Comments.Nodes))
-	for _, node := range resp.Repository.IssueOrPullRequest.Comments.Nodes {
-		comment := domain.Comment{
-			ID:        node.ID,
-			Body:      node.Body,
-			CreatedAt: node.CreatedAt,
-			UpdatedAt: node.UpdatedAt,
+	item := resp.Repository.IssueOrPullRequest
+	page := domain.CommentPage{Comments: make([]domain.Comment, 0, len(item.Comments.Nodes))}
+	for _, node := range item.Comments.Nodes {
+		page.Comments = append(page.Comments, node.comment())
+	}
+	if item.Comments.PageInfo.HasPreviousPage {
+		page.OlderCursor = item.Comments.PageInfo.StartCursor
+	}
+	for _, node := range item.ReviewThreads.Nodes {
+		thread := domain.ReviewThread{
+			Path:       node.Path,
+			Line:       node.Line,
+			IsResolved: node.IsResolved,
+			IsOutdated: node.IsOutdated,
 		}
+		for _, c := range node.Comments.Nodes {
+			thread.Comments = append(thread.Comments, c.comment())
+		}
+		page.Threads = append(page.Threads, thread)
+	}
+	return page, nil
+}
 
-		// Handle deleted users (author is nil)
-		if node.Author != nil {
-			comment.Author = node.Author.Login
-		} else {
-			comment.Author = ""
+// GetLinkedItems fetches the issues and pull requests connected to an issue
+// or pull request: those it closes or is closed by, then those mentioning it.
+func (c *Client) GetLinkedItems(ctx context.Context, owner, repo string, number int) ([]domain.LinkedItem, error) {
+	req := graphql.NewRequest(`
+		query($owner: String!, $repo: String!, $number: Int!) {
+			repository(owner: $owner, name: $repo) {
+				issueOrPullRequest(number: $number) {
+					... on Issue {
+						closedByPullRequestsReferences(first: 10, includeClosedPrs: true) {
+							nodes { ...linkedPullRequest }
+						}
+						timelineItems(first: 25, itemTypes: [CROSS_REFERENCED_EVENT]) {
+							nodes { ...crossReference }
+						}
+					}
+					... on PullRequest {
+						closingIssuesReferences(first: 10) {
+							nodes { ...linkedIssue }
+						}
+						timelineItems(first: 25, itemTypes: [CROSS_REFERENCED_EVENT]) {
+							nodes { ...crossReference }
+						}
+					}
+				}
+			}
 		}
 
-		comments = append(comments, comment)
+		fragment linkedIssue on Issue {
+			__typename id number title state url
+			repository { nameWithOwner }
+		}
+
+		fragment linkedPullRequest on PullRequest {
+			__typename id number title state url
+			repository { nameWithOwner }
+		}
+
+		fragment crossReference on CrossReferencedEvent {
+			source {
+				...linkedIssue
+				...linkedPullRequest
+			}
+		}
+	`)
+	req.Var("owner", owner)
+	req.Var("repo", repo)
+	req.Var("number", number)
+
+	type linkedNode struct {
+		Typename   string `json:"__typename"`
+		ID         string `json:"id"`
+		Number     int    `json:"number"`
+		Title      string `json:"title"`
+		State      string `json:"state"`
+		URL        string `json:"url"`
+		Repository struct {
+			NameWithOwner string `json:"nameWithOwner"`
+		} `json:"repository"`
+	}
+	type connection struct {
+		Nodes []*linkedNode `json:"nodes"`
+	}
+	var resp struct {
+		Repository struct {
+			IssueOrPullRequest struct {
+				ClosedBy      connection `json:"closedByPullRequestsReferences"`
+				Closes        connection `json:"closingIssuesReferences"`
+				TimelineItems struct {
+					Nodes []struct {
+						Source *linkedNode `json:"source"`
+					} `json:"nodes"`
+				} `json:"timelineItems"`
+			} `json:"issueOrPullRequest"`
+		} `json:"repository"`
+	}
+
+	if err := c.makeRequest(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get linked items: %w", err)
+	}
+
+	var items []domain.LinkedItem
+	seen := make(map[string]bool)
+	add := func(node *linkedNode, relation string) {
+		if node == nil || node.ID == "" || seen[node.ID] {
+			return
+		}
+		seen[node.ID] = true
+		items = append(items, domain.LinkedItem{
+			ContentID:   node.ID,
+			ContentType: node.Typename,
+			Repo:        node.Repository.NameWithOwner,
+			Number:      node.Number,
+			Title:       node.Title,
+			State:       node.State,
+			URL:         node.URL,
+			Relation:    relation,
+		})
+	}
+
+	item := resp.Repository.IssueOrPullRequest
+	for _, node := range item.ClosedBy.Nodes {
+		add(node, "closed by")
+	}
+	for _, node := range item.Closes.Nodes {
+		add(node, "closes")
 	}
+	for _, event := range item.TimelineItems.Nodes {
+		add(event.Source, "mentioned in")
+	}
+	return items, nil
+}
+
+// prMetaBatchSize is the maximum number of node IDs per nodes() lookup.
+const prMetaBatchSize = 100
 
-	return comments, nil
+// GetPRMeta fetches review metadata for pull requests in bulk.
+// contentIDs are PR node IDs; IDs that do not resolve to a pull request are skipped.
+// Returns a map of content ID to metadata.
+func (c *Client) GetPRMeta(ctx context.Context, contentIDs []string) (map[string]domain.PRMeta, error) {
+	result := make(map[string]domain.PRMeta, len(contentIDs))
+
+	for start := 0; start < len(contentIDs); start += prMetaBatchSize {
+		end := min(start+prMetaBatchSize, len(contentIDs))
+
+		req := graphql.NewRequest(`
+			query($ids: [ID!]!) {
+				nodes(ids: $ids) {
+					... on PullRequest {
+						id
+						createdAt
+						closedAt
+						state
+						reviewDecision
+						reviews {
+							totalCount
+						}
+					}
+				}
+			}
+		`)
+		req.Var("ids", contentIDs[start:end])
+
+		var resp struct {
+			Nodes []*struct {
+				ID             string `json:"id"`
+				CreatedAt      string `json:"createdAt"`
+				ClosedAt       string `json:"closedAt"`
+				State          string `json:"state"`
+				ReviewDecision string `json:"reviewDecision"`
+				Reviews        struct {
+					TotalCount int `json:"totalCount"`
+				} `json:"reviews"`
+			} `json:"nodes"`
+		}
+
+		if err := c.makeRequest(ctx, req, &resp); err != nil {
+			return nil, fmt.Errorf("failed to get pull request metadata: %w", err)
+		}
+
+		for _, node := range resp.Nodes {
+			// Deleted or inaccessible nodes come back as null
+			if node == nil || node.ID == "" {
+				continue
+			}
+			result[node.ID] = domain.PRMeta{
+				ContentID:      node.ID,
+				CreatedAt:      node.CreatedAt,
+				ClosedAt:       node.ClosedAt,
+				State:          node.State,
+				ReviewCount:    node.Reviews.TotalCount,
+				ReviewDecision: node.ReviewDecision,
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// headCommits is a pull request's last commit with its combined check state
+type headCommits struct {
+	Nodes []struct {
+		Commit struct {
+			StatusCheckRollup *struct {
+				State string `json:"state"`
+			} `json:"statusCheckRollup"`
+		} `json:"commit"`
+	} `json:"nodes"`
+}
+
+// checksState returns the head commit's combined check state, "" without checks
+func (h *headCommits) checksState() string {
+	if h == nil || len(h.Nodes) == 0 || h.Nodes[0].Commit.StatusCheckRollup == nil {
+		return ""
+	}
+	return h.Nodes[0].Commit.StatusCheckRollup.State
+}
+
+// GetChecks fetches the CI check runs and commit statuses on a pull request's
+// head commit. A check run still in progress is reported as PENDING.
+func (c *Client) GetChecks(ctx context.Context, contentID string) ([]domain.Check, error) {
+	req := graphql.NewRequest(`
+		query($id: ID!) {
+			node(id: $id) {
+				... on PullRequest {
+					commits(last: 1) {
+						nodes {
+							commit {
+								statusCheckRollup {
+									contexts(first: 100) {
+										nodes {
+											__typename
+											... on CheckRun {
+												name
+												status
+												conclusion
+												detailsUrl
+											}
+											... on StatusContext {
+												context
+												state
+												targetUrl
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	`)
+	req.Var("id", contentID)
+
+	var resp struct {
+		Node struct {
+			Commits struct {
+				Nodes []struct {
+					Commit struct {
+						StatusCheckRollup *struct {
+							Contexts struct {
+								Nodes []struct {
+									Typename   string `json:"__typename"`
+									Name       string `json:"name"`
+									Status     string `json:"status"`
+									Conclusion string `json:"conclusion"`
+									DetailsURL string `json:"detailsUrl"`
+									Context    string `json:"context"`
+									State      string `json:"state"`
+									TargetURL  string `json:"targetUrl"`
+								} `json:"nodes"`
+							} `json:"contexts"`
+						} `json:"statusCheckRollup"`
+					} `json:"commit"`
+				} `json:"nodes"`
+			} `json:"commits"`
+		} `json:"node"`
+	}
+
+	if err := c.makeRequest(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get checks: %w", err)
+	}
+
+	var checks []domain.Check
+	for _, commit := range resp.Node.Commits.Nodes {
+		rollup := commit.Commit.StatusCheckRollup
+		if rollup == nil {
+			continue
+		}
+		for _, node := range rollup.Contexts.Nodes {
+			switch node.Typename {
+			case "CheckRun":
+				state := node.Conclusion
+				if node.Status != "COMPLETED" || state == "" {
+					state = "PENDING"
+				}
+				checks = append(checks, domain.Check{Name: node.Name, State: state, URL: node.DetailsURL})
+			case "StatusContext":
+				checks = append(checks, domain.Check{Name: node.Context, State: node.State, URL: node.TargetURL})
+			}
+		}
+	}
+	return checks, nil
+}
+
+// GetProjectInfo fetches a project's description, readme, and built-in workflows.
+func (c *Client) GetProjectInfo(ctx context.Context, projectID string) (domain.ProjectInfo, error) {
+	req := graphql.NewRequest(`
+		query($projectId: ID!) {
+			node(id: $projectId) {
+				... on ProjectV2 {
+					shortDescription
+					readme
+					url
+					public
+					workflows(first: 50) {
+						nodes {
+							name
+							enabled
+						}
+					}
+				}
+			}
+		}
+	`)
+	req.Var("projectId", projectID)
+
+	var resp struct {
+		Node struct {
+			ShortDescription string `json:"shortDescription"`
+			Readme           string `json:"readme"`
+			URL              string `json:"url"`
+			Public           bool   `json:"public"`
+			Workflows        struct {
+				Nodes []struct {
+					Name    string `json:"name"`
+					Enabled bool   `json:"enabled"`
+				} `json:"nodes"`
+			} `json:"workflows"`
+		} `json:"node"`
+	}
+
+	if err := c.makeRequest(ctx, req, &resp); err != nil {
+		return domain.ProjectInfo{}, fmt.Errorf("failed to get project info: %w", err)
+	}
+
+	info := domain.ProjectInfo{
+		ShortDescription: resp.Node.ShortDescription,
+		Readme:           resp.Node.Readme,
+		URL:              resp.Node.URL,
+		Public:           resp.Node.Public,
+	}
+	for _, w := range resp.Node.Workflows.Nodes {
+		info.Workflows = append(info.Workflows, domain.Workflow{Name: w.Name, Enabled: w.Enabled})
+	}
+	return info, nil
+}
+
+// GetItemFieldValues fetches an item's values for its text, number, date,
+// single-select, and iteration fields. Fields without a value are omitted.
+func (c *Client) GetItemFieldValues(ctx context.Context, itemID string) ([]domain.FieldValue, error) {
+	req := graphql.NewRequest(`
+		query($itemId: ID!) {
+			node(id: $itemId) {
+				... on ProjectV2Item {
+					fieldValues(first: 50) {
+						nodes {
+							__typename
+							... on ProjectV2ItemFieldTextValue {
+								text
+								field { ... on ProjectV2FieldCommon { id name dataType } }
+							}
+							... on ProjectV2ItemFieldNumberValue {
+								number
+								field { ... on ProjectV2FieldCommon { id name dataType } }
+							}
+							... on ProjectV2ItemFieldDateValue {
+								date
+								field { ... on ProjectV2FieldCommon { id name dataType } }
+							}
+							... on ProjectV2ItemFieldSingleSelectValue {
+								optionId
+								name
+								field { ... on ProjectV2FieldCommon { id name dataType } }
+							}
+							... on ProjectV2ItemFieldIterationValue {
+								iterationId
+								title
+								field { ... on ProjectV2FieldCommon { id name dataType } }
+							}
+						}
+					}
+				}
+			}
+		}
+	`)
+	req.Var("itemId", itemID)
+
+	var resp struct {
+		Node struct {
+			FieldValues struct {
+				Nodes []struct {
+					Typename    string   `json:"__typename"`
+					Text        string   `json:"text"`
+					Number      *float64 `json:"number"`
+					Date        string   `json:"date"`
+					OptionID    string   `json:"optionId"`
+					Name        string   `json:"name"`
+					IterationID string   `json:"iterationId"`
+					Title       string   `json:"title"`
+					Field       *struct {
+						ID       string `json:"id"`
+						Name     string `json:"name"`
+						DataType string `json:"dataType"`
+					} `json:"field"`
+				} `json:"nodes"`
+			} `json:"fieldValues"`
+		} `json:"node"`
+	}
+
+	if err := c.makeRequest(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get item field values: %w", err)
+	}
+
+	var values []domain.FieldValue
+	for _, node := range resp.Node.FieldValues.Nodes {
+		if node.Field == nil || node.Field.ID == "" {
+			continue
+		}
+		v := domain.FieldValue{FieldID: node.Field.ID, Field: node.Field.Name, Type: node.Field.DataType}
+		switch node.Typename {
+		case "ProjectV2ItemFieldTextValue":
+			v.Text = node.Text
+		case "ProjectV2ItemFieldNumberValue":
+			if node.Number != nil {
+				v.Text = strconv.FormatFloat(*node.Number, 'f', -1, 64)
+			}
+		case "ProjectV2ItemFieldDateValue":
+			v.Text = node.Date
+		case "ProjectV2ItemFieldSingleSelectValue":
+			v.Text, v.ID = node.Name, node.OptionID
+		case "ProjectV2ItemFieldIterationValue":
+			v.Text, v.ID = node.Title, node.IterationID
+		default:
+			continue
+		}
+		values = append(values, v)
+	}
+	return values, nil
 }
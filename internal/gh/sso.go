@@ -0,0 +1,33 @@
+package gh
+
+import (
+	"strings"
+
+	"github.com/h0rv/ghp/internal/auth"
+)
+
+// SSORequiredError is returned when an organization enforces SAML SSO and the
+// token has not been authorized for it.
+type SSORequiredError struct {
+	URL string // Authorization URL sent by GitHub, empty if none was sent
+	Err error
+}
+
+func (e *SSORequiredError) Error() string { return e.Err.Error() }
+func (e *SSORequiredError) Unwrap() error { return e.Err }
+
+// AuthorizationURL returns where to authorize the token, falling back to the
+// organization's SSO page when GitHub did not send a URL.
+func (e *SSORequiredError) AuthorizationURL(org string) string {
+	if e.URL != "" || org == "" {
+		return e.URL
+	}
+	return "https://" + auth.Host() + "/orgs/" + org + "/sso"
+}
+
+// isSAMLError reports whether err comes from an organization that enforces
+// SAML SSO the token has not been authorized for. GitHub still returns the
+// rest of the response, with the protected nodes set to null.
+func isSAMLError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "SAML")
+}
@@ -0,0 +1,78 @@
+package gh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/machinebox/graphql"
+)
+
+// GetTeamMembers returns the logins of every member of an organization team,
+// including members of its child teams.
+func (c *Client) GetTeamMembers(ctx context.Context, org, slug string) ([]string, error) {
+	var logins []string
+	cursor := ""
+
+	for {
+		req := graphql.NewRequest(`
+			query($org: String!, $slug: String!, $cursor: String) {
+				organization(login: $org) {
+					team(slug: $slug) {
+						members(first: 100, after: $cursor, membership: ALL) {
+							nodes {
+								login
+							}
+							pageInfo {
+								hasNextPage
+								endCursor
+							}
+						}
+					}
+				}
+			}
+		`)
+		req.Var("org", org)
+		req.Var("slug", slug)
+		if cursor != "" {
+			req.Var("cursor", cursor)
+		}
+
+		var resp struct {
+			Organization *struct {
+				Team *struct {
+					Members struct {
+						Nodes []struct {
+							Login string `json:"login"`
+						} `json:"nodes"`
+						PageInfo struct {
+							HasNextPage bool   `json:"hasNextPage"`
+							EndCursor   string `json:"endCursor"`
+						} `json:"pageInfo"`
+					} `json:"members"`
+				} `json:"team"`
+			} `json:"organization"`
+		}
+
+		if err := c.makeRequest(ctx, req, &resp); err != nil {
+			return nil, fmt.Errorf("failed to get team members: %w", err)
+		}
+		if resp.Organization == nil {
+			return nil, notFoundf("organization '%s' not found", org)
+		}
+		if resp.Organization.Team == nil {
+			return nil, notFoundf("team '%s' not found in %s", slug, org)
+		}
+
+		members := resp.Organization.Team.Members
+		for _, node := range members.Nodes {
+			logins = append(logins, node.Login)
+		}
+
+		if !members.PageInfo.HasNextPage || members.PageInfo.EndCursor == "" {
+			break
+		}
+		cursor = members.PageInfo.EndCursor
+	}
+
+	return logins, nil
+}
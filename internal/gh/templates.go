@@ -0,0 +1,47 @@
+package gh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/machinebox/graphql"
+)
+
+// IssueTemplate is one of a repository's markdown issue templates.
+type IssueTemplate struct {
+	Name  string `json:"name"`
+	Title string `json:"title"` // Default issue title, often a prefix such as "[bug] "
+	Body  string `json:"body"`
+}
+
+// GetIssueTemplates returns the markdown issue templates in a repository's
+// .github/ISSUE_TEMPLATE directory. Issue forms (YAML) aren't included.
+func (c *Client) GetIssueTemplates(ctx context.Context, owner, repo string) ([]IssueTemplate, error) {
+	req := graphql.NewRequest(`
+		query($owner: String!, $repo: String!) {
+			repository(owner: $owner, name: $repo) {
+				issueTemplates {
+					name
+					title
+					body
+				}
+			}
+		}
+	`)
+	req.Var("owner", owner)
+	req.Var("repo", repo)
+
+	var resp struct {
+		Repository *struct {
+			IssueTemplates []IssueTemplate `json:"issueTemplates"`
+		} `json:"repository"`
+	}
+
+	if err := c.makeRequest(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get issue templates: %w", err)
+	}
+	if resp.Repository == nil {
+		return nil, notFoundf("repository %s/%s not found", owner, repo)
+	}
+	return resp.Repository.IssueTemplates, nil
+}
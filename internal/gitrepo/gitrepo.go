@@ -0,0 +1,50 @@
+// Package gitrepo finds the GitHub repository a working directory is a
+// clone of, from its origin remote.
+package gitrepo
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// ErrNoOrigin indicates the directory is not in a git repository with an
+// origin remote.
+var ErrNoOrigin = errors.New("no git origin remote")
+
+// Origin returns the "owner/name" of the repository dir's origin remote
+// points at.
+func Origin(dir string) (string, error) {
+	out, err := exec.Command("git", "-C", dir, "remote", "get-url", "origin").Output()
+	if err != nil {
+		return "", ErrNoOrigin
+	}
+	repo, ok := ParseRemote(strings.TrimSpace(string(out)))
+	if !ok {
+		return "", fmt.Errorf("origin remote %q is not a GitHub repository", strings.TrimSpace(string(out)))
+	}
+	return repo, nil
+}
+
+// ParseRemote returns the "owner/name" of a remote URL in HTTPS, SSH, or
+// scp-like (git@host:owner/name.git) form, for any host.
+func ParseRemote(url string) (string, bool) {
+	var path string
+	switch {
+	case strings.Contains(url, "://"):
+		_, rest, _ := strings.Cut(url, "://")
+		_, path, _ = strings.Cut(rest, "/")
+	case strings.Contains(url, ":"):
+		_, path, _ = strings.Cut(url, ":")
+	default:
+		return "", false
+	}
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	owner, name, ok := strings.Cut(path, "/")
+	if !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+		return "", false
+	}
+	return owner + "/" + name, true
+}
@@ -0,0 +1,26 @@
+package gitrepo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRemote(t *testing.T) {
+	for url, want := range map[string]string{
+		"https://github.com/h0rv/ghp.git":          "h0rv/ghp",
+		"https://github.com/h0rv/ghp":              "h0rv/ghp",
+		"git@github.com:h0rv/ghp.git":              "h0rv/ghp",
+		"ssh://git@github.example.com/org/api.git": "org/api",
+		"https://user@github.com/org/api/":         "org/api",
+	} {
+		got, ok := ParseRemote(url)
+		assert.True(t, ok, url)
+		assert.Equal(t, want, got, url)
+	}
+
+	for _, url := range []string{"", "/local/path/repo", "https://github.com/org", "https://gitlab.com/group/sub/repo.git"} {
+		_, ok := ParseRemote(url)
+		assert.False(t, ok, url)
+	}
+}
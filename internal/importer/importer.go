@@ -0,0 +1,162 @@
+// Package importer parses bulk item lists (CSV files or markdown checklists)
+// into entries that can be created as project items.
+package importer
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Supported input formats.
+const (
+	FormatCSV      = "csv"
+	FormatMarkdown = "markdown"
+)
+
+// Entry is a single item to import.
+type Entry struct {
+	Title  string // Item title (required)
+	Body   string // Item body (optional)
+	Status string // Status option name overriding the default (optional)
+}
+
+// checklistItem matches markdown task list lines like "- [ ] Title" or "* [x] Title".
+var checklistItem = regexp.MustCompile(`^\s*[-*+]\s+\[[ xX]\]\s+(.+)$`)
+
+// DetectFormat guesses the input format from a file name's extension.
+// Returns an error if the extension is not recognized.
+func DetectFormat(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".md", ".markdown":
+		return FormatMarkdown, nil
+	}
+	return "", fmt.Errorf("cannot detect format of %q; use --format csv or --format markdown", path)
+}
+
+// Parse reads entries from r in the given format.
+func Parse(r io.Reader, format string) ([]Entry, error) {
+	switch format {
+	case FormatCSV:
+		return parseCSV(r)
+	case FormatMarkdown:
+		return parseMarkdown(r)
+	}
+	return nil, fmt.Errorf("unknown format %q", format)
+}
+
+// parseCSV reads a CSV file with a header row.
+// A "title" column is required; "body" and "status" columns are optional.
+// Rows with an empty title are skipped.
+func parseCSV(r io.Reader) ([]Entry, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	titleCol, bodyCol, statusCol := -1, -1, -1
+	for i, name := range header {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "title":
+			titleCol = i
+		case "body":
+			bodyCol = i
+		case "status":
+			statusCol = i
+		}
+	}
+	if titleCol < 0 {
+		return nil, errors.New("CSV header must include a title column")
+	}
+
+	column := func(record []string, idx int) string {
+		if idx < 0 || idx >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[idx])
+	}
+
+	var entries []Entry
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+
+		title := column(record, titleCol)
+		if title == "" {
+			continue
+		}
+		entries = append(entries, Entry{
+			Title:  title,
+			Body:   column(record, bodyCol),
+			Status: column(record, statusCol),
+		})
+	}
+
+	return entries, nil
+}
+
+// parseMarkdown reads task list items from a markdown document.
+// Lines indented beneath a task become its body; all other lines are ignored.
+func parseMarkdown(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	var body []string
+	collecting := false
+
+	flush := func() {
+		if collecting {
+			entries[len(entries)-1].Body = strings.TrimSpace(strings.Join(body, "\n"))
+		}
+		body = nil
+		collecting = false
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch m := checklistItem.FindStringSubmatch(line); {
+		case m != nil && !isIndented(line):
+			flush()
+			entries = append(entries, Entry{Title: strings.TrimSpace(m[1])})
+			collecting = true
+		case collecting && strings.TrimSpace(line) == "":
+			// Blank lines separate paragraphs within a body
+			body = append(body, "")
+		case collecting && isIndented(line):
+			body = append(body, strings.TrimSpace(line))
+		default:
+			// Any other unindented line ends the current item's body
+			flush()
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read markdown: %w", err)
+	}
+	flush()
+
+	return entries, nil
+}
+
+// isIndented reports whether a line starts with whitespace.
+func isIndented(line string) bool {
+	return strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")
+}
@@ -0,0 +1,76 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectFormat(t *testing.T) {
+	format, err := DetectFormat("items.CSV")
+	require.NoError(t, err)
+	assert.Equal(t, FormatCSV, format)
+
+	format, err = DetectFormat("todo.md")
+	require.NoError(t, err)
+	assert.Equal(t, FormatMarkdown, format)
+
+	_, err = DetectFormat("items.txt")
+	assert.Error(t, err)
+}
+
+func TestParse_CSV(t *testing.T) {
+	input := "Title,Body,Status\n" +
+		"Fix login,\"Users see a 500\nafter reset\",In Progress\n" +
+		",skipped row,\n" +
+		"Write docs,,\n"
+
+	entries, err := Parse(strings.NewReader(input), FormatCSV)
+
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, Entry{Title: "Fix login", Body: "Users see a 500\nafter reset", Status: "In Progress"}, entries[0])
+	assert.Equal(t, Entry{Title: "Write docs"}, entries[1])
+}
+
+func TestParse_CSVTitleOnly(t *testing.T) {
+	entries, err := Parse(strings.NewReader("title\nOne\nTwo\n"), FormatCSV)
+
+	require.NoError(t, err)
+	assert.Equal(t, []Entry{{Title: "One"}, {Title: "Two"}}, entries)
+}
+
+func TestParse_CSVMissingTitle(t *testing.T) {
+	_, err := Parse(strings.NewReader("name,body\nx,y\n"), FormatCSV)
+	assert.Error(t, err)
+}
+
+func TestParse_Markdown(t *testing.T) {
+	input := `# Launch checklist
+
+- [ ] Draft announcement
+  Needs review from marketing.
+
+  Second paragraph.
+* [x] Book venue
+Some unrelated note
+    not part of any item
+- [ ] Send invites
+`
+
+	entries, err := Parse(strings.NewReader(input), FormatMarkdown)
+
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, "Draft announcement", entries[0].Title)
+	assert.Equal(t, "Needs review from marketing.\n\nSecond paragraph.", entries[0].Body)
+	assert.Equal(t, Entry{Title: "Book venue"}, entries[1])
+	assert.Equal(t, Entry{Title: "Send invites"}, entries[2])
+}
+
+func TestParse_UnknownFormat(t *testing.T) {
+	_, err := Parse(strings.NewReader(""), "xml")
+	assert.Error(t, err)
+}
@@ -0,0 +1,144 @@
+// Package session records board state transitions to a file and reads them
+// back for replay. Recordings capture what the board showed, not the keys
+// that were pressed, so they can be attached to bug reports or used for demos.
+package session
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/h0rv/ghp/internal/domain"
+)
+
+// Frame is the board state after one transition.
+// Cards is nil when unchanged since the previous frame; Load fills it back in.
+type Frame struct {
+	At             time.Time
+	Event          string // Message that caused the transition
+	Viewer         string // Viewer login, for the "assigned to me" filter
+	Project        domain.Project
+	GroupField     domain.FieldDef
+	Cards          []domain.Card
+	SelectedColumn int
+	SelectedItemID string
+	Filter         string
+	MyOnly         bool
+	Mode           string // "move", "filter", "triage", or "help"
+	Toast          string
+}
+
+// Recorder appends frames to a recording file as JSON lines.
+// A Recorder is safe for concurrent use.
+type Recorder struct {
+	mu    sync.Mutex
+	f     *os.File
+	w     *bufio.Writer
+	last  *Frame
+	cards []domain.Card // Cards as of the last written frame
+}
+
+// NewRecorder creates (or truncates) the recording file at path.
+func NewRecorder(path string) (*Recorder, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create recording: %w", err)
+	}
+	return &Recorder{f: f, w: bufio.NewWriter(f)}, nil
+}
+
+// Record writes a frame if the board state differs from the previous one.
+// Transitions that leave the visible state unchanged are dropped.
+func (r *Recorder) Record(frame Frame) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cardsChanged := !reflect.DeepEqual(frame.Cards, r.cards)
+	if r.last != nil && !cardsChanged && sameView(*r.last, frame) {
+		return nil
+	}
+
+	out := frame
+	if !cardsChanged {
+		out.Cards = nil
+	} else {
+		r.cards = frame.Cards
+	}
+
+	data, err := json.Marshal(out)
+	if err != nil {
+		return fmt.Errorf("failed to encode frame: %w", err)
+	}
+	r.w.Write(data)
+	r.w.WriteByte('\n')
+	r.last = &frame
+
+	// Flush per frame so a crash still leaves a usable recording
+	return r.w.Flush()
+}
+
+// Close flushes and closes the recording file.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.w.Flush(); err != nil {
+		r.f.Close()
+		return err
+	}
+	return r.f.Close()
+}
+
+// sameView reports whether two frames show the same board, ignoring cards and timing.
+func sameView(a, b Frame) bool {
+	return a.Project == b.Project &&
+		a.Viewer == b.Viewer &&
+		a.GroupField.ID == b.GroupField.ID &&
+		a.SelectedColumn == b.SelectedColumn &&
+		a.SelectedItemID == b.SelectedItemID &&
+		a.Filter == b.Filter &&
+		a.MyOnly == b.MyOnly &&
+		a.Mode == b.Mode &&
+		a.Toast == b.Toast
+}
+
+// Load reads a recording, filling in Cards on frames that omitted them.
+// Returns an error if the recording contains no frames.
+func Load(r io.Reader) ([]Frame, error) {
+	var frames []Frame
+	var cards []domain.Card
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var frame Frame
+		if err := json.Unmarshal(scanner.Bytes(), &frame); err != nil {
+			return nil, fmt.Errorf("line %d: invalid frame: %w", line, err)
+		}
+		if frame.Cards == nil {
+			frame.Cards = cards
+		} else {
+			cards = frame.Cards
+		}
+		frames = append(frames, frame)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read recording: %w", err)
+	}
+
+	if len(frames) == 0 {
+		return nil, fmt.Errorf("recording has no frames")
+	}
+	return frames, nil
+}
@@ -0,0 +1,64 @@
+package session
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestFrame(selected int, cards ...domain.Card) Frame {
+	return Frame{
+		At:             time.Now(),
+		Event:          "input",
+		Project:        domain.Project{ID: "proj_1", Number: 3, Title: "Roadmap", Owner: "TestOrg"},
+		GroupField:     domain.FieldDef{ID: "field_status", Name: "Status"},
+		Cards:          cards,
+		SelectedColumn: selected,
+	}
+}
+
+func TestRecorder_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	rec, err := NewRecorder(path)
+	require.NoError(t, err)
+
+	todo := domain.Card{ItemID: "item_1", Title: "Fix bug", GroupOptionID: "opt_todo"}
+	done := todo
+	done.GroupOptionID = "opt_done"
+
+	require.NoError(t, rec.Record(createTestFrame(0, todo)))
+	require.NoError(t, rec.Record(createTestFrame(0, todo))) // No change, dropped
+	require.NoError(t, rec.Record(createTestFrame(1, todo))) // Selection moved
+	require.NoError(t, rec.Record(createTestFrame(1, done))) // Card moved
+	require.NoError(t, rec.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	frames, err := Load(f)
+	require.NoError(t, err)
+	require.Len(t, frames, 3)
+
+	assert.Equal(t, 0, frames[0].SelectedColumn)
+	assert.Equal(t, 1, frames[1].SelectedColumn)
+	assert.Equal(t, []domain.Card{todo}, frames[1].Cards, "Unchanged cards are carried forward")
+	assert.Equal(t, []domain.Card{done}, frames[2].Cards)
+}
+
+func TestLoad_Empty(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "empty.jsonl")
+	require.NoError(t, os.WriteFile(path, nil, 0o644))
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	_, err = Load(f)
+	assert.Error(t, err)
+}
@@ -0,0 +1,182 @@
+// Package snapshot turns a rendered board into a plain-text or PNG snapshot
+// for pasting into docs and chat.
+package snapshot
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/rivo/uniseg"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/inconsolata"
+	"golang.org/x/image/math/fixed"
+)
+
+// face is the bitmap font, which covers Latin-1 and most other Latin letters
+var face = inconsolata.Regular8x16
+
+// Cell size of the bitmap font, in pixels
+const (
+	cellWidth  = 8
+	cellHeight = 16
+	margin     = 8
+)
+
+var (
+	background = color.RGBA{0x1e, 0x1e, 0x1e, 0xff}
+	foreground = color.RGBA{0xdd, 0xdd, 0xdd, 0xff}
+)
+
+// Text strips terminal styling from a rendered view and trims trailing
+// spaces, leaving plain text.
+func Text(view string) string {
+	lines := strings.Split(ansi.Strip(view), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n") + "\n"
+}
+
+// WritePNG draws plain text (see Text) as light-on-dark monospace PNG.
+// Characters take as many cells as in a terminal, so wide ones (CJK, emoji)
+// keep the columns after them aligned; those the font lacks are drawn as
+// empty boxes of their width.
+func WritePNG(w io.Writer, text string) error {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	cols := 0
+	for _, line := range lines {
+		cols = max(cols, ansi.StringWidth(line))
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, cols*cellWidth+2*margin, len(lines)*cellHeight+2*margin))
+	draw.Draw(img, img.Bounds(), image.NewUniform(background), image.Point{}, draw.Src)
+
+	d := &font.Drawer{Dst: img, Src: image.NewUniform(foreground), Face: face}
+	for row, line := range lines {
+		col, state := 0, -1
+		for line != "" {
+			var cluster string
+			var width int
+			cluster, line, width, state = uniseg.FirstGraphemeClusterInString(line, state)
+			if width == 0 {
+				continue
+			}
+			x, y := margin+col*cellWidth, margin+row*cellHeight
+			col += width
+
+			r := []rune(cluster)[0]
+			if drawShape(img, r, x, y) {
+				continue
+			}
+			if _, ok := face.GlyphAdvance(r); !ok {
+				if sub, ok := asciiFor(r); ok {
+					r = sub
+				} else {
+					drawBox(img, x, y, width)
+					continue
+				}
+			}
+			d.Dot = fixed.P(x, y+face.Ascent)
+			d.DrawString(string(r))
+		}
+	}
+
+	if err := png.Encode(w, img); err != nil {
+		return fmt.Errorf("failed to encode PNG: %w", err)
+	}
+	return nil
+}
+
+// boxSegments lists which edges of its cell each box-drawing rune connects
+var boxSegments = map[rune]struct{ up, down, left, right bool }{
+	'─': {left: true, right: true},
+	'│': {up: true, down: true},
+	'┌': {down: true, right: true},
+	'╭': {down: true, right: true},
+	'┐': {down: true, left: true},
+	'╮': {down: true, left: true},
+	'└': {up: true, right: true},
+	'╰': {up: true, right: true},
+	'┘': {up: true, left: true},
+	'╯': {up: true, left: true},
+	'├': {up: true, down: true, right: true},
+	'┤': {up: true, down: true, left: true},
+	'┬': {down: true, left: true, right: true},
+	'┴': {up: true, left: true, right: true},
+	'┼': {up: true, down: true, left: true, right: true},
+}
+
+// drawShape draws box-drawing and block runes, which the bitmap font lacks,
+// reporting whether r was one of them
+func drawShape(img *image.RGBA, r rune, x, y int) bool {
+	fill := func(x0, y0, x1, y1 int) {
+		draw.Draw(img, image.Rect(x+x0, y+y0, x+x1, y+y1), image.NewUniform(foreground), image.Point{}, draw.Src)
+	}
+	cx, cy := cellWidth/2, cellHeight/2
+
+	if seg, ok := boxSegments[r]; ok {
+		if seg.up {
+			fill(cx, 0, cx+1, cy+1)
+		}
+		if seg.down {
+			fill(cx, cy, cx+1, cellHeight)
+		}
+		if seg.left {
+			fill(0, cy, cx+1, cy+1)
+		}
+		if seg.right {
+			fill(cx, cy, cellWidth, cy+1)
+		}
+		return true
+	}
+
+	switch r {
+	case '█':
+		fill(0, 0, cellWidth, cellHeight)
+	case '▀':
+		fill(0, 0, cellWidth, cy)
+	case '▄':
+		fill(0, cy, cellWidth, cellHeight)
+	default:
+		return false
+	}
+	return true
+}
+
+// drawBox outlines a cell width cells wide, standing in for a character the
+// font can't draw
+func drawBox(img *image.RGBA, x, y, width int) {
+	box := image.Rect(x+1, y+2, x+width*cellWidth-1, y+cellHeight-2)
+	for _, edge := range []image.Rectangle{
+		{box.Min, image.Pt(box.Max.X, box.Min.Y+1)},
+		{image.Pt(box.Min.X, box.Max.Y-1), box.Max},
+		{box.Min, image.Pt(box.Min.X+1, box.Max.Y)},
+		{image.Pt(box.Max.X-1, box.Min.Y), box.Max},
+	} {
+		draw.Draw(img, edge, image.NewUniform(foreground), image.Point{}, draw.Src)
+	}
+}
+
+// asciiFor returns a stand-in the font has for the symbols ghp draws that
+// it lacks
+func asciiFor(r rune) (rune, bool) {
+	switch r {
+	case '↳', '→', '▸':
+		return '>', true
+	case '←':
+		return '<', true
+	case '●':
+		return '•', true
+	case '✓':
+		return '+', true
+	case '✗':
+		return 'x', true
+	}
+	return 0, false
+}
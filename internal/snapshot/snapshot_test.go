@@ -0,0 +1,54 @@
+package snapshot
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestText(t *testing.T) {
+	view := "\x1b[1mTodo\x1b[0m   \n╭──╮\n\n"
+	assert.Equal(t, "Todo\n╭──╮\n", Text(view))
+}
+
+func TestWritePNG(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WritePNG(&buf, "╭────╮\n│ hi │…\n╰────╯\n"))
+
+	img, err := png.Decode(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, 7*cellWidth+2*margin, img.Bounds().Dx(), "Width follows the longest line")
+	assert.Equal(t, 3*cellHeight+2*margin, img.Bounds().Dy())
+
+	// The top border's horizontal line runs through the middle of its cells
+	r, g, b, _ := img.At(margin+cellWidth+1, margin+cellHeight/2).RGBA()
+	assert.Equal(t, uint32(0xdddd), r)
+	assert.Equal(t, r, g)
+	assert.Equal(t, r, b)
+}
+
+func TestWritePNG_WideCharacters(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WritePNG(&buf, "│漢字│\n│abcd│\n│résumé│\n"))
+
+	img, err := png.Decode(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, 8*cellWidth+2*margin, img.Bounds().Dx(), "Width counts terminal cells, not runes")
+
+	lit := func(col, row int) bool {
+		r, _, _, _ := img.At(margin+col*cellWidth+cellWidth/2, margin+row*cellHeight+cellHeight/2).RGBA()
+		return r == 0xdddd
+	}
+	assert.True(t, lit(5, 0), "The border after two wide characters lines up...")
+	assert.True(t, lit(5, 1), "...with the one after four narrow ones")
+	assert.False(t, lit(4, 0))
+
+	// Accented letters are drawn, not replaced
+	var accented, question bytes.Buffer
+	require.NoError(t, WritePNG(&accented, "é"))
+	require.NoError(t, WritePNG(&question, "?"))
+	assert.NotEqual(t, question.Bytes(), accented.Bytes())
+}
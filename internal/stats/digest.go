@@ -0,0 +1,157 @@
+package stats
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/h0rv/ghp/internal/domain"
+)
+
+// Digest summarizes a period of project activity.
+type Digest struct {
+	Since, Until time.Time
+	Completed    []*domain.Card // Moved into a Done column during the period
+	Created      []*domain.Card // Issues, PRs, and drafts created during the period
+	Moves        []Move         // Net column changes during the period
+	HasHistory   bool           // Whether any snapshots covered the period
+}
+
+// Move is an item's net column change over a period: where it was before the
+// period's first change and where it ended up. Columns are option IDs, "" for
+// no status.
+type Move struct {
+	Card     *domain.Card
+	From, To string
+}
+
+// BuildDigest collects the items completed, created, and moved between since
+// and until. Moves come from history snapshots for groupField, so they are
+// only as fine-grained as the snapshots; creation times need item details.
+func BuildDigest(cards []domain.Card, history []Snapshot, groupField domain.FieldDef, since, until time.Time) Digest {
+	d := Digest{Since: since, Until: until}
+	byID := make(map[string]*domain.Card, len(cards))
+	for i := range cards {
+		byID[cards[i].ItemID] = &cards[i]
+	}
+
+	doneOptions := make(map[string]bool)
+	for _, opt := range groupField.Options {
+		if strings.EqualFold(strings.TrimSpace(opt.Name), "done") {
+			doneOptions[opt.ID] = true
+		}
+	}
+
+	// Net change per item across the snapshots taken during the period
+	from := make(map[string]string)
+	to := make(map[string]string)
+	var order []string
+	last := make(map[string]string)
+	for _, snap := range history {
+		if snap.GroupFieldID != groupField.ID {
+			continue
+		}
+		inPeriod := !snap.At.Before(since) && snap.At.Before(until)
+		if inPeriod {
+			d.HasHistory = true
+		}
+		for itemID, column := range snap.Columns {
+			prev, seen := last[itemID]
+			last[itemID] = column
+			if !seen || prev == column || !inPeriod {
+				continue
+			}
+			if _, moved := from[itemID]; !moved {
+				from[itemID] = prev
+				order = append(order, itemID)
+			}
+			to[itemID] = column
+		}
+	}
+	for _, itemID := range order {
+		card, ok := byID[itemID]
+		if !ok || from[itemID] == to[itemID] {
+			continue // Archived since, or moved back
+		}
+		d.Moves = append(d.Moves, Move{Card: card, From: from[itemID], To: to[itemID]})
+		if doneOptions[to[itemID]] {
+			d.Completed = append(d.Completed, card)
+		}
+	}
+
+	for i := range cards {
+		created, err := time.Parse(time.RFC3339, cards[i].CreatedAt)
+		if err == nil && !created.Before(since) && created.Before(until) {
+			d.Created = append(d.Created, &cards[i])
+		}
+	}
+
+	sortCards := func(cards []*domain.Card) {
+		sort.SliceStable(cards, func(i, j int) bool { return cards[i].Title < cards[j].Title })
+	}
+	sortCards(d.Completed)
+	sortCards(d.Created)
+	sort.SliceStable(d.Moves, func(i, j int) bool { return d.Moves[i].Card.Title < d.Moves[j].Card.Title })
+	return d
+}
+
+// Markdown renders the digest for mail or a discussion post.
+func (d Digest) Markdown(project domain.Project, groupField domain.FieldDef) string {
+	columnName := func(id string) string {
+		for _, opt := range groupField.Options {
+			if opt.ID == id {
+				return opt.Name
+			}
+		}
+		return "No " + groupField.Name
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s: %s – %s\n", project.Title, d.Since.Format("Jan 2"), d.Until.Add(-time.Second).Format("Jan 2, 2006"))
+
+	section := func(title string, n int) bool {
+		fmt.Fprintf(&b, "\n## %s (%d)\n\n", title, n)
+		if n == 0 {
+			b.WriteString("_None_\n")
+		}
+		return n > 0
+	}
+	if section("Completed", len(d.Completed)) {
+		for _, card := range d.Completed {
+			fmt.Fprintf(&b, "- %s\n", digestItem(card))
+		}
+	}
+	if section("New", len(d.Created)) {
+		for _, card := range d.Created {
+			fmt.Fprintf(&b, "- %s\n", digestItem(card))
+		}
+	}
+	if section("Moved", len(d.Moves)) {
+		for _, mv := range d.Moves {
+			fmt.Fprintf(&b, "- %s: %s → %s\n", digestItem(mv.Card), columnName(mv.From), columnName(mv.To))
+		}
+	}
+
+	if !d.HasHistory {
+		b.WriteString("\n_No board snapshots were recorded in this period, so completed and moved items are missing. " +
+			"ghp records one each time it loads the project; running `ghp watch` records them continuously._\n")
+	}
+	return b.String()
+}
+
+// digestItem renders a card as a markdown list entry: linked title,
+// reference, and assignees
+func digestItem(card *domain.Card) string {
+	title := strings.NewReplacer("[", "\\[", "]", "\\]").Replace(card.Title)
+	if card.URL != "" {
+		title = fmt.Sprintf("[%s](%s)", title, card.URL)
+	}
+	if card.Repo != "" && card.Number > 0 {
+		title += fmt.Sprintf(" %s#%d", card.Repo, card.Number)
+	}
+	if len(card.Assignees) > 0 {
+		title += " (@" + strings.Join(card.Assignees, ", @") + ")"
+	}
+	return title
+}
@@ -0,0 +1,169 @@
+// Package stats computes board-level metrics from cards and their metadata.
+// It is pure computation; fetching the inputs is left to callers.
+package stats
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/h0rv/ghp/internal/domain"
+)
+
+// Column is a board column's identity and the cards currently in it.
+type Column struct {
+	ID    string
+	Name  string
+	Cards []*domain.Card
+}
+
+// ColumnPRStats summarizes the pull requests in one column.
+type ColumnPRStats struct {
+	ColumnID string
+	Name     string
+	PRs      int           // Pull requests with metadata
+	Reviewed int           // Pull requests with at least one review
+	Approved int           // Pull requests whose review decision is APPROVED
+	AvgOpen  time.Duration // Mean time from creation to close (or now, if still open)
+}
+
+// ReviewCoverage returns the fraction of PRs that have at least one review.
+// Returns 0 when the column has no PRs.
+func (s ColumnPRStats) ReviewCoverage() float64 {
+	if s.PRs == 0 {
+		return 0
+	}
+	return float64(s.Reviewed) / float64(s.PRs)
+}
+
+// PRStats computes pull request statistics for each column.
+// Cards that are not PRs, or whose metadata is missing from meta, are ignored.
+// now is the reference time for PRs that are still open.
+func PRStats(columns []Column, meta map[string]domain.PRMeta, now time.Time) []ColumnPRStats {
+	result := make([]ColumnPRStats, 0, len(columns))
+
+	for _, col := range columns {
+		s := ColumnPRStats{ColumnID: col.ID, Name: col.Name}
+		var totalOpen time.Duration
+
+		for _, card := range col.Cards {
+			if card.ContentType != domain.ContentTypePullRequest {
+				continue
+			}
+			pr, ok := meta[card.ContentID]
+			if !ok {
+				continue
+			}
+
+			created, err := time.Parse(time.RFC3339, pr.CreatedAt)
+			if err != nil {
+				continue
+			}
+			end := now
+			if closed, err := time.Parse(time.RFC3339, pr.ClosedAt); err == nil {
+				end = closed
+			}
+
+			s.PRs++
+			totalOpen += end.Sub(created)
+			if pr.ReviewCount > 0 {
+				s.Reviewed++
+			}
+			if pr.ReviewDecision == "APPROVED" {
+				s.Approved++
+			}
+		}
+
+		if s.PRs > 0 {
+			s.AvgOpen = totalOpen / time.Duration(s.PRs)
+		}
+		result = append(result, s)
+	}
+
+	return result
+}
+
+// FormatDuration renders a duration compactly for tables (e.g. "3d 4h", "5h", "12m").
+func FormatDuration(d time.Duration) string {
+	switch {
+	case d <= 0:
+		return "-"
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	}
+
+	days := int(d.Hours()) / 24
+	hours := int(d.Hours()) % 24
+	if hours == 0 {
+		return fmt.Sprintf("%dd", days)
+	}
+	return fmt.Sprintf("%dd %dh", days, hours)
+}
+
+// Snapshot records which column every item was in at a point in time, as
+// recorded by the caller (ghp keeps them in its cache).
+type Snapshot struct {
+	At           time.Time         // When the snapshot was taken
+	GroupFieldID string            // Field the column IDs belong to
+	Columns      map[string]string // ItemID -> option ID ("" for no status)
+}
+
+// ColumnCycleTime summarizes how long items stayed in one column.
+type ColumnCycleTime struct {
+	ColumnID string
+	Avg      time.Duration // Mean time from entering the column to leaving it
+	Exits    int           // Number of completed stays the average is based on
+}
+
+// CycleTimes computes the average time items spend in each column from a
+// sequence of history snapshots for the given grouping field.
+//
+// A stay is counted once an item is seen leaving a column. Stays whose start
+// was not observed (the item was already in the column in the first snapshot)
+// are skipped, since their true length is unknown. Returns a map keyed by
+// column option ID, with "" for items without a status.
+func CycleTimes(history []Snapshot, groupFieldID string) map[string]ColumnCycleTime {
+	type stay struct {
+		column   string
+		entered  time.Time
+		observed bool // Whether the entry into the column was seen
+	}
+
+	current := make(map[string]stay)
+	totals := make(map[string]time.Duration)
+	exits := make(map[string]int)
+	first := true
+
+	for _, snap := range history {
+		if snap.GroupFieldID != groupFieldID {
+			continue
+		}
+
+		for itemID, column := range snap.Columns {
+			prev, seen := current[itemID]
+			switch {
+			case !seen:
+				// Items that appear after the first snapshot were observed entering
+				current[itemID] = stay{column: column, entered: snap.At, observed: !first}
+			case prev.column != column:
+				if prev.observed {
+					totals[prev.column] += snap.At.Sub(prev.entered)
+					exits[prev.column]++
+				}
+				current[itemID] = stay{column: column, entered: snap.At, observed: true}
+			}
+		}
+		first = false
+	}
+
+	result := make(map[string]ColumnCycleTime, len(exits))
+	for column, n := range exits {
+		result[column] = ColumnCycleTime{
+			ColumnID: column,
+			Avg:      totals[column] / time.Duration(n),
+			Exits:    n,
+		}
+	}
+	return result
+}
@@ -0,0 +1,134 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPRStats(t *testing.T) {
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+
+	columns := []Column{
+		{ID: "opt_review", Name: "Review", Cards: []*domain.Card{
+			{ContentID: "pr_1", ContentType: domain.ContentTypePullRequest},
+			{ContentID: "pr_2", ContentType: domain.ContentTypePullRequest},
+			{ContentID: "issue_1", ContentType: domain.ContentTypeIssue},
+			{ContentID: "pr_missing", ContentType: domain.ContentTypePullRequest},
+		}},
+		{ID: "opt_done", Name: "Done", Cards: []*domain.Card{
+			{ContentID: "pr_3", ContentType: domain.ContentTypePullRequest},
+		}},
+		{ID: "opt_empty", Name: "Empty"},
+	}
+
+	meta := map[string]domain.PRMeta{
+		// Open for 2 days, reviewed and approved
+		"pr_1": {CreatedAt: "2024-06-08T12:00:00Z", ReviewCount: 2, ReviewDecision: "APPROVED"},
+		// Open for 4 days, no reviews
+		"pr_2": {CreatedAt: "2024-06-06T12:00:00Z"},
+		// Merged after 1 day
+		"pr_3": {CreatedAt: "2024-06-01T00:00:00Z", ClosedAt: "2024-06-02T00:00:00Z", ReviewCount: 1},
+	}
+
+	result := PRStats(columns, meta, now)
+
+	require.Len(t, result, 3)
+
+	assert.Equal(t, "Review", result[0].Name)
+	assert.Equal(t, 2, result[0].PRs)
+	assert.Equal(t, 1, result[0].Reviewed)
+	assert.Equal(t, 1, result[0].Approved)
+	assert.Equal(t, 3*24*time.Hour, result[0].AvgOpen)
+	assert.InDelta(t, 0.5, result[0].ReviewCoverage(), 0.001)
+
+	assert.Equal(t, 1, result[1].PRs)
+	assert.Equal(t, 24*time.Hour, result[1].AvgOpen)
+	assert.InDelta(t, 1.0, result[1].ReviewCoverage(), 0.001)
+
+	assert.Equal(t, 0, result[2].PRs)
+	assert.Equal(t, 0.0, result[2].ReviewCoverage())
+}
+
+func TestFormatDuration(t *testing.T) {
+	assert.Equal(t, "-", FormatDuration(0))
+	assert.Equal(t, "12m", FormatDuration(12*time.Minute))
+	assert.Equal(t, "5h", FormatDuration(5*time.Hour+30*time.Minute))
+	assert.Equal(t, "3d", FormatDuration(72*time.Hour))
+	assert.Equal(t, "3d 4h", FormatDuration(76*time.Hour))
+}
+
+func TestCycleTimes(t *testing.T) {
+	t0 := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	history := []Snapshot{
+		{At: t0, GroupFieldID: "status", Columns: map[string]string{"a": "todo", "b": "todo"}},
+		// a moves to review; its todo stay started before history, so it is not counted
+		{At: t0.Add(day), GroupFieldID: "status", Columns: map[string]string{"a": "review", "b": "todo", "c": ""}},
+		// Snapshot for a different grouping field is ignored
+		{At: t0.Add(day), GroupFieldID: "priority", Columns: map[string]string{"a": "high"}},
+		// c gets triaged into todo after one day without status
+		{At: t0.Add(2 * day), GroupFieldID: "status", Columns: map[string]string{"a": "review", "b": "todo", "c": "todo"}},
+		// a leaves review after 3 days; c leaves todo after 2 days
+		{At: t0.Add(4 * day), GroupFieldID: "status", Columns: map[string]string{"a": "done", "b": "todo", "c": "review"}},
+	}
+
+	result := CycleTimes(history, "status")
+
+	assert.Equal(t, ColumnCycleTime{ColumnID: "review", Avg: 3 * day, Exits: 1}, result["review"])
+	assert.Equal(t, ColumnCycleTime{ColumnID: "todo", Avg: 2 * day, Exits: 1}, result["todo"])
+	assert.Equal(t, ColumnCycleTime{ColumnID: "", Avg: day, Exits: 1}, result[""])
+	_, hasDone := result["done"]
+	assert.False(t, hasDone, "Stays still in progress are not counted")
+}
+
+func TestBuildDigest(t *testing.T) {
+	since := time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)
+	until := since.AddDate(0, 0, 7)
+	field := domain.FieldDef{ID: "f_status", Name: "Status", Options: []domain.Option{
+		{ID: "opt_todo", Name: "Todo"},
+		{ID: "opt_doing", Name: "In Progress"},
+		{ID: "opt_done", Name: "Done"},
+	}}
+	cards := []domain.Card{
+		{ItemID: "i1", Title: "Ship it", Repo: "o/r", Number: 1, URL: "https://github.com/o/r/issues/1", Assignees: []string{"alice"}, CreatedAt: "2024-05-01T00:00:00Z"},
+		{ItemID: "i2", Title: "Start it", Repo: "o/r", Number: 2, CreatedAt: "2024-06-04T00:00:00Z"},
+		{ItemID: "i3", Title: "Bounce", Repo: "o/r", Number: 3, CreatedAt: "2024-05-01T00:00:00Z"},
+		{ItemID: "i4", Title: "Idle", CreatedAt: "2024-06-20T00:00:00Z"},
+	}
+	history := []Snapshot{
+		{At: since.Add(-time.Hour), GroupFieldID: "f_status", Columns: map[string]string{"i1": "opt_todo", "i3": "opt_todo", "i4": "opt_todo"}},
+		{At: since.Add(24 * time.Hour), GroupFieldID: "f_status", Columns: map[string]string{"i1": "opt_doing", "i2": "", "i3": "opt_doing", "i4": "opt_todo"}},
+		// Another field's snapshot is ignored
+		{At: since.Add(36 * time.Hour), GroupFieldID: "f_other", Columns: map[string]string{"i1": "x"}},
+		{At: since.Add(48 * time.Hour), GroupFieldID: "f_status", Columns: map[string]string{"i1": "opt_done", "i2": "opt_doing", "i3": "opt_todo", "i4": "opt_todo", "gone": "opt_done"}},
+		// After the period
+		{At: until.Add(time.Hour), GroupFieldID: "f_status", Columns: map[string]string{"i4": "opt_done"}},
+	}
+
+	d := BuildDigest(cards, history, field, since, until)
+
+	assert.True(t, d.HasHistory)
+	require.Len(t, d.Completed, 1)
+	assert.Equal(t, "i1", d.Completed[0].ItemID)
+	require.Len(t, d.Created, 1)
+	assert.Equal(t, "i2", d.Created[0].ItemID)
+	// i3 moved back to Todo, and "gone" is no longer on the board
+	require.Len(t, d.Moves, 2)
+	assert.Equal(t, Move{Card: &cards[0], From: "opt_todo", To: "opt_done"}, d.Moves[0])
+	assert.Equal(t, Move{Card: &cards[1], From: "", To: "opt_doing"}, d.Moves[1])
+
+	md := d.Markdown(domain.Project{Title: "Roadmap"}, field)
+	assert.Contains(t, md, "# Roadmap: Jun 3 – Jun 9, 2024")
+	assert.Contains(t, md, "## Completed (1)\n\n- [Ship it](https://github.com/o/r/issues/1) o/r#1 (@alice)\n")
+	assert.Contains(t, md, "- Start it o/r#2: No Status → In Progress\n")
+	assert.NotContains(t, md, "No board snapshots")
+
+	empty := BuildDigest(cards, nil, field, since, until)
+	assert.False(t, empty.HasHistory)
+	assert.Contains(t, empty.Markdown(domain.Project{Title: "Roadmap"}, field), "## Moved (0)\n\n_None_\n")
+}
@@ -6,9 +6,13 @@ package store
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
+	"unicode"
 
 	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/suggest"
 )
 
 var (
@@ -39,6 +43,22 @@ type Store struct {
 	// Card storage
 	cards map[string]*domain.Card // ItemID -> Card
 
+	// Bodies are loaded on demand with the other item details and kept apart
+	// from cards: ItemID -> body. A present entry means details are loaded.
+	bodies map[string]string
+
+	// Interned strings shared by all cards (repos, labels, logins, option IDs).
+	// Large boards repeat the same few values thousands of times.
+	strs map[string]string
+
+	// Project order: ItemID -> position in which the card was first upserted.
+	// Pages arrive in project order, so this preserves the project's item order.
+	order     map[string]int
+	nextOrder int
+
+	// All project fields (for sorting by fields other than the grouping field)
+	fields []domain.FieldDef
+
 	// Column mapping: optionID -> []ItemID
 	// Special key NoStatusKey holds cards without a group value
 	columns map[string][]string
@@ -49,13 +69,27 @@ type Store struct {
 
 	// Rollback state for optimistic updates
 	rollbackCard *domain.Card
+
+	// Changes that reached GitHub, latest last, and the ones undone since
+	undo, redo   []Action
+	nextActionID int
+
+	// Repositories whose items are left out (lowercased nameWithOwner), and
+	// the items that were left out because of them
+	excludedRepos map[string]bool
+	excluded      map[string]bool
 }
 
 // New creates a new empty Store instance.
 func New() *Store {
 	return &Store{
 		cards:   make(map[string]*domain.Card),
+		bodies:  make(map[string]string),
+		strs:    make(map[string]string),
 		columns: make(map[string][]string),
+		order:   make(map[string]int),
+
+		excluded: make(map[string]bool),
 	}
 }
 
@@ -83,9 +117,36 @@ func (s *Store) GetViewerLogin() string {
 // This will trigger a rebuild of the column mapping.
 func (s *Store) SetGroupField(field *domain.FieldDef) {
 	s.groupField = field
+	if g := groupingOf(field); g != nil {
+		for _, card := range s.cards {
+			card.GroupOptionID = g.groupKey(card)
+		}
+	}
 	s.rebuildColumns()
 }
 
+// RegroupBy switches the grouping field without refetching items, taking
+// each card's column from the single-select values already on it. The old
+// field's values are kept on the cards, so switching back restores them.
+func (s *Store) RegroupBy(field *domain.FieldDef) {
+	if old := s.groupField; old != nil && !IsVirtualField(old) {
+		for _, card := range s.cards {
+			if card.GroupOptionID == "" {
+				delete(card.FieldValues, old.Name)
+				continue
+			}
+			if card.FieldValues == nil {
+				card.FieldValues = make(map[string]string)
+			}
+			card.FieldValues[old.Name] = card.GroupOptionID
+		}
+	}
+	for _, card := range s.cards {
+		card.GroupOptionID = card.FieldValues[field.Name]
+	}
+	s.SetGroupField(field)
+}
+
 // GetGroupField returns the current grouping field, or nil if not set.
 func (s *Store) GetGroupField() *domain.FieldDef {
 	return s.groupField
@@ -93,13 +154,60 @@ func (s *Store) GetGroupField() *domain.FieldDef {
 
 // UpsertCards adds or updates multiple cards in the store.
 // After upserting, column mappings are automatically rebuilt.
+// A card's body, if set, moves to the body store and is cleared on the card.
+// Cards from excluded repositories are dropped.
 func (s *Store) UpsertCards(cards []*domain.Card) {
 	for _, card := range cards {
+		if s.isExcluded(card) {
+			s.excluded[card.ItemID] = true
+			continue
+		}
+		if card.Body != "" {
+			s.bodies[card.ItemID] = card.Body
+			card.Body = ""
+		}
+		s.intern(card)
+		if g := groupingOf(s.groupField); g != nil {
+			card.GroupOptionID = g.groupKey(card)
+		}
 		s.cards[card.ItemID] = card
+		if _, ok := s.order[card.ItemID]; !ok {
+			s.order[card.ItemID] = s.nextOrder
+			s.nextOrder++
+		}
 	}
 	s.rebuildColumns()
 }
 
+// SetExcludedRepos sets the repositories (owner/name, any case) whose items
+// are kept out of the store, and drops the ones already stored.
+func (s *Store) SetExcludedRepos(repos []string) {
+	s.excludedRepos = make(map[string]bool, len(repos))
+	for _, repo := range repos {
+		s.excludedRepos[strings.ToLower(repo)] = true
+	}
+
+	var drop []string
+	for id, card := range s.cards {
+		if s.isExcluded(card) {
+			drop = append(drop, id)
+			s.excluded[id] = true
+		}
+	}
+	if len(drop) > 0 {
+		s.RemoveCards(drop)
+	}
+}
+
+// ExcludedCount returns how many items were dropped for their repository.
+func (s *Store) ExcludedCount() int {
+	return len(s.excluded)
+}
+
+func (s *Store) isExcluded(card *domain.Card) bool {
+	return card.Repo != "" && s.excludedRepos[strings.ToLower(card.Repo)]
+}
+
 // GetCard retrieves a card by ItemID, returning ErrCardNotFound if not found.
 func (s *Store) GetCard(itemID string) (*domain.Card, error) {
 	card, exists := s.cards[itemID]
@@ -109,6 +217,97 @@ func (s *Store) GetCard(itemID string) (*domain.Card, error) {
 	return card, nil
 }
 
+// RemoveCards drops cards from the store (e.g. after archiving) and rebuilds columns.
+func (s *Store) RemoveCards(itemIDs []string) {
+	for _, itemID := range itemIDs {
+		delete(s.cards, itemID)
+		delete(s.bodies, itemID)
+		delete(s.order, itemID)
+	}
+	s.rebuildColumns()
+}
+
+// SetDetails applies details fetched on demand to a card, storing the body separately.
+func (s *Store) SetDetails(itemID string, details domain.ItemDetails) {
+	card, ok := s.cards[itemID]
+	if !ok {
+		return
+	}
+	s.bodies[itemID] = details.Body
+	details.Body = ""
+	card.ApplyDetails(details)
+	s.intern(card)
+}
+
+// SetBody replaces the body of a card (e.g. after an edit).
+func (s *Store) SetBody(itemID, body string) {
+	s.bodies[itemID] = body
+}
+
+// GetBody returns a card's body and whether its details have been loaded.
+func (s *Store) GetBody(itemID string) (string, bool) {
+	body, ok := s.bodies[itemID]
+	return body, ok
+}
+
+// intern replaces a card's repeated strings with shared copies
+func (s *Store) intern(card *domain.Card) {
+	card.ContentType = s.str(card.ContentType)
+	card.Repo = s.str(card.Repo)
+	card.GroupOptionID = s.str(card.GroupOptionID)
+	card.State = s.str(card.State)
+	card.AccessHint = s.str(card.AccessHint)
+	card.Author = s.str(card.Author)
+	for i := range card.Assignees {
+		card.Assignees[i] = s.str(card.Assignees[i])
+	}
+	for i := range card.Labels {
+		card.Labels[i] = s.str(card.Labels[i])
+	}
+	if pr := card.PR; pr != nil {
+		pr.ReviewDecision = s.str(pr.ReviewDecision)
+		pr.Checks = s.str(pr.Checks)
+		pr.Mergeable = s.str(pr.Mergeable)
+	}
+	if len(card.FieldValues) > 0 {
+		values := make(map[string]string, len(card.FieldValues))
+		for field, option := range card.FieldValues {
+			values[s.str(field)] = s.str(option)
+		}
+		card.FieldValues = values
+	}
+}
+
+// str returns the shared copy of v
+func (s *Store) str(v string) string {
+	if v == "" {
+		return ""
+	}
+	if shared, ok := s.strs[v]; ok {
+		return shared
+	}
+	s.strs[v] = v
+	return v
+}
+
+// Position returns the card's position in project order, or -1 if it is not in the store.
+func (s *Store) Position(itemID string) int {
+	if pos, ok := s.order[itemID]; ok {
+		return pos
+	}
+	return -1
+}
+
+// SetFields sets all field definitions of the current project.
+func (s *Store) SetFields(fields []domain.FieldDef) {
+	s.fields = fields
+}
+
+// GetFields returns all field definitions of the current project.
+func (s *Store) GetFields() []domain.FieldDef {
+	return s.fields
+}
+
 // GetAllCards returns all cards in the store.
 func (s *Store) GetAllCards() []*domain.Card {
 	cards := make([]*domain.Card, 0, len(s.cards))
@@ -137,6 +336,35 @@ func (s *Store) GetColumns() (map[string][]string, error) {
 	return result, nil
 }
 
+// GetColumnLanes splits each column by a second single-select field, for
+// swimlanes: column key -> lane key -> item IDs in project order. Lane keys
+// are the field's option IDs, with NoStatusKey for cards without a value (or
+// with an option the field no longer has).
+func (s *Store) GetColumnLanes(laneField *domain.FieldDef) (map[string]map[string][]string, error) {
+	if s.groupField == nil {
+		return nil, ErrNoGroupField
+	}
+
+	known := make(map[string]bool, len(laneField.Options))
+	for _, opt := range laneField.Options {
+		known[opt.ID] = true
+	}
+
+	result := make(map[string]map[string][]string, len(s.columns))
+	for colKey, itemIDs := range s.columns {
+		lanes := make(map[string][]string)
+		for _, itemID := range itemIDs {
+			lane := s.cards[itemID].FieldValues[laneField.Name]
+			if !known[lane] {
+				lane = NoStatusKey
+			}
+			lanes[lane] = append(lanes[lane], itemID)
+		}
+		result[colKey] = lanes
+	}
+	return result, nil
+}
+
 // GetColumnCardIDs returns the card IDs for a specific column (optionID or NoStatusKey).
 func (s *Store) GetColumnCardIDs(optionID string) []string {
 	ids, exists := s.columns[optionID]
@@ -152,26 +380,23 @@ func (s *Store) GetColumnCardIDs(optionID string) []string {
 // MoveCard performs an optimistic move of a card to a new column.
 // It updates the card's GroupOptionID and rebuilds columns.
 // The previous state is saved for potential rollback.
-// Returns ErrCardNotFound if the card doesn't exist.
+// Returns ErrCardNotFound if the card doesn't exist, and ErrFixedGroup if
+// the grouping field's columns can't be moved between.
 func (s *Store) MoveCard(itemID string, newOptionID string) error {
 	card, exists := s.cards[itemID]
 	if !exists {
 		return ErrCardNotFound
 	}
-
-	// Save rollback state (copy the card)
-	s.rollbackCard = &domain.Card{
-		ItemID:        card.ItemID,
-		ContentType:   card.ContentType,
-		Title:         card.Title,
-		URL:           card.URL,
-		Repo:          card.Repo,
-		Number:        card.Number,
-		GroupOptionID: card.GroupOptionID,
+	if !Movable(s.groupField) {
+		return ErrFixedGroup
 	}
 
+	// Save rollback state (copy the card so no field is lost on rollback)
+	saved := *card
+	s.rollbackCard = &saved
+
 	// Update the card
-	card.GroupOptionID = newOptionID
+	s.setGroupOption(card, card.GroupOptionID, newOptionID)
 	s.rebuildColumns()
 
 	return nil
@@ -195,6 +420,69 @@ func (s *Store) RollbackMove() error {
 	return nil
 }
 
+// RevertMove puts back a card that MoveCard moved from one option to another,
+// for when one of several moves in flight fails.
+// Returns ErrCardNotFound if the card doesn't exist.
+func (s *Store) RevertMove(itemID, from, to string) error {
+	card, exists := s.cards[itemID]
+	if !exists {
+		return ErrCardNotFound
+	}
+	s.setGroupOption(card, to, from)
+	s.rebuildColumns()
+	return nil
+}
+
+// MoveAfter places a card just after afterID in project order, or first
+// when afterID is "", as GitHub does for a manual reorder. Every other card
+// keeps its place.
+func (s *Store) MoveAfter(itemID, afterID string) error {
+	if _, ok := s.order[itemID]; !ok {
+		return ErrCardNotFound
+	}
+	if _, ok := s.order[afterID]; afterID != "" && !ok {
+		return ErrCardNotFound
+	}
+	ids := make([]string, 0, len(s.order))
+	for id := range s.order {
+		if id != itemID {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return s.order[ids[i]] < s.order[ids[j]] })
+	at := 0
+	if afterID != "" {
+		at = slices.Index(ids, afterID) + 1
+	}
+	ids = slices.Insert(ids, at, itemID)
+	for i, id := range ids {
+		s.order[id] = i
+	}
+	s.nextOrder = len(ids)
+	s.rebuildColumns()
+	return nil
+}
+
+// PrecedingInColumn returns the card just before itemID in its column's
+// project order, or "" when it is first.
+func (s *Store) PrecedingInColumn(itemID string) string {
+	card, ok := s.cards[itemID]
+	if !ok {
+		return ""
+	}
+	key := card.GroupOptionID
+	if key == "" {
+		key = NoStatusKey
+	}
+	ids := s.columns[key]
+	for i, id := range ids {
+		if id == itemID && i > 0 {
+			return ids[i-1]
+		}
+	}
+	return ""
+}
+
 // SetPagination updates the pagination state.
 func (s *Store) SetPagination(cursor string, hasNextPage bool) {
 	s.cursor = cursor
@@ -209,6 +497,10 @@ func (s *Store) GetPagination() (cursor string, hasNextPage bool) {
 // rebuildColumns reconstructs the column mapping from current cards.
 // Cards are grouped by their GroupOptionID, with empty values going to NoStatusKey.
 func (s *Store) rebuildColumns() {
+	if g := groupingOf(s.groupField); g != nil {
+		s.refreshVirtualOptions(g)
+	}
+
 	// Clear existing columns
 	s.columns = make(map[string][]string)
 
@@ -220,6 +512,11 @@ func (s *Store) rebuildColumns() {
 		}
 		s.columns[key] = append(s.columns[key], itemID)
 	}
+
+	// Keep each column in project order
+	for _, ids := range s.columns {
+		sort.Slice(ids, func(i, j int) bool { return s.order[ids[i]] < s.order[ids[j]] })
+	}
 }
 
 // SelectGroupField implements the field selection heuristic from the spec:
@@ -261,6 +558,112 @@ func SelectGroupField(fields []*domain.FieldDef) (selected *domain.FieldDef, can
 	return nil, singleSelectFields, nil
 }
 
+// MatchesField reports whether nameOrID names f by ID or by name, ignoring case.
+func MatchesField(f domain.FieldDef, nameOrID string) bool {
+	return f.ID == nameOrID || strings.EqualFold(f.Name, nameOrID)
+}
+
+// FindGroupField returns the SINGLE_SELECT field named by nameOrID: an exact
+// name, a field ID, or a name differing only in case. When nothing matches,
+// the error lists the fields that can group the board and suggests the
+// closest name. Virtual fields, such as "Assignee", win over project fields
+// of the same name.
+func FindGroupField(fields []domain.FieldDef, nameOrID string) (*domain.FieldDef, error) {
+	if g := findGrouping(nameOrID); g != nil {
+		return virtualField(g.field.ID), nil
+	}
+	match := func(f *domain.FieldDef) bool { return f.Name == nameOrID || f.ID == nameOrID }
+	for pass := 0; pass < 2; pass++ {
+		for i := range fields {
+			if f := &fields[i]; match(f) {
+				if f.Type != domain.FieldTypeSingleSelect {
+					return nil, fmt.Errorf("field '%s' is a %s field; only SINGLE_SELECT fields can group the board", f.Name, f.Type)
+				}
+				return f, nil
+			}
+		}
+		match = func(f *domain.FieldDef) bool { return strings.EqualFold(f.Name, nameOrID) }
+	}
+
+	var names []string
+	for _, f := range fields {
+		if f.Type == domain.FieldTypeSingleSelect {
+			names = append(names, f.Name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("field '%s' not found in project, which has no SINGLE_SELECT fields", nameOrID)
+	}
+	for _, f := range VirtualFields() {
+		names = append(names, f.Name)
+	}
+	msg := fmt.Sprintf("field '%s' not found in project", nameOrID)
+	if s := suggest.Closest(nameOrID, names); s != "" {
+		msg += fmt.Sprintf(" (did you mean '%s'?)", s)
+	}
+	return nil, fmt.Errorf("%s; group by one of: %s", msg, strings.Join(names, ", "))
+}
+
+// FindOption returns the option of field named by nameOrID: an option ID, a
+// name ignoring case, spaces, and punctuation, or the one option whose name
+// starts with or contains it. The error lists the options and suggests the
+// closest one when nothing matches.
+func FindOption(field *domain.FieldDef, nameOrID string) (*domain.Option, error) {
+	query := foldName(nameOrID)
+	if query == "" {
+		return nil, fmt.Errorf("%s option '%s' not found", field.Name, nameOrID)
+	}
+
+	var prefixed, contained []*domain.Option
+	for i := range field.Options {
+		opt := &field.Options[i]
+		name := foldName(opt.Name)
+		switch {
+		case opt.ID == nameOrID || name == query:
+			return opt, nil
+		case strings.HasPrefix(name, query):
+			prefixed = append(prefixed, opt)
+		case strings.Contains(name, query):
+			contained = append(contained, opt)
+		}
+	}
+	for _, matches := range [][]*domain.Option{prefixed, contained} {
+		switch len(matches) {
+		case 0:
+			continue
+		case 1:
+			return matches[0], nil
+		}
+		names := make([]string, len(matches))
+		for i, opt := range matches {
+			names[i] = opt.Name
+		}
+		return nil, fmt.Errorf("%s option '%s' is ambiguous (%s)", field.Name, nameOrID, strings.Join(names, ", "))
+	}
+
+	names := make([]string, len(field.Options))
+	for i, opt := range field.Options {
+		names[i] = opt.Name
+	}
+	msg := fmt.Sprintf("%s option '%s' not found", field.Name, nameOrID)
+	if s := suggest.Closest(nameOrID, names); s != "" {
+		msg += fmt.Sprintf(" (did you mean '%s'?)", s)
+	}
+	return nil, fmt.Errorf("%s; options: %s", msg, strings.Join(names, ", "))
+}
+
+// foldName lowercases name and drops everything but letters and digits, so
+// "in-progress" and "🚧 In Progress" compare equal
+func foldName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
 // ValidateOption checks if an option ID is valid for the current grouping field.
 // Returns ErrNoGroupField if no grouping field is set, ErrInvalidOption if invalid.
 func (s *Store) ValidateOption(optionID string) error {
@@ -286,15 +689,23 @@ func (s *Store) ValidateOption(optionID string) error {
 // Clear resets the store to empty state, preserving project and group field.
 func (s *Store) Clear() {
 	s.cards = make(map[string]*domain.Card)
+	s.bodies = make(map[string]string)
+	s.strs = make(map[string]string)
 	s.columns = make(map[string][]string)
+	s.order = make(map[string]int)
+	s.nextOrder = 0
 	s.cursor = ""
 	s.hasNextPage = false
 	s.rollbackCard = nil
+	s.excluded = make(map[string]bool)
 }
 
 // Reset completely resets the store to initial state.
 func (s *Store) Reset() {
 	s.project = nil
 	s.groupField = nil
+	s.fields = nil
+	s.excludedRepos = nil
+	s.undo, s.redo = nil, nil
 	s.Clear()
 }
@@ -2,6 +2,7 @@ package store
 
 import (
 	"testing"
+	"unsafe"
 
 	"github.com/h0rv/ghp/internal/domain"
 	"github.com/stretchr/testify/assert"
@@ -162,6 +163,53 @@ func TestUpsertCards(t *testing.T) {
 	assert.Equal(t, "opt_done", retrieved.GroupOptionID)
 }
 
+// TestExcludedRepos verifies items of excluded repositories stay out of the store
+func TestExcludedRepos(t *testing.T) {
+	s := New()
+	s.SetGroupField(createTestStatusField())
+	s.UpsertCards(createTestCards())
+
+	// Already stored items are dropped; drafts have no repository to match
+	s.SetExcludedRepos([]string{"Test/Repo"})
+	assert.Len(t, s.GetAllCards(), 1)
+	assert.Equal(t, 3, s.ExcludedCount())
+	assert.Empty(t, s.GetColumnCardIDs("opt_todo"))
+
+	// Later upserts skip them too
+	s.Clear()
+	s.UpsertCards(createTestCards())
+	assert.Len(t, s.GetAllCards(), 1)
+	assert.Equal(t, 3, s.ExcludedCount())
+	_, err := s.GetCard("item_1")
+	assert.ErrorIs(t, err, ErrCardNotFound)
+}
+
+// TestMoveAfter verifies manual reordering within a column
+func TestMoveAfter(t *testing.T) {
+	s := New()
+	s.SetGroupField(createTestStatusField())
+	cards := createTestCards()[:3]
+	for i := range cards {
+		cards[i].GroupOptionID = "opt_todo"
+	}
+	s.UpsertCards(cards)
+	require.Equal(t, []string{"item_1", "item_2", "item_3"}, s.GetColumnCardIDs("opt_todo"))
+
+	require.NoError(t, s.MoveAfter("item_1", "item_2"))
+	assert.Equal(t, []string{"item_2", "item_1", "item_3"}, s.GetColumnCardIDs("opt_todo"))
+	assert.Equal(t, "item_2", s.PrecedingInColumn("item_1"))
+	assert.Equal(t, "", s.PrecedingInColumn("item_2"), "First in column")
+
+	// Only the moved card changes place
+	require.NoError(t, s.MoveAfter("item_3", ""))
+	assert.Equal(t, []string{"item_3", "item_2", "item_1"}, s.GetColumnCardIDs("opt_todo"))
+	require.NoError(t, s.MoveAfter("item_3", "item_1"))
+	assert.Equal(t, []string{"item_2", "item_1", "item_3"}, s.GetColumnCardIDs("opt_todo"))
+
+	assert.ErrorIs(t, s.MoveAfter("item_1", "missing"), ErrCardNotFound)
+	assert.ErrorIs(t, s.MoveAfter("missing", ""), ErrCardNotFound)
+}
+
 // TestGetCard verifies card retrieval
 func TestGetCard(t *testing.T) {
 	s := New()
@@ -674,3 +722,291 @@ func TestConcurrentCardOperations(t *testing.T) {
 	assert.Len(t, columns["opt_inprogress"], 1)
 	assert.NotContains(t, columns, "opt_todo") // Empty columns might not exist in map
 }
+
+// TestColumnsKeepProjectOrder verifies cards stay in the order they were first upserted
+func TestColumnsKeepProjectOrder(t *testing.T) {
+	s := New()
+	s.SetGroupField(createTestStatusField())
+
+	var cards []*domain.Card
+	for _, id := range []string{"item_c", "item_a", "item_d", "item_b"} {
+		cards = append(cards, &domain.Card{ItemID: id, ContentType: domain.ContentTypeIssue, GroupOptionID: "opt_todo"})
+	}
+	s.UpsertCards(cards[:2])
+	s.UpsertCards(cards[2:])
+
+	// Re-upserting an existing card (e.g. after an update) keeps its position
+	s.UpsertCards([]*domain.Card{{ItemID: "item_c", ContentType: domain.ContentTypeIssue, GroupOptionID: "opt_todo"}})
+
+	assert.Equal(t, []string{"item_c", "item_a", "item_d", "item_b"}, s.GetColumnCardIDs("opt_todo"))
+	assert.Equal(t, 2, s.Position("item_d"))
+	assert.Equal(t, -1, s.Position("missing"))
+}
+
+// TestRemoveCards verifies removed cards disappear from the store and columns
+func TestRemoveCards(t *testing.T) {
+	s := New()
+	s.SetGroupField(createTestStatusField())
+	s.UpsertCards(createTestCards())
+
+	s.RemoveCards([]string{"item_4", "missing"})
+
+	_, err := s.GetCard("item_4")
+	assert.ErrorIs(t, err, ErrCardNotFound)
+	assert.Empty(t, s.GetColumnCardIDs("opt_done"))
+	assert.Len(t, s.GetAllCards(), 3)
+	assert.Equal(t, -1, s.Position("item_4"))
+}
+
+// TestBodiesStoredSeparately verifies bodies move off cards and load on demand
+func TestBodiesStoredSeparately(t *testing.T) {
+	s := New()
+	s.SetGroupField(createTestStatusField())
+	cards := createTestCards()
+	cards[0].Body = "Steps to reproduce"
+	s.UpsertCards(cards)
+
+	card, err := s.GetCard("item_1")
+	require.NoError(t, err)
+	assert.Empty(t, card.Body, "Bodies are not kept on cards")
+	body, ok := s.GetBody("item_1")
+	assert.True(t, ok)
+	assert.Equal(t, "Steps to reproduce", body)
+
+	_, ok = s.GetBody("item_2")
+	assert.False(t, ok, "Unfetched bodies are reported missing")
+	s.SetBody("item_2", "")
+	_, ok = s.GetBody("item_2")
+	assert.True(t, ok, "An empty body still counts as loaded")
+}
+
+func TestSetDetails(t *testing.T) {
+	s := New()
+	s.SetGroupField(createTestStatusField())
+	s.UpsertCards(createTestCards())
+
+	s.SetDetails("item_1", domain.ItemDetails{
+		Body:      "Details body",
+		Labels:    []string{"bug"},
+		Author:    "octocat",
+		CreatedAt: "2024-01-01T00:00:00Z",
+	})
+
+	card, err := s.GetCard("item_1")
+	require.NoError(t, err)
+	assert.Empty(t, card.Body)
+	assert.Equal(t, []string{"bug"}, card.Labels)
+	assert.Equal(t, "octocat", card.Author)
+	assert.Equal(t, "2024-01-01T00:00:00Z", card.CreatedAt)
+	body, ok := s.GetBody("item_1")
+	assert.True(t, ok)
+	assert.Equal(t, "Details body", body)
+
+	s.SetDetails("missing", domain.ItemDetails{Body: "x"})
+	_, ok = s.GetBody("missing")
+	assert.False(t, ok, "Details for unknown items are dropped")
+}
+
+// TestUpsertCardsInternsStrings verifies repeated values share one copy
+func TestUpsertCardsInternsStrings(t *testing.T) {
+	s := New()
+	a := &domain.Card{ItemID: "a", Repo: string([]byte("test/repo")), Labels: []string{string([]byte("bug"))}}
+	b := &domain.Card{ItemID: "b", Repo: string([]byte("test/repo")), Labels: []string{string([]byte("bug"))}}
+	s.UpsertCards([]*domain.Card{a, b})
+
+	assert.Same(t, unsafe.StringData(a.Repo), unsafe.StringData(b.Repo))
+	assert.Same(t, unsafe.StringData(a.Labels[0]), unsafe.StringData(b.Labels[0]))
+}
+
+// TestGetColumnLanes verifies cards split by a second field within columns
+func TestGetColumnLanes(t *testing.T) {
+	s := New()
+	priority := &domain.FieldDef{ID: "f_pri", Name: "Priority", Type: domain.FieldTypeSingleSelect,
+		Options: []domain.Option{{ID: "p0", Name: "P0"}, {ID: "p1", Name: "P1"}}}
+	_, err := s.GetColumnLanes(priority)
+	assert.ErrorIs(t, err, ErrNoGroupField)
+
+	s.SetGroupField(createTestStatusField())
+	s.UpsertCards([]*domain.Card{
+		{ItemID: "a", GroupOptionID: "opt_todo", FieldValues: map[string]string{"Priority": "p1"}},
+		{ItemID: "b", GroupOptionID: "opt_todo", FieldValues: map[string]string{"Priority": "p0"}},
+		{ItemID: "c", GroupOptionID: "opt_todo", FieldValues: map[string]string{"Priority": "p1"}},
+		{ItemID: "d", GroupOptionID: "opt_todo"},
+		{ItemID: "e", FieldValues: map[string]string{"Priority": "gone"}},
+	})
+
+	lanes, err := s.GetColumnLanes(priority)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"p0": {"b"}, "p1": {"a", "c"}, NoStatusKey: {"d"}}, lanes["opt_todo"])
+	assert.Equal(t, map[string][]string{NoStatusKey: {"e"}}, lanes[NoStatusKey], "Unknown options count as no value")
+}
+
+// TestFindGroupField verifies --group-field lookup by name, case, and ID, and
+// the suggestions given when nothing matches
+func TestFindGroupField(t *testing.T) {
+	fields := []domain.FieldDef{
+		*createTestStatusField(),
+		*createTestPriorityField(),
+		{ID: "field_notes", Name: "Notes", Type: domain.FieldTypeText},
+	}
+
+	for _, nameOrID := range []string{"Status", "status", fields[0].ID} {
+		field, err := FindGroupField(fields, nameOrID)
+		require.NoError(t, err, nameOrID)
+		assert.Equal(t, "Status", field.Name)
+	}
+
+	for nameOrID, id := range map[string]string{"Assignees": AssigneeFieldID, "repo": RepoFieldID, RepoFieldID: RepoFieldID} {
+		field, err := FindGroupField(fields, nameOrID)
+		require.NoError(t, err, nameOrID)
+		assert.Equal(t, id, field.ID, "Virtual fields")
+	}
+
+	_, err := FindGroupField(fields, "Stauts")
+	require.Error(t, err)
+	assert.Equal(t, "field 'Stauts' not found in project (did you mean 'Status'?); group by one of: Status, Priority, Assignee, Repository", err.Error())
+
+	_, err = FindGroupField(fields, "Milestone")
+	require.Error(t, err)
+	assert.Equal(t, "field 'Milestone' not found in project; group by one of: Status, Priority, Assignee, Repository", err.Error())
+
+	_, err = FindGroupField(fields, "notes")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "'Notes' is a TEXT field")
+
+	assert.True(t, MatchesField(fields[0], "STATUS"))
+	assert.False(t, MatchesField(fields[0], "Priority"))
+}
+
+// TestFindOption verifies option lookup by ID, folded name, and unique
+// prefix or substring, and the errors for ambiguous and unknown names
+func TestFindOption(t *testing.T) {
+	field := &domain.FieldDef{
+		Name: "Status",
+		Options: []domain.Option{
+			{ID: "opt_todo", Name: "Todo"},
+			{ID: "opt_progress", Name: "🚧 In Progress"},
+			{ID: "opt_review", Name: "In Review"},
+			{ID: "opt_done", Name: "Done"},
+		},
+	}
+
+	for query, want := range map[string]string{
+		"opt_done":    "opt_done",
+		"DONE":        "opt_done",
+		"in-progress": "opt_progress",
+		"tod":         "opt_todo",
+		"prog":        "opt_progress",
+		"review":      "opt_review",
+	} {
+		opt, err := FindOption(field, query)
+		require.NoError(t, err, query)
+		assert.Equal(t, want, opt.ID, query)
+	}
+
+	_, err := FindOption(field, "in")
+	require.Error(t, err)
+	assert.Equal(t, "Status option 'in' is ambiguous (🚧 In Progress, In Review)", err.Error())
+
+	_, err = FindOption(field, "Dnoe")
+	require.Error(t, err)
+	assert.Equal(t, "Status option 'Dnoe' not found (did you mean 'Done'?); options: Todo, 🚧 In Progress, In Review, Done", err.Error())
+
+	_, err = FindOption(field, "  ")
+	assert.Error(t, err)
+}
+
+// TestUndoLastAction verifies undo and redo of moves, field edits, and
+// archives, and that recording a new action forgets undone ones
+func TestUndoLastAction(t *testing.T) {
+	s := New()
+	s.SetProject(createTestProject())
+	s.SetGroupField(createTestStatusField())
+	s.UpsertCards(createTestCards())
+
+	_, err := s.UndoLastAction()
+	assert.ErrorIs(t, err, ErrNothingToUndo)
+
+	// Move
+	require.NoError(t, s.MoveCard("item_1", "opt_done"))
+	s.RecordAction(Action{Kind: ActionMove, ItemID: "item_1", From: "opt_todo", To: "opt_done"})
+	a, err := s.UndoLastAction()
+	require.NoError(t, err)
+	assert.Equal(t, "item_1", a.ItemID)
+	assert.Contains(t, s.GetColumnCardIDs("opt_todo"), "item_1")
+	_, err = s.RedoLastAction()
+	require.NoError(t, err)
+	assert.Contains(t, s.GetColumnCardIDs("opt_done"), "item_1")
+
+	// Field edit
+	priority := *createTestPriorityField()
+	s.SetFieldValue("item_2", priority, domain.FieldValue{ID: "opt_high", Text: "High"})
+	s.RecordAction(Action{Kind: ActionSetField, ItemID: "item_2", Field: priority, After: &domain.FieldValue{ID: "opt_high", Text: "High"}})
+	card, _ := s.GetCard("item_2")
+	assert.Equal(t, "opt_high", card.FieldValues["Priority"])
+	_, err = s.UndoLastAction()
+	require.NoError(t, err)
+	assert.NotContains(t, card.FieldValues, "Priority")
+
+	// Recording forgets the undone field edit
+	_, err = s.RedoLastAction()
+	require.NoError(t, err)
+	_, _ = s.UndoLastAction()
+	archived, _ := s.GetCard("item_4")
+	s.RecordAction(Action{Kind: ActionArchive, Cards: []domain.Card{*archived}})
+	s.RemoveCards([]string{"item_4"})
+	_, err = s.RedoLastAction()
+	assert.ErrorIs(t, err, ErrNothingToRedo)
+
+	// Archive comes back in its place in project order
+	a, err = s.UndoLastAction()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"item_4"}, a.ItemIDs())
+	_, err = s.GetCard("item_4")
+	require.NoError(t, err)
+	assert.Equal(t, 3, s.Position("item_4"))
+
+	// A refused undo is taken back
+	assert.True(t, s.CancelUndo(a, false))
+	_, err = s.GetCard("item_4")
+	assert.ErrorIs(t, err, ErrCardNotFound)
+	assert.False(t, s.CancelUndo(a, false), "Only the latest step can be taken back")
+
+	// A retried undo steps back again
+	assert.True(t, s.RepeatUndo(a, false))
+	assert.Equal(t, 3, s.Position("item_4"))
+	assert.True(t, s.RepeatUndo(a, false), "Already undone")
+	assert.False(t, s.RepeatUndo(Action{ID: a.ID + 1}, false), "Other steps aren't repeated")
+}
+
+// TestGroupByAssignee verifies the virtual assignee field: a column per
+// assignee with the viewer first, and moves handing cards between assignees
+func TestGroupByAssignee(t *testing.T) {
+	s := New()
+	s.SetViewerLogin("zoe")
+	s.UpsertCards([]*domain.Card{
+		{ItemID: "a", Assignees: []string{"bob", "carol"}, GroupOptionID: "opt_todo"},
+		{ItemID: "b", Assignees: []string{"zoe"}},
+		{ItemID: "c"},
+	})
+
+	field, err := FindGroupField([]domain.FieldDef{*createTestStatusField()}, "assignee")
+	require.NoError(t, err)
+	require.True(t, IsAssigneeField(field))
+	s.SetGroupField(field)
+
+	assert.Equal(t, []domain.Option{{ID: "zoe", Name: "zoe"}, {ID: "bob", Name: "bob", Order: 1}, {ID: "carol", Name: "carol", Order: 2}}, field.Options)
+	columns, err := s.GetColumns()
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"bob": {"a"}, "zoe": {"b"}, NoStatusKey: {"c"}}, columns)
+
+	require.NoError(t, s.MoveCard("a", "zoe"))
+	card, err := s.GetCard("a")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"zoe", "carol"}, card.Assignees, "The first assignee is replaced")
+	assert.Len(t, field.Options, 3, "An emptied column stays")
+
+	require.NoError(t, s.MoveCard("a", ""))
+	assert.Equal(t, []string{"carol"}, card.Assignees)
+	assert.Equal(t, "carol", card.GroupOptionID, "Grouped under the remaining assignee")
+}
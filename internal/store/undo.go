@@ -0,0 +1,234 @@
+package store
+
+import (
+	"errors"
+
+	"github.com/h0rv/ghp/internal/domain"
+)
+
+// maxUndo is how many actions the undo stack keeps.
+const maxUndo = 50
+
+var (
+	// ErrNothingToUndo indicates the undo stack is empty.
+	ErrNothingToUndo = errors.New("nothing to undo")
+	// ErrNothingToRedo indicates no undone action is left to redo.
+	ErrNothingToRedo = errors.New("nothing to redo")
+)
+
+// ActionKind is the kind of board change an Action records.
+type ActionKind int
+
+const (
+	ActionMove     ActionKind = iota // A card moved to another column
+	ActionSetField                   // A field value set or cleared
+	ActionArchive                    // Items archived
+)
+
+// Action is a board change that reached GitHub. Undoing it restores the
+// store and leaves the caller to send the inverse mutation; only the fields
+// of its Kind are set.
+type Action struct {
+	ID    int // Set by RecordAction
+	Kind  ActionKind
+	Label string // e.g. "Move #12 to Done"
+
+	ItemID string // Moved or edited item
+
+	// Option IDs a card moved between, "" for no status. Between assignee
+	// columns they are logins, and ContentID is the reassigned issue or PR.
+	From, To  string
+	ContentID string
+
+	// Edited field and its values, nil when empty. Moves record the grouping
+	// field they were made in, so undoing one after regrouping sets that field.
+	Field         domain.FieldDef
+	Before, After *domain.FieldValue
+
+	// Archived items as they were, and their places in project order
+	Cards     []domain.Card
+	positions []int
+}
+
+// ItemIDs returns the items an action changed.
+func (a Action) ItemIDs() []string {
+	if a.Kind != ActionArchive {
+		return []string{a.ItemID}
+	}
+	ids := make([]string, len(a.Cards))
+	for i, card := range a.Cards {
+		ids[i] = card.ItemID
+	}
+	return ids
+}
+
+// RecordAction pushes a change that reached GitHub onto the undo stack and
+// forgets undone actions. Archives must be recorded before their cards are
+// removed, so undoing them puts the cards back in place.
+func (s *Store) RecordAction(a Action) {
+	if a.Kind == ActionArchive {
+		a.positions = make([]int, len(a.Cards))
+		for i, card := range a.Cards {
+			pos, ok := s.order[card.ItemID]
+			if !ok {
+				pos = s.nextOrder
+				s.nextOrder++
+			}
+			a.positions[i] = pos
+		}
+	}
+	s.nextActionID++
+	a.ID = s.nextActionID
+	s.undo = append(s.undo, a)
+	if len(s.undo) > maxUndo {
+		s.undo = s.undo[len(s.undo)-maxUndo:]
+	}
+	s.redo = nil
+}
+
+// UndoLastAction reverts the latest recorded action in the store and returns
+// it, so the caller can send the inverse mutation. It moves to the redo stack.
+// Returns ErrNothingToUndo when there is none.
+func (s *Store) UndoLastAction() (Action, error) {
+	if len(s.undo) == 0 {
+		return Action{}, ErrNothingToUndo
+	}
+	a := s.undo[len(s.undo)-1]
+	s.undo = s.undo[:len(s.undo)-1]
+	s.redo = append(s.redo, a)
+	s.applyAction(a, false)
+	return a, nil
+}
+
+// RedoLastAction re-applies the latest undone action in the store and returns
+// it, so the caller can send the mutation again. Returns ErrNothingToRedo
+// when there is none.
+func (s *Store) RedoLastAction() (Action, error) {
+	if len(s.redo) == 0 {
+		return Action{}, ErrNothingToRedo
+	}
+	a := s.redo[len(s.redo)-1]
+	s.redo = s.redo[:len(s.redo)-1]
+	s.undo = append(s.undo, a)
+	s.applyAction(a, true)
+	return a, nil
+}
+
+// CancelUndo takes back an UndoLastAction (or with redo, a RedoLastAction)
+// of a whose mutation GitHub refused, restoring the store and both stacks.
+// It reports false, changing nothing, if other actions were recorded since.
+func (s *Store) CancelUndo(a Action, redo bool) bool {
+	if redo {
+		if len(s.undo) == 0 || s.undo[len(s.undo)-1].ID != a.ID {
+			return false
+		}
+		_, _ = s.UndoLastAction()
+		return true
+	}
+	if len(s.redo) == 0 || s.redo[len(s.redo)-1].ID != a.ID {
+		return false
+	}
+	_, _ = s.RedoLastAction()
+	return true
+}
+
+// RepeatUndo puts the store back in the state after the undo (or with redo,
+// the redo) of a, before a retry resends its mutation. It redoes the step
+// CancelUndo took back, and reports false if a is on neither end of it.
+func (s *Store) RepeatUndo(a Action, redo bool) bool {
+	from, to := s.undo, s.redo
+	step := s.UndoLastAction
+	if redo {
+		from, to, step = s.redo, s.undo, s.RedoLastAction
+	}
+	switch {
+	case len(to) > 0 && to[len(to)-1].ID == a.ID:
+		// CancelUndo didn't take the step back; the store is already there
+		return true
+	case len(from) > 0 && from[len(from)-1].ID == a.ID:
+		_, _ = step()
+		return true
+	}
+	return false
+}
+
+// applyAction sets the store to the state after an action, or with forward
+// false the state before it. Cards no longer on the board are skipped.
+func (s *Store) applyAction(a Action, forward bool) {
+	switch a.Kind {
+	case ActionMove:
+		if a.Field.ID != "" && (s.groupField == nil || s.groupField.ID != a.Field.ID) {
+			// Made under another grouping field, so it sets that field's value
+			to := a.From
+			if forward {
+				to = a.To
+			}
+			var v *domain.FieldValue
+			if to != "" {
+				v = &domain.FieldValue{ID: to}
+			}
+			s.setFieldValue(a.ItemID, a.Field, v)
+			break
+		}
+		if card, ok := s.cards[a.ItemID]; ok {
+			if forward {
+				s.setGroupOption(card, a.From, a.To)
+			} else {
+				s.setGroupOption(card, a.To, a.From)
+			}
+		}
+	case ActionSetField:
+		v := a.Before
+		if forward {
+			v = a.After
+		}
+		s.setFieldValue(a.ItemID, a.Field, v)
+	case ActionArchive:
+		if forward {
+			s.RemoveCards(a.ItemIDs())
+			return
+		}
+		for i := range a.Cards {
+			card := a.Cards[i]
+			s.cards[card.ItemID] = &card
+			s.order[card.ItemID] = a.positions[i]
+		}
+	}
+	s.rebuildColumns()
+}
+
+// SetFieldValue records a single-select value on a card, moving it when the
+// field is the grouping field. Values of other types aren't kept on cards.
+func (s *Store) SetFieldValue(itemID string, field domain.FieldDef, v domain.FieldValue) {
+	value := &v
+	if v.ID == "" {
+		value = nil
+	}
+	s.setFieldValue(itemID, field, value)
+	s.rebuildColumns()
+}
+
+// setFieldValue sets or, for a nil value, clears a card's single-select value
+func (s *Store) setFieldValue(itemID string, field domain.FieldDef, v *domain.FieldValue) {
+	card, ok := s.cards[itemID]
+	if !ok || field.Type != domain.FieldTypeSingleSelect {
+		return
+	}
+	optionID := ""
+	if v != nil {
+		optionID = v.ID
+	}
+
+	if s.groupField != nil && s.groupField.ID == field.ID {
+		card.GroupOptionID = optionID
+		return
+	}
+	if optionID == "" {
+		delete(card.FieldValues, field.Name)
+		return
+	}
+	if card.FieldValues == nil {
+		card.FieldValues = make(map[string]string)
+	}
+	card.FieldValues[field.Name] = optionID
+}
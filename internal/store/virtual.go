@@ -0,0 +1,208 @@
+package store
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/h0rv/ghp/internal/domain"
+)
+
+// IDs of the virtual fields, which group the board by card data rather than
+// by a project field
+const (
+	AssigneeFieldID = "_assignee_"
+	RepoFieldID     = "_repository_"
+)
+
+// ErrFixedGroup indicates a move between columns of a virtual field whose
+// values can't be changed from the board, such as repositories.
+var ErrFixedGroup = errors.New("cards can't move between these columns")
+
+// grouping is how a virtual field sorts cards into columns
+type grouping struct {
+	field   domain.FieldDef
+	aliases []string // Other names --group-field accepts, ignoring case
+	noValue string   // Name of the column for cards without a value
+
+	// values returns the column keys a card has, the one it's grouped
+	// under first
+	values func(card *domain.Card) []string
+
+	// move hands a card from one value to another, nil when it can't move
+	move func(card *domain.Card, from, to string)
+
+	viewerFirst bool // The viewer's column comes before the others
+}
+
+// groupings are the virtual fields the board can be grouped by
+var groupings = []grouping{
+	{
+		field:       domain.FieldDef{ID: AssigneeFieldID, Name: "Assignee", Type: domain.FieldTypeSingleSelect},
+		aliases:     []string{"assignees"},
+		noValue:     "Unassigned",
+		values:      func(card *domain.Card) []string { return card.Assignees },
+		move:        reassign,
+		viewerFirst: true,
+	},
+	{
+		field:   domain.FieldDef{ID: RepoFieldID, Name: "Repository", Type: domain.FieldTypeSingleSelect},
+		aliases: []string{"repo", "repositories"},
+		noValue: "No Repository",
+		values: func(card *domain.Card) []string {
+			if card.Repo == "" {
+				return nil
+			}
+			return []string{card.Repo}
+		},
+	},
+}
+
+// groupingOf returns the grouping of a virtual field, nil for project fields
+func groupingOf(f *domain.FieldDef) *grouping {
+	if f == nil {
+		return nil
+	}
+	for i := range groupings {
+		if groupings[i].field.ID == f.ID {
+			return &groupings[i]
+		}
+	}
+	return nil
+}
+
+// findGrouping returns the virtual field named by nameOrID, nil for none
+func findGrouping(nameOrID string) *grouping {
+	for i := range groupings {
+		g := &groupings[i]
+		if g.field.ID == nameOrID || strings.EqualFold(g.field.Name, nameOrID) ||
+			slices.ContainsFunc(g.aliases, func(a string) bool { return strings.EqualFold(a, nameOrID) }) {
+			return g
+		}
+	}
+	return nil
+}
+
+// AssigneeField returns the virtual field with a column per assignee of the
+// stored cards. Cards are grouped under their first assignee.
+func AssigneeField() *domain.FieldDef {
+	return virtualField(AssigneeFieldID)
+}
+
+// RepoField returns the virtual field with a column per repository of the
+// stored cards. Drafts, which have none, get a column of their own.
+func RepoField() *domain.FieldDef {
+	return virtualField(RepoFieldID)
+}
+
+// virtualField returns a copy of the virtual field with the ID, whose
+// options the store may fill in
+func virtualField(id string) *domain.FieldDef {
+	f := groupingOf(&domain.FieldDef{ID: id}).field
+	return &f
+}
+
+// VirtualFields returns the virtual fields, for offering alongside a
+// project's single-select fields.
+func VirtualFields() []domain.FieldDef {
+	fields := make([]domain.FieldDef, len(groupings))
+	for i, g := range groupings {
+		fields[i] = g.field
+	}
+	return fields
+}
+
+// IsVirtualField reports whether f is a virtual field.
+func IsVirtualField(f *domain.FieldDef) bool {
+	return groupingOf(f) != nil
+}
+
+// IsAssigneeField reports whether f is the virtual assignee field.
+func IsAssigneeField(f *domain.FieldDef) bool {
+	return f != nil && f.ID == AssigneeFieldID
+}
+
+// Movable reports whether cards can be moved between f's columns.
+func Movable(f *domain.FieldDef) bool {
+	g := groupingOf(f)
+	return g == nil || g.move != nil
+}
+
+// NoValueName returns the name of f's column for cards without a value.
+func NoValueName(f *domain.FieldDef) string {
+	if g := groupingOf(f); g != nil {
+		return g.noValue
+	}
+	return "No Status"
+}
+
+// groupKey returns the column key a card is grouped under by g, "" for none
+func (g *grouping) groupKey(card *domain.Card) string {
+	if values := g.values(card); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// refreshVirtualOptions adds every value of the stored cards, not only the
+// ones they're grouped under, to the virtual field's options, sorted by name
+// (the viewer first, for assignees). Options are kept when their last card
+// moves away, so the column stays a move target.
+func (s *Store) refreshVirtualOptions(g *grouping) {
+	seen := make(map[string]bool)
+	var values []string
+	for _, opt := range s.groupField.Options {
+		seen[opt.ID] = true
+		values = append(values, opt.ID)
+	}
+	for _, card := range s.cards {
+		for _, v := range g.values(card) {
+			if !seen[v] {
+				seen[v] = true
+				values = append(values, v)
+			}
+		}
+	}
+	slices.SortFunc(values, func(a, b string) int {
+		if g.viewerFirst && (a == s.viewerLogin) != (b == s.viewerLogin) {
+			if a == s.viewerLogin {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+
+	s.groupField.Options = make([]domain.Option, len(values))
+	for i, v := range values {
+		s.groupField.Options[i] = domain.Option{ID: v, Name: v, Order: i}
+	}
+}
+
+// setGroupOption moves a card from one option to another. Grouped by a
+// virtual field, the card's data changes instead, and its column follows.
+func (s *Store) setGroupOption(card *domain.Card, from, to string) {
+	g := groupingOf(s.groupField)
+	if g == nil {
+		card.GroupOptionID = to
+		return
+	}
+	if g.move != nil {
+		g.move(card, from, to)
+	}
+	card.GroupOptionID = g.groupKey(card)
+}
+
+// reassign hands a card from one assignee to another, keeping the others
+func reassign(card *domain.Card, from, to string) {
+	assignees := []string{}
+	if to != "" {
+		assignees = append(assignees, to)
+	}
+	for _, login := range card.Assignees {
+		if login != from && login != to {
+			assignees = append(assignees, login)
+		}
+	}
+	card.Assignees = assignees
+}
@@ -0,0 +1,15 @@
+package suggest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClosest(t *testing.T) {
+	candidates := []string{"Status", "Priority", "Iteration"}
+	assert.Equal(t, "Status", Closest("statsu", candidates), "Ignores case and small typos")
+	assert.Equal(t, "Priority", Closest("Priorty", candidates))
+	assert.Equal(t, "", Closest("Owner", candidates), "Nothing close enough")
+	assert.Equal(t, "", Closest("Status", nil))
+}
@@ -0,0 +1,136 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxLogEntries is how many entries the action log keeps
+const maxLogEntries = 500
+
+// logKind says how an action log entry turned out
+type logKind int
+
+const (
+	logSent   logKind = iota // A mutation GitHub accepted
+	logFailed                // A mutation or request that failed
+	logNote                  // Any other result, e.g. "Archived 3 items"
+)
+
+// logEntry is one line of the action log
+type logEntry struct {
+	at   time.Time
+	kind logKind
+	text string
+	err  error
+}
+
+// actionLog is the session's record of mutations and API results, oldest
+// first, so they can be read after their toasts are gone. It is shared by
+// pointer between the app's boards and detail views and only touched from Update.
+type actionLog struct {
+	entries []logEntry
+}
+
+// add appends an entry, dropping the oldest once the log is full
+func (l *actionLog) add(kind logKind, text string, err error) {
+	if l == nil {
+		return
+	}
+	l.entries = append(l.entries, logEntry{at: time.Now(), kind: kind, text: text, err: err})
+	if len(l.entries) > maxLogEntries {
+		l.entries = l.entries[len(l.entries)-maxLogEntries:]
+	}
+}
+
+// logToasts records toasts raised by a result arriving (not by a key press)
+// that weren't showing before it. Outbox results are logged by the outbox.
+func (m BoardModel) logToasts(msg tea.Msg, info, errText string) {
+	switch msg.(type) {
+	case tea.KeyMsg, outboxResultMsg:
+		return
+	}
+	if m.errorToast != "" && m.errorToast != errText {
+		m.actionLog.add(logFailed, m.errorToast, nil)
+	}
+	if m.infoToast != "" && m.infoToast != info {
+		m.actionLog.add(logNote, m.infoToast, nil)
+	}
+}
+
+// handleActionLogKey handles keys in the action log panel
+func (m BoardModel) handleActionLogKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "H", "q", "esc":
+		m.showLog = false
+	case "k", "up":
+		if m.logScroll < len(m.actionLog.entries)-1 {
+			m.logScroll++
+		}
+	case "j", "down":
+		if m.logScroll > 0 {
+			m.logScroll--
+		}
+	case "G":
+		m.logScroll = 0
+	}
+	return m, nil
+}
+
+// renderActionLog renders the newest action log entries that fit in height,
+// or older ones after scrolling up
+func (m BoardModel) renderActionLog(width, height int) string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Action log"))
+	b.WriteString("\n\n")
+
+	var entries []logEntry
+	if m.actionLog != nil {
+		entries = m.actionLog.entries
+	}
+	if len(entries) == 0 {
+		b.WriteString(dimStyle.Render("Nothing has happened yet this session."))
+		return HelpOverlayStyle.Render(b.String())
+	}
+
+	// Entries with an error take two lines; fill the panel from the newest
+	end := len(entries) - min(m.logScroll, len(entries)-1)
+	start, lines := end, 0
+	for start > 0 {
+		n := 1
+		if entries[start-1].err != nil {
+			n = 2
+		}
+		if lines+n > max(height-8, 1) {
+			break
+		}
+		lines += n
+		start--
+	}
+
+	textWidth := max(width-20, 10)
+	for _, e := range entries[start:end] {
+		status := dimStyle.Render("•")
+		switch e.kind {
+		case logSent:
+			status = passStyle.Render("✓")
+		case logFailed:
+			status = failStyle.Render("✗")
+		}
+		b.WriteString(fmt.Sprintf("%s %s %s\n", dimStyle.Render(e.at.Format("15:04:05")), status, truncateLine(e.text, textWidth)))
+		if e.err != nil {
+			b.WriteString("           " + dimStyle.Render(truncateLine(e.err.Error(), textWidth)) + "\n")
+		}
+	}
+
+	b.WriteString("\n")
+	hint := "k/j older/newer · esc close"
+	if start > 0 || end < len(entries) {
+		hint = fmt.Sprintf("%d-%d of %d · %s", start+1, end, len(entries), hint)
+	}
+	b.WriteString(dimStyle.Render(hint))
+	return HelpOverlayStyle.Render(b.String())
+}
@@ -2,12 +2,19 @@ package tui
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/config"
 	"github.com/h0rv/ghp/internal/domain"
 	"github.com/h0rv/ghp/internal/gh"
+	"github.com/h0rv/ghp/internal/session"
 	"github.com/h0rv/ghp/internal/store"
+	"github.com/h0rv/ghp/internal/workspace"
 )
 
 // AppScreen represents the different screens in the application flow.
@@ -20,6 +27,7 @@ const (
 	ScreenFieldPicker
 	ScreenBoard
 	ScreenDetail
+	ScreenCompare
 )
 
 // AppModel is the root Bubble Tea model that manages screen transitions.
@@ -51,30 +59,283 @@ type AppModel struct {
 
 	// Cached models to preserve state across screen transitions
 	boardModel *BoardModel
+
+	// Lists reused by the in-board project and owner switchers
+	owners          []gh.Owner                  // Viewer and their organizations
+	projectsByOwner map[string][]domain.Project // Owner login -> projects
+
+	// Optional session recorder for board state transitions
+	recorder *session.Recorder
+
+	// Mutations and API results of the whole session, shared by its boards
+	actionLog *actionLog
+
+	// Disable spinners in favor of static loading text
+	reducedMotion bool
+
+	// Board filter ignores diacritics
+	foldDiacritics bool
+
+	// Workspace whose view settings are applied to the board
+	workspace *workspace.Workspace
+
+	// Initial team filter for the board
+	team string
+
+	// Limits on how many items the board loads
+	budget gh.FetchBudget
+
+	// Age at which the board dims cards, 0 to never dim
+	staleAfter time.Duration
+
+	// Header status segments, nil for the default layout
+	statusSegments []string
+
+	// Board accent colors by project ("owner/number")
+	accents map[string]string
+
+	// Named filter presets offered by the board's preset menu
+	presets []config.Preset
+
+	// Local templates offered by the board's new item form
+	templates []config.Template
+
+	// Interval of the board's background refreshes, 0 for none
+	autoRefresh time.Duration
+
+	// Column width bounds, 0 for the defaults
+	minColumn, maxColumn int
+
+	// Columns show a scrollbar instead of "more" lines
+	scrollbar bool
+
+	// Grouping field (name or ID) the board toggles to and from with F
+	altGroupField string
+
+	// Comments fetched ahead of opening the detail view
+	prefetcher *commentPrefetcher
+
+	// The flagged project's first page of items, fetched alongside it
+	firstPage *firstPage
+
+	// Detail view shows the board summary strip (toggled in the detail view)
+	boardStrip bool
+
+	// Detail view shows raw text instead of rendered markdown
+	rawMarkdown bool
+
+	// The project picker is choosing a project to compare with the board
+	comparing bool
+
+	// Repository ("owner/name") the working directory is a clone of, and the
+	// projects it is linked to, listed first in the project picker
+	repo   string
+	linked []domain.Project
+
+	// The open board was shown from the cache before the project loaded
+	fromCache bool
+
+	// The project and field flags name the last board rather than the user's
+	// choice, so the pickers stand in when they no longer exist
+	resuming bool
 }
 
 // NewAppModel creates a new app model with optional CLI flag values.
 // Pass empty string or 0 to skip pre-filling.
 func NewAppModel(client *gh.Client, store *store.Store, ctx context.Context, ownerFlag string, projectFlag int, groupFieldFlag string) AppModel {
 	return AppModel{
-		client:         client,
-		store:          store,
-		ctx:            ctx,
-		ownerFlag:      ownerFlag,
-		projectFlag:    projectFlag,
-		groupFieldFlag: groupFieldFlag,
-		currentScreen:  ScreenLoading,
-		loadingMsg:     "Connecting to GitHub...",
+		client:          client,
+		store:           store,
+		ctx:             ctx,
+		ownerFlag:       ownerFlag,
+		ownerLogin:      ownerFlag,
+		projectFlag:     projectFlag,
+		groupFieldFlag:  groupFieldFlag,
+		currentScreen:   ScreenLoading,
+		loadingMsg:      "Connecting to GitHub...",
+		projectsByOwner: make(map[string][]domain.Project),
+		prefetcher:      newCommentPrefetcher(prefetchCacheSize),
+		firstPage:       newFirstPage(),
+		budget:          gh.DefaultFetchBudget(),
+		actionLog:       &actionLog{},
 	}
 }
 
+// WithRecorder returns a copy of the app that records board state transitions to r.
+func (m AppModel) WithRecorder(r *session.Recorder) AppModel {
+	m.recorder = r
+	return m
+}
+
+// WithReducedMotion returns a copy of the app with spinners replaced by static text.
+func (m AppModel) WithReducedMotion(on bool) AppModel {
+	m.reducedMotion = on
+	return m
+}
+
+// WithScrollbar returns a copy of the app whose columns show their scroll
+// position as a scrollbar instead of "↑/↓ N more" lines.
+func (m AppModel) WithScrollbar(on bool) AppModel {
+	m.scrollbar = on
+	return m
+}
+
+// WithDiacriticFolding returns a copy of the app whose board filter ignores diacritics.
+func (m AppModel) WithDiacriticFolding(on bool) AppModel {
+	m.foldDiacritics = on
+	return m
+}
+
+// WithWorkspace returns a copy of the app that applies ws's view settings to the board.
+// The caller is responsible for passing the workspace's owner, project, and field as flags.
+func (m AppModel) WithWorkspace(ws *workspace.Workspace) AppModel {
+	m.workspace = ws
+	return m
+}
+
+// Close releases what the session holds on disk, such as its presence file.
+// Call it after the program exits.
+func (m AppModel) Close() {
+	if m.boardModel != nil {
+		m.boardModel.leavePresence()
+	}
+	if compare, ok := m.currentModel.(CompareModel); ok {
+		compare.panes[1].leavePresence()
+	}
+}
+
+// WithFetchBudget returns a copy of the app whose board stops loading items once budget is spent.
+func (m AppModel) WithFetchBudget(budget gh.FetchBudget) AppModel {
+	m.budget = budget
+	return m
+}
+
+// WithStaleAfter returns a copy of the app whose board dims cards not updated for d.
+func (m AppModel) WithStaleAfter(d time.Duration) AppModel {
+	m.staleAfter = d
+	return m
+}
+
+// WithAutoRefresh returns a copy of the app whose board refetches items every
+// interval and highlights the cards other people changed. 0 turns it off.
+func (m AppModel) WithAutoRefresh(interval time.Duration) AppModel {
+	m.autoRefresh = interval
+	return m
+}
+
+// WithAltGroupField returns a copy of the app whose board switches between
+// its grouping field and the one named by nameOrID with a single key.
+func (m AppModel) WithAltGroupField(nameOrID string) AppModel {
+	m.altGroupField = nameOrID
+	return m
+}
+
+// WithStatusBar returns a copy of the app whose board header shows the named
+// status segments (see StatusSegments) in order. Empty keeps the default.
+func (m AppModel) WithStatusBar(segments []string) AppModel {
+	m.statusSegments = segments
+	return m
+}
+
+// WithAccents returns a copy of the app whose boards use a project's color
+// from accents ("owner/number" -> ANSI number or "#rrggbb") for the selected
+// column border and title.
+func (m AppModel) WithAccents(accents map[string]string) AppModel {
+	m.accents = accents
+	return m
+}
+
+// WithColumnWidths returns a copy of the app whose board columns are between
+// minWidth and maxWidth wide; 0 keeps a default.
+func (m AppModel) WithColumnWidths(minWidth, maxWidth int) AppModel {
+	m.minColumn, m.maxColumn = minWidth, maxWidth
+	return m
+}
+
+// WithPresets returns a copy of the app whose boards offer presets in their
+// preset menu.
+func (m AppModel) WithPresets(presets []config.Preset) AppModel {
+	m.presets = presets
+	return m
+}
+
+// WithTemplates returns a copy of the app whose boards offer templates in
+// the new item form, ahead of the chosen repository's own.
+func (m AppModel) WithTemplates(templates []config.Template) AppModel {
+	m.templates = templates
+	return m
+}
+
+// WithRepo returns a copy of the app that, when no owner is given, starts
+// with the projects repo ("owner/name") is linked to rather than the owner
+// picker, and lists them first whenever it shows the repository owner's
+// projects.
+func (m AppModel) WithRepo(repo string) AppModel {
+	m.repo = repo
+	return m
+}
+
+// WithResume returns a copy of the app that treats its project and group
+// field as the last board used. A project that no longer loads falls back to
+// the owner's project picker, and a missing field to the usual field choice.
+func (m AppModel) WithResume() AppModel {
+	m.resuming = true
+	return m
+}
+
+// CurrentBoard returns the project and grouping field of the open board,
+// to be resumed next time. ok is false before a board has opened.
+func (m AppModel) CurrentBoard() (owner string, number int, groupField string, ok bool) {
+	if m.boardModel == nil {
+		return "", 0, "", false
+	}
+	project := m.boardModel.store.GetProject()
+	field := m.boardModel.store.GetGroupField()
+	if project == nil || field == nil {
+		return "", 0, "", false
+	}
+	return project.Owner, project.Number, field.Name, true
+}
+
+// WithTeam returns a copy of the app whose board starts filtered to a team ("slug" or "org/slug").
+func (m AppModel) WithTeam(team string) AppModel {
+	m.team = team
+	return m
+}
+
 // Init initializes the app model.
 func (m AppModel) Init() tea.Cmd {
+	// With both owner and project known, load the project, its fields, and
+	// the first page of items directly while the owner is resolved for the
+	// switchers in parallel. The page is grouped by the flagged field, or by
+	// Status, which the board picks when there is one.
+	if m.ownerFlag != "" && m.projectFlag > 0 {
+		fieldName := m.groupFieldFlag
+		if fieldName == "" {
+			fieldName = "Status"
+		}
+		pageSize := m.budget.NextPageSize(0, 0)
+		if pageSize == 0 {
+			pageSize = m.budget.Unlimited().NextPageSize(0, 0)
+		}
+		return tea.Batch(
+			m.resolveOwner(m.ownerFlag),
+			m.loadProjectByNumber(),
+			m.firstPage.fetch(m.ctx, m.client, m.ownerFlag, m.projectFlag, fieldName, pageSize),
+			loadCachedBoard(m.ownerFlag, m.projectFlag),
+		)
+	}
+
 	// If owner flag is provided, skip owner prompt and resolve immediately
 	if m.ownerFlag != "" {
 		return m.resolveOwner(m.ownerFlag)
 	}
 
+	// Inside a clone, the repository's projects come first
+	if m.repo != "" {
+		return m.listRepoProjects()
+	}
+
 	// Otherwise, fetch available owners (viewer + orgs)
 	return m.fetchOwners()
 }
@@ -83,19 +344,82 @@ func (m AppModel) Init() tea.Cmd {
 func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// The SSO screen replaces whatever screen failed
+		if url, ok := m.ssoURL(m.err); ok {
+			return m.handleSSOKey(msg, url)
+		}
+
 		// Global quit handler
 		if msg.String() == "ctrl+c" && m.currentScreen != ScreenBoard {
 			return m, tea.Quit
 		}
 
 	case ErrorMsg:
+		// A board shown from the cache stays up, read-only
+		if m.fromCache && m.boardModel != nil {
+			model, cmd := m.boardModel.Update(offlineMsg{err: msg.Err})
+			board := model.(BoardModel)
+			m.boardModel = &board
+			if m.currentScreen == ScreenBoard {
+				m.currentModel = m.boardModel
+			}
+			return m, cmd
+		}
 		m.err = msg.Err
 		return m, nil
 
+	case cachedBoardMsg:
+		if !(&m).fillFromCache(msg.entry) {
+			return m, nil
+		}
+		m.fromCache = true
+		board := m.newBoard(m.store)
+		board.cachedAt = msg.entry.FetchedAt
+		if m.err != nil {
+			// GitHub was already found unreachable
+			board.offline = true
+			board.errorToast = fmt.Sprintf("Offline: %v", m.err)
+			m.err = nil
+		}
+		return m.showBoard(board)
+
+	case resumeFailedMsg:
+		// The last board is gone; pick another of the owner's projects
+		m.resuming = false
+		m.projectFlag = 0
+		m.groupFieldFlag = ""
+		if m.ownerID == "" {
+			// Listing starts once the owner is resolved
+			return m, nil
+		}
+		m.loadingMsg = fmt.Sprintf("Loading projects for %s...", m.ownerLogin)
+		return m, m.listProjects()
+
 	case QuitMsg:
 		return m, tea.Quit
 
+	case PickerCancelledMsg:
+		// Backing out of a picker opened from the board returns to it
+		if m.boardModel == nil {
+			return m, tea.Quit
+		}
+		m.comparing = false
+		m.currentScreen = ScreenBoard
+		m.currentModel = m.boardModel
+		return m, tea.WindowSize()
+
+	case repoProjectsLoadedMsg:
+		// Without linked projects, pick an owner as usual
+		if len(msg.projects) == 0 {
+			return m, m.fetchOwners()
+		}
+		m.linked = msg.projects
+		m.ownerLogin, _, _ = strings.Cut(m.repo, "/")
+		m.loadingMsg = fmt.Sprintf("Resolving %s...", m.ownerLogin)
+		return m, m.resolveOwner(m.ownerLogin)
+
 	case ownersLoadedMsg:
+		m.owners = msg.owners
 		// Store viewer login for "assigned to me" filtering
 		if len(msg.owners) > 0 {
 			m.store.SetViewerLogin(msg.owners[0].Login)
@@ -113,6 +437,9 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.OwnerID != "" {
 			m.ownerType = msg.OwnerType
 			m.ownerID = msg.OwnerID
+			if projects, ok := m.projectsByOwner[m.ownerLogin]; ok {
+				return m, func() tea.Msg { return projectsLoadedMsg{projects: projects} }
+			}
 			m.loadingMsg = fmt.Sprintf("Loading projects for %s...", m.ownerLogin)
 			m.currentModel = nil
 			return m, m.listProjects()
@@ -126,13 +453,25 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Owner resolved, now list projects
 		m.ownerType = msg.ownerType
 		m.ownerID = msg.ownerID
+		if len(msg.owners) > 0 {
+			m.owners = msg.owners
+		}
+		if m.projectFlag > 0 {
+			// The project is being loaded directly (see Init)
+			return m, nil
+		}
+		if projects, ok := m.projectsByOwner[m.ownerLogin]; ok {
+			return m, func() tea.Msg { return projectsLoadedMsg{projects: projects} }
+		}
 		m.loadingMsg = fmt.Sprintf("Loading projects for %s...", m.ownerLogin)
 		return m, m.listProjects()
 
 	case projectsLoadedMsg:
 		// Projects loaded
+		m.projectsByOwner[m.ownerLogin] = msg.projects
+
 		// If project flag is provided, find and select it
-		if m.projectFlag > 0 {
+		if m.projectFlag > 0 && !m.comparing {
 			for _, proj := range msg.projects {
 				if proj.Number == m.projectFlag {
 					m.project = &proj
@@ -149,20 +488,51 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Show project picker
 		m.currentScreen = ScreenProjectPicker
 		pickerModel := NewProjectPickerModel(msg.projects)
+		if owner, _, _ := strings.Cut(m.repo, "/"); strings.EqualFold(owner, m.ownerLogin) {
+			pickerModel = newLinkedProjectPicker(m.repo, m.linked, msg.projects)
+		}
 		m.currentModel = pickerModel
 		return m, pickerModel.Init()
 
+	case projectResolvedMsg:
+		// The cached board keeps going; it loads its own items
+		if m.fromCache && m.boardModel != nil {
+			m.project = &msg.project
+			m.store.SetProject(&msg.project)
+			m.fields = msg.fields
+			m.store.SetFields(msg.fields)
+			return m, nil
+		}
+		// Project and fields fetched directly by number
+		m.project = &msg.project
+		m.store.SetProject(&msg.project)
+		return m.Update(fieldsLoadedMsg{fields: msg.fields})
+
 	case ProjectSelectedMsg:
-		// Project selected, load fields
+		if m.comparing {
+			m.loadingMsg = fmt.Sprintf("Loading fields for %s...", msg.Project.Title)
+			m.currentModel = nil
+			return m, m.loadCompareProject(msg.Project)
+		}
+		// Project selected, load fields. Drop any previous project's items first.
 		m.project = &msg.Project
+		m.boardModel = nil // The previous board no longer matches the store
+		m.fromCache = false
+		m.store.Reset()
 		m.store.SetProject(&msg.Project)
 		m.loadingMsg = fmt.Sprintf("Loading fields for %s...", msg.Project.Title)
 		m.currentModel = nil
-		return m, m.loadFields()
+		return m, tea.Batch(m.loadFields(), loadCachedBoard(msg.Project.Owner, msg.Project.Number))
 
 	case fieldsLoadedMsg:
+		if m.fromCache && m.boardModel != nil {
+			m.fields = msg.fields
+			m.store.SetFields(msg.fields)
+			return m, nil
+		}
 		// Fields loaded, run field selection heuristic
 		m.fields = msg.fields
+		m.store.SetFields(msg.fields)
 
 		// Convert to pointer slice for SelectGroupField
 		fieldPtrs := make([]*domain.FieldDef, len(m.fields))
@@ -178,16 +548,18 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// If group field flag is provided, find and use it
 		if m.groupFieldFlag != "" {
-			for i := range m.fields {
-				if m.fields[i].Name == m.groupFieldFlag {
-					m.groupField = &m.fields[i]
-					m.store.SetGroupField(&m.fields[i])
-					return m, m.loadItemsAndShowBoard()
-				}
+			field, err := store.FindGroupField(m.fields, m.groupFieldFlag)
+			if err == nil {
+				m.groupField = field
+				m.store.SetGroupField(field)
+				return m, m.loadItemsAndShowBoard()
+			}
+			// A resumed board's field may have been renamed since; choose
+			// one as usual instead.
+			if !m.resuming {
+				m.err = err
+				return m, nil
 			}
-			// Field name not found
-			m.err = fmt.Errorf("field '%s' not found in project", m.groupFieldFlag)
-			return m, nil
 		}
 
 		// Auto-selected (Status field or only one option)
@@ -202,6 +574,7 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		for i, c := range candidates {
 			candidateValues[i] = *c
 		}
+		candidateValues = append(candidateValues, store.VirtualFields()...)
 
 		m.currentScreen = ScreenFieldPicker
 		pickerModel := NewGroupFieldPickerModel(candidateValues)
@@ -209,19 +582,82 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, pickerModel.Init()
 
 	case FieldSelectedMsg:
-		// Field selected, load items and show board
+		// Field selected, load items and show board. Items loaded for another
+		// field would land in the wrong columns (or look like removed options).
 		m.groupField = &msg.Field
+		m.fromCache = false
+		m.store.Clear()
 		m.store.SetGroupField(&msg.Field)
 		m.currentModel = nil
 		return m, m.loadItemsAndShowBoard()
 
 	case boardReadyMsg:
+		// A cached board already open is loading the items itself
+		if m.fromCache && m.boardModel != nil {
+			return m, nil
+		}
 		// Items loaded, show board
+		return m.showBoard(m.newBoard(m.store))
+
+	case compareMsg:
+		// Pick the project to show beside the board; flags no longer pick it
+		m.comparing = true
+		if projects, ok := m.projectsByOwner[m.ownerLogin]; ok {
+			return m, func() tea.Msg { return projectsLoadedMsg{projects: projects} }
+		}
+		m.currentScreen = ScreenLoading
+		m.currentModel = nil
+		m.loadingMsg = fmt.Sprintf("Loading projects for %s...", m.ownerLogin)
+		return m, m.listProjects()
+
+	case compareReadyMsg:
+		m.comparing = false
+		compare := newCompareModel(*m.boardModel, m.newBoard(msg.store))
+		m.currentScreen = ScreenCompare
+		m.currentModel = compare
+		return m, compare.Init()
+
+	case compareErrorMsg:
+		m.comparing = false
+		m.boardModel.errorToast = fmt.Sprintf("Compare failed: %v", msg.err)
+		m.currentScreen = ScreenBoard
+		m.currentModel = m.boardModel
+		return m, tea.WindowSize()
+
+	case closeCompareMsg:
+		msg.right.leavePresence()
+		m.boardModel = &msg.left
 		m.currentScreen = ScreenBoard
-		boardModel := NewBoardModel(m.store, m.client, m.ctx)
-		m.boardModel = &boardModel
 		m.currentModel = m.boardModel
-		return m, boardModel.Init()
+		return m, tea.WindowSize()
+
+	case switchProjectMsg:
+		// Switching boards starts fresh: earlier flags and workspace described the old project
+		m.projectFlag = 0
+		m.groupFieldFlag = ""
+		m.workspace = nil
+		m.team = ""
+		if projects, ok := m.projectsByOwner[m.ownerLogin]; ok {
+			return m, func() tea.Msg { return projectsLoadedMsg{projects: projects} }
+		}
+		m.currentScreen = ScreenLoading
+		m.currentModel = nil
+		m.loadingMsg = fmt.Sprintf("Loading projects for %s...", m.ownerLogin)
+		return m, m.listProjects()
+
+	case switchOwnerMsg:
+		// Like switchProjectMsg, the new owner's project must be picked explicitly
+		m.projectFlag = 0
+		m.groupFieldFlag = ""
+		m.workspace = nil
+		m.team = ""
+		if m.owners != nil {
+			return m, func() tea.Msg { return ownersLoadedMsg{owners: m.owners} }
+		}
+		m.currentScreen = ScreenLoading
+		m.currentModel = nil
+		m.loadingMsg = "Loading owners..."
+		return m, m.fetchOwners()
 
 	case changeGroupFieldMsg:
 		// User wants to change grouping field from board view
@@ -232,10 +668,7 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
-		if len(fieldValues) == 0 {
-			m.err = fmt.Errorf("no SINGLE_SELECT fields available")
-			return m, nil
-		}
+		fieldValues = append(fieldValues, store.VirtualFields()...)
 
 		m.currentScreen = ScreenFieldPicker
 		pickerModel := NewGroupFieldPickerModel(fieldValues)
@@ -244,19 +677,66 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case openDetailMsg:
 		// User wants to view card details
-		m.currentScreen = ScreenDetail
-		detailModel := NewDetailModel(msg.card, m.client, m.ctx)
-		m.currentModel = detailModel
-		return m, detailModel.Init()
+		return m.openDetail(msg.card, msg.summary, nil)
+
+	case openLinkedMsg:
+		// Show a linked issue or PR, coming back to this card on close
+		detail, ok := m.currentModel.(DetailModel)
+		if !ok {
+			return m, nil
+		}
+		m.boardStrip, m.rawMarkdown = detail.showSummary, detail.rawMarkdown
+		return m.openDetail(m.linkedCard(msg.item), detail.boardSummary, &detail)
+
+	case detailsLoadedMsg:
+		// Keep details fetched by the detail view; the detail view still gets the message
+		m.store.SetDetails(msg.itemID, msg.details)
+
+	case contentSavedMsg:
+		// Keep a description edited in the detail view for the board
+		m.store.SetBody(msg.card.ItemID, msg.body)
+
+	case fieldValueSavedMsg:
+		// Keep the board in step with single-select changes; the detail
+		// view still gets the message
+		m.applyFieldValue(msg)
 
 	case closeDetailMsg:
-		// Return to board from detail view
+		// Return to board from detail view, remembering the strip and
+		// markdown settings
+		if detail, ok := m.currentModel.(DetailModel); ok {
+			m.boardStrip, m.rawMarkdown = detail.showSummary, detail.rawMarkdown
+			if detail.previous != nil {
+				m.currentModel = *detail.previous
+				return m, tea.WindowSize()
+			}
+		}
 		m.currentScreen = ScreenBoard
 		m.currentModel = m.boardModel
 		// Request window size to ensure proper rendering
 		return m, tea.WindowSize()
 	}
 
+	// Boards opened later offer a preset saved on this one
+	if msg, ok := msg.(presetSavedMsg); ok {
+		m.presets = slices.Clone(m.presets)
+		if i := slices.IndexFunc(m.presets, func(p config.Preset) bool { return strings.EqualFold(p.Name, msg.preset.Name) }); i >= 0 {
+			m.presets[i] = msg.preset
+		} else {
+			m.presets = append(m.presets, msg.preset)
+		}
+	}
+
+	// The board keeps refreshing, heartbeating, following terminal focus,
+	// and settling its changes while the detail view covers it
+	if _, ok := m.currentModel.(DetailModel); ok && m.boardModel != nil && backgroundBoardMsg(msg) {
+		model, cmd := m.boardModel.Update(msg)
+		if board, ok := model.(BoardModel); ok {
+			m.boardModel = &board
+		}
+		return m, cmd
+	}
+
 	// Delegate to current screen's model
 	if m.currentModel != nil {
 		var cmd tea.Cmd
@@ -267,15 +747,35 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.boardModel = &bm
 			}
 		}
+		if compare, ok := m.currentModel.(CompareModel); ok {
+			m.boardModel = &compare.panes[0]
+		}
 		return m, cmd
 	}
 
 	return m, nil
 }
 
+// backgroundBoardMsg reports whether msg belongs to the board even while
+// the detail view is shown: its timers, terminal focus, refreshes, and the
+// results of its changes
+func backgroundBoardMsg(msg tea.Msg) bool {
+	switch msg := msg.(type) {
+	case autoRefreshMsg, revalidatedMsg, offlineMsg, presenceTickMsg, presenceMsg,
+		tea.FocusMsg, tea.BlurMsg, itemsLoadedMsg, itemsErrorMsg:
+		return true
+	case outboxResultMsg:
+		return !msg.detail
+	}
+	return false
+}
+
 // View renders the current screen.
 func (m AppModel) View() string {
 	// Show error if present
+	if url, ok := m.ssoURL(m.err); ok {
+		return renderSSOScreen(url)
+	}
 	if m.err != nil {
 		return ErrorStyle.Render(fmt.Sprintf("Error: %v\n\nPress Ctrl+C to quit", m.err))
 	}
@@ -305,6 +805,7 @@ func (m AppModel) fetchOwners() tea.Cmd {
 func (m AppModel) resolveOwner(login string) tea.Cmd {
 	return func() tea.Msg {
 		// Fetch viewer login for "assigned to me" filtering
+		// (and keep the owner list for the owner switcher)
 		owners, err := m.client.GetViewerAndOrgs(m.ctx)
 		if err == nil && len(owners) > 0 {
 			m.store.SetViewerLogin(owners[0].Login)
@@ -314,7 +815,21 @@ func (m AppModel) resolveOwner(login string) tea.Cmd {
 		if err != nil {
 			return ErrorMsg{Err: fmt.Errorf("failed to resolve owner '%s': %w", login, err)}
 		}
-		return ownerResolvedMsg{ownerType: ownerType, ownerID: ownerID}
+		return ownerResolvedMsg{ownerType: ownerType, ownerID: ownerID, owners: owners}
+	}
+}
+
+// listRepoProjects creates a command to list the projects the working
+// directory's repository is linked to. Failing to is not an error; the
+// owner picker takes over.
+func (m AppModel) listRepoProjects() tea.Cmd {
+	return func() tea.Msg {
+		owner, name, _ := strings.Cut(m.repo, "/")
+		projects, err := m.client.ListRepoProjects(m.ctx, owner, name)
+		if err != nil {
+			return repoProjectsLoadedMsg{}
+		}
+		return repoProjectsLoadedMsg{projects: projects}
 	}
 }
 
@@ -327,13 +842,33 @@ func (m AppModel) listProjects() tea.Cmd {
 		}
 
 		if len(projects) == 0 {
-			return ErrorMsg{Err: fmt.Errorf("no projects found for owner '%s'", m.ownerLogin)}
+			// The owner exists, so another gh account may be the one with access
+			msg := fmt.Sprintf("no projects found for owner '%s'", m.ownerLogin)
+			if others := gh.FindOtherAccounts(m.ctx, m.ownerLogin); len(others) > 0 {
+				msg += "; " + others[0].Hint(m.ownerLogin)
+			}
+			return ErrorMsg{Err: errors.New(msg)}
 		}
 
 		return projectsLoadedMsg{projects: projects}
 	}
 }
 
+// loadProjectByNumber creates a command to load the flagged project and its fields in one request.
+func (m AppModel) loadProjectByNumber() tea.Cmd {
+	return func() tea.Msg {
+		project, fields, err := m.client.GetProjectByNumber(m.ctx, m.ownerFlag, m.projectFlag)
+		var notFound *gh.NotFoundError
+		if errors.As(err, &notFound) && m.resuming {
+			return resumeFailedMsg{}
+		}
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return projectResolvedMsg{project: project, fields: fields}
+	}
+}
+
 // loadFields creates a command to load project fields.
 func (m AppModel) loadFields() tea.Cmd {
 	return func() tea.Msg {
@@ -345,6 +880,70 @@ func (m AppModel) loadFields() tea.Cmd {
 	}
 }
 
+// loadCompareProject creates a command to load a second project's fields
+// into a store of its own, grouped like the board when it can be.
+func (m AppModel) loadCompareProject(project domain.Project) tea.Cmd {
+	groupName := ""
+	if field := m.store.GetGroupField(); field != nil {
+		groupName = field.Name
+	}
+	viewer := m.store.GetViewerLogin()
+	return func() tea.Msg {
+		fields, err := m.client.GetProjectFields(m.ctx, project.ID)
+		if err != nil {
+			return compareErrorMsg{err: err}
+		}
+		groupField := compareGroupField(fields, groupName)
+		if groupField == nil {
+			return compareErrorMsg{err: fmt.Errorf("%s has no single-select field to group by", project.Title)}
+		}
+		s := store.New()
+		s.SetViewerLogin(viewer)
+		s.SetProject(&project)
+		s.SetFields(fields)
+		s.SetGroupField(groupField)
+		return compareReadyMsg{store: s}
+	}
+}
+
+// newBoard creates a board over s with the app's display and loading options.
+func (m AppModel) newBoard(s *store.Store) BoardModel {
+	board := NewBoardModel(s, m.client, m.ctx)
+	board.reducedMotion = m.reducedMotion
+	board.prefetcher = m.prefetcher
+	board.firstPage = m.firstPage
+	board.foldDiacritics = m.foldDiacritics
+	board.budget = m.budget
+	board.staleAfter = m.staleAfter
+	board.statusSegments = m.statusSegments
+	board.accents = m.accents
+	board.presets = slices.Clone(m.presets)
+	board.templates = m.templates
+	board.actionLog = m.actionLog
+	board.outbox.log = m.actionLog
+	board.autoRefresh = m.autoRefresh
+	board.scrollbar = m.scrollbar
+	board.minColumn, board.maxColumn = m.minColumn, m.maxColumn
+	board.altGroupField = m.altGroupField
+	return board
+}
+
+// showBoard switches to a new board with the session's view settings
+func (m AppModel) showBoard(board BoardModel) (tea.Model, tea.Cmd) {
+	m.currentScreen = ScreenBoard
+	board.recorder = m.recorder
+	if m.workspace != nil {
+		board.applyWorkspace(m.workspace)
+	}
+	if m.team != "" {
+		board.teamSlug = m.team
+		board.teamInput.SetValue(m.team)
+	}
+	m.boardModel = &board
+	m.currentModel = m.boardModel
+	return m, board.Init()
+}
+
 // loadItemsAndShowBoard shows the board immediately and starts background loading.
 func (m AppModel) loadItemsAndShowBoard() tea.Cmd {
 	// Return boardReadyMsg immediately to show the board
@@ -363,15 +962,57 @@ type (
 	ownerResolvedMsg struct {
 		ownerType gh.OwnerType
 		ownerID   string
+		owners    []gh.Owner
 	}
 
 	projectsLoadedMsg struct {
 		projects []domain.Project
 	}
 
+	// repoProjectsLoadedMsg carries the projects the working directory's
+	// repository is linked to
+	repoProjectsLoadedMsg struct {
+		projects []domain.Project
+	}
+
 	fieldsLoadedMsg struct {
 		fields []domain.FieldDef
 	}
 
+	projectResolvedMsg struct {
+		project domain.Project
+		fields  []domain.FieldDef
+	}
+
 	boardReadyMsg struct{}
+
+	// resumeFailedMsg reports that the last board's project no longer loads
+	resumeFailedMsg struct{}
 )
+
+// openDetail shows a card's detail view; previous is the detail view to go
+// back to on close, nil to return to the board
+func (m AppModel) openDetail(card *domain.Card, summary string, previous *DetailModel) (tea.Model, tea.Cmd) {
+	m.currentScreen = ScreenDetail
+	detailModel := NewDetailModel(card, m.client, m.ctx)
+	detailModel.reducedMotion = m.reducedMotion
+	detailModel.boardSummary = summary
+	detailModel.showSummary = m.boardStrip
+	detailModel.rawMarkdown = m.rawMarkdown
+	detailModel.previous = previous
+	detailModel.prefetcher = m.prefetcher
+	if m.boardModel != nil {
+		// Reopening an item doesn't let a comment still being posted go out twice
+		detailModel.guard = m.boardModel.guard
+		detailModel.outbox = m.boardModel.outbox
+	}
+	if body, ok := m.store.GetBody(card.ItemID); ok {
+		detailModel.body, detailModel.bodyLoaded = body, true
+	}
+	if project := m.store.GetProject(); project != nil {
+		detailModel.setProjectFields(project.ID, m.store.GetFields())
+	}
+	detailModel.usePrefetched()
+	m.currentModel = detailModel
+	return m, detailModel.Init()
+}
@@ -0,0 +1,113 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/store"
+)
+
+// archiveModeStyle is the banner style for the archive confirmation
+var archiveModeStyle = lipgloss.NewStyle().
+	Background(lipgloss.Color("160")).
+	Foreground(lipgloss.Color("15")).
+	Padding(0, 1)
+
+// isDoneColumn reports whether a column name marks finished work
+func isDoneColumn(name string) bool {
+	return strings.EqualFold(strings.TrimSpace(name), "done")
+}
+
+// isFinished reports whether a card's issue or PR is closed or merged
+func isFinished(card *domain.Card) bool {
+	return card.State == "CLOSED" || card.State == "MERGED"
+}
+
+// startArchiveDone asks to confirm archiving the closed and merged items
+// shown in the selected Done column, listing them when there are many
+func (m *BoardModel) startArchiveDone() {
+	if len(m.columns) == 0 {
+		return
+	}
+	colID := m.columns[m.selectedColumn]
+	if !isDoneColumn(m.columnNames[colID]) {
+		m.errorToast = "Archive all is only available on the Done column"
+		return
+	}
+
+	var ids []string
+	for _, itemID := range m.filteredCards[colID] {
+		if card, err := m.store.GetCard(itemID); err == nil && isFinished(card) {
+			ids = append(ids, itemID)
+		}
+	}
+	if len(ids) == 0 {
+		m.infoToast = "No closed or merged items to archive"
+		return
+	}
+	if len(ids) > bulkConfirmThreshold {
+		title := fmt.Sprintf("Archive %d closed/merged items from %s", len(ids), m.columnNames[colID])
+		m.confirmBulk(title, ids, func(m *BoardModel) tea.Cmd { return m.archiveItems(ids) })
+		return
+	}
+	m.archiveIDs = ids
+}
+
+// handleArchiveConfirm handles key presses while confirming an archive
+func (m BoardModel) handleArchiveConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y":
+		ids := m.archiveIDs
+		m.archiveIDs = nil
+		return m, m.archiveItems(ids)
+	case "n", "N", "esc", "q":
+		m.archiveIDs = nil
+	}
+	return m, nil
+}
+
+// renderArchiveBanner renders the archive confirmation line
+func (m BoardModel) renderArchiveBanner() string {
+	noun := "items"
+	if len(m.archiveIDs) == 1 {
+		noun = "item"
+	}
+	return archiveModeStyle.Render("ARCHIVE") +
+		fmt.Sprintf(" Archive %d closed/merged %s from %s? y:confirm n:cancel",
+			len(m.archiveIDs), noun, m.columnNames[m.columns[m.selectedColumn]])
+}
+
+// archiveItems archives the items on GitHub
+func (m BoardModel) archiveItems(ids []string) tea.Cmd {
+	project := m.store.GetProject()
+	if project == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		n, err := m.client.ArchiveItems(m.ctx, project.ID, ids)
+		return itemsArchivedMsg{itemIDs: ids[:n], err: err}
+	}
+}
+
+// archiveAction records archived items for undo, while they're still stored
+func (m BoardModel) archiveAction(ids []string) store.Action {
+	a := store.Action{Kind: store.ActionArchive, Label: fmt.Sprintf("Archive %d items", len(ids))}
+	if len(ids) == 1 {
+		a.Label = "Archive 1 item"
+	}
+	for _, id := range ids {
+		if card, err := m.store.GetCard(id); err == nil {
+			a.Cards = append(a.Cards, *card)
+		}
+	}
+	return a
+}
+
+// itemsArchivedMsg reports the items archived; err is set if some were not
+type itemsArchivedMsg struct {
+	itemIDs []string
+	err     error
+}
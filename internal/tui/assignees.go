@@ -0,0 +1,241 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/gh"
+)
+
+// assigneeItem is a user in the assignee picker
+type assigneeItem struct {
+	user     gh.User
+	assigned bool
+}
+
+func (i assigneeItem) FilterValue() string {
+	return i.user.Login + " " + i.user.Name
+}
+
+// assigneeDelegate renders users one per line with a checkbox
+type assigneeDelegate struct{}
+
+func (d assigneeDelegate) Height() int                             { return 1 }
+func (d assigneeDelegate) Spacing() int                            { return 0 }
+func (d assigneeDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+func (d assigneeDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	i, ok := item.(assigneeItem)
+	if !ok {
+		return
+	}
+
+	box := "[ ]"
+	if i.assigned {
+		box = "[x]"
+	}
+	str := box + " " + i.user.Login
+	if i.user.Name != "" {
+		str += dimStyle.Render(" " + i.user.Name)
+	}
+	if index == m.Index() {
+		fmt.Fprint(w, SelectedItemStyle.Render("> "+str))
+	} else {
+		fmt.Fprint(w, NormalItemStyle.Render("  "+str))
+	}
+}
+
+// startAssign opens the assignee picker for the selected card, loading the
+// repository's assignable users
+func (m *BoardModel) startAssign() tea.Cmd {
+	card := m.getSelectedCard()
+	if card == nil {
+		return nil
+	}
+	owner, repo, ok := strings.Cut(card.Repo, "/")
+	if !ok || card.ContentID == "" || !linkable(card) {
+		m.errorToast = "Only issues and pull requests have assignees"
+		return nil
+	}
+
+	m.assigneeCard = card
+	m.assigneesLoading = true
+	client, ctx := m.client, m.ctx
+	return func() tea.Msg {
+		users, err := client.ListAssignableUsers(ctx, owner, repo)
+		if err != nil {
+			return assigneesErrorMsg{err: err}
+		}
+		return assigneesLoadedMsg{card: card, users: users}
+	}
+}
+
+// showAssignees fills the picker, assigned users first
+func (m *BoardModel) showAssignees(card *domain.Card, users []gh.User) {
+	assigned := func(login string) bool {
+		return slices.ContainsFunc(card.Assignees, func(a string) bool { return strings.EqualFold(a, login) })
+	}
+	slices.SortStableFunc(users, func(a, b gh.User) int {
+		switch x, y := assigned(a.Login), assigned(b.Login); {
+		case x && !y:
+			return -1
+		case y && !x:
+			return 1
+		}
+		return strings.Compare(strings.ToLower(a.Login), strings.ToLower(b.Login))
+	})
+
+	items := make([]list.Item, len(users))
+	for i, u := range users {
+		items[i] = assigneeItem{user: u, assigned: assigned(u.Login)}
+	}
+
+	l := list.New(items, assigneeDelegate{}, m.width, m.height)
+	l.Title = fmt.Sprintf("Assignees of %s#%d (enter toggles)", card.Repo, card.Number)
+	l.SetShowStatusBar(false)
+	l.SetShowHelp(false)
+	l.SetFilteringEnabled(true)
+	l.Styles.Title = TitleStyle
+
+	m.assigneePicker = l
+	m.assigneesLoading = false
+}
+
+// handleAssigneePicker handles keys while the assignee picker is open
+func (m BoardModel) handleAssigneePicker(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.assigneesLoading {
+		if msg.String() == "esc" || msg.String() == "q" {
+			m.assigneeCard, m.assigneesLoading = nil, false
+		}
+		return m, nil
+	}
+
+	filtering := m.assigneePicker.SettingFilter()
+	switch msg.String() {
+	case "esc":
+		// Let esc clear an in-progress filter first
+		if !filtering && !m.assigneePicker.IsFiltered() {
+			m.assigneeCard = nil
+			return m, nil
+		}
+	case "enter", " ":
+		if filtering {
+			break
+		}
+		item, ok := m.assigneePicker.SelectedItem().(assigneeItem)
+		if !ok {
+			return m, nil
+		}
+		// The checkbox only flips once the change is on its way
+		cmd := m.setAssigned(m.assigneeCard, item.user, !item.assigned)
+		if cmd == nil {
+			m.errorToast = fmt.Sprintf("%s is still being changed", item.user.Login)
+			return m, nil
+		}
+		item.assigned = !item.assigned
+		m.assigneePicker.SetItem(m.assigneePicker.GlobalIndex(), item)
+		(&m).applyFilter()
+		return m, cmd
+	}
+
+	var cmd tea.Cmd
+	m.assigneePicker, cmd = m.assigneePicker.Update(msg)
+	return m, cmd
+}
+
+// setAssigned adds or removes an assignee, updating the card right away and
+// undoing the change if GitHub refuses it
+func (m BoardModel) setAssigned(card *domain.Card, user gh.User, assign bool) tea.Cmd {
+	key := "assignee:" + card.ItemID + ":" + user.Login
+	ctx, ok := m.guard.begin(m.ctx, key)
+	if !ok {
+		return nil
+	}
+
+	apply := func() error {
+		applyAssignee(card, user.Login, assign)
+		return nil
+	}
+	_ = apply()
+
+	label := fmt.Sprintf("Assign %s to %s", user.Login, cardLabel(card))
+	if !assign {
+		label = fmt.Sprintf("Unassign %s from %s", user.Login, cardLabel(card))
+	}
+	return m.outbox.enqueue(ctx, &outboxEntry{
+		label: label,
+		apply: apply,
+		send: func(ctx context.Context) error {
+			defer m.guard.end(key)
+			if assign {
+				return m.client.AddAssignees(ctx, card.ContentID, []string{user.ID})
+			}
+			return m.client.RemoveAssignees(ctx, card.ContentID, []string{user.ID})
+		},
+		done: func(err error) tea.Msg {
+			if err != nil {
+				return assigneeErrorMsg{card: card, login: user.Login, assigned: assign, err: err}
+			}
+			return assigneeSavedMsg{}
+		},
+	})
+}
+
+// applyAssignee adds or removes login on the card's assignees
+func applyAssignee(card *domain.Card, login string, assign bool) {
+	card.Assignees = slices.DeleteFunc(card.Assignees, func(a string) bool { return strings.EqualFold(a, login) })
+	if assign {
+		card.Assignees = append(card.Assignees, login)
+	}
+}
+
+// rollbackAssignee undoes a refused assignee change on the card and, if it
+// is still open, in the picker
+func (m *BoardModel) rollbackAssignee(msg assigneeErrorMsg) {
+	applyAssignee(msg.card, msg.login, !msg.assigned)
+	m.applyFilter()
+	m.errorToast = fmt.Sprintf("Assignee not changed: %v", msg.err)
+
+	if m.assigneeCard != msg.card || m.assigneesLoading {
+		return
+	}
+	for i, it := range m.assigneePicker.Items() {
+		if item, ok := it.(assigneeItem); ok && item.user.Login == msg.login {
+			item.assigned = !msg.assigned
+			m.assigneePicker.SetItem(i, item)
+		}
+	}
+}
+
+// renderAssigneePicker renders the assignee picker in the board area
+func (m BoardModel) renderAssigneePicker(width, height int) string {
+	if m.assigneesLoading {
+		return loadingText(m.spinner, m.reducedMotion, "Loading assignable users…")
+	}
+	l := m.assigneePicker
+	l.SetSize(width, height)
+	return l.View()
+}
+
+// Message types for the assignee picker
+type (
+	assigneesLoadedMsg struct {
+		card  *domain.Card
+		users []gh.User
+	}
+	assigneesErrorMsg struct{ err error }
+	assigneeSavedMsg  struct{}
+	// assigneeErrorMsg reports a change GitHub refused; assigned is what was
+	// attempted
+	assigneeErrorMsg struct {
+		card     *domain.Card
+		login    string
+		assigned bool
+		err      error
+	}
+)
@@ -0,0 +1,57 @@
+package tui
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/store"
+)
+
+// BenchResult is the mean time of board operations over a benchmark run
+type BenchResult struct {
+	Layout   time.Duration // Building columns and applying filters from scratch
+	Render   time.Duration // Rendering the whole view
+	Filter   time.Duration // Applying a text filter, rendering, and clearing it again
+	Navigate time.Duration // Moving the selection down a card and rendering
+}
+
+// MeasureBoard times the board's hot paths on a loaded store at the given
+// size, averaging over rounds. query is the filter text timed by Filter.
+func MeasureBoard(s *store.Store, width, height, rounds int, query string) BenchResult {
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = width, height
+	board.loading = false
+	rounds = max(rounds, 1)
+
+	var result BenchResult
+	result.Layout = timeRounds(rounds, func() {
+		board.rebuildColumns()
+		board.applyFilter()
+	})
+	result.Render = timeRounds(rounds, func() { _ = board.View() })
+	result.Filter = timeRounds(rounds, func() {
+		board.filterText = query
+		board.applyFilter()
+		_ = board.View()
+		board.filterText = ""
+		board.applyFilter()
+	})
+
+	down := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")}
+	result.Navigate = timeRounds(rounds, func() {
+		model, _ := board.Update(down)
+		board = model.(BoardModel)
+		_ = board.View()
+	})
+	return result
+}
+
+// timeRounds returns the mean duration of fn over rounds calls
+func timeRounds(rounds int, fn func()) time.Duration {
+	start := time.Now()
+	for range rounds {
+		fn()
+	}
+	return time.Since(start) / time.Duration(rounds)
+}
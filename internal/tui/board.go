@@ -3,24 +3,36 @@ package tui
 import (
 	"context"
 	"fmt"
+	"os"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/h0rv/ghp/internal/cache"
+	"github.com/h0rv/ghp/internal/config"
 	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/editor"
+	"github.com/h0rv/ghp/internal/filter"
 	"github.com/h0rv/ghp/internal/gh"
+	"github.com/h0rv/ghp/internal/session"
+	"github.com/h0rv/ghp/internal/stats"
 	"github.com/h0rv/ghp/internal/store"
+	"github.com/h0rv/ghp/internal/uistate"
+	"github.com/h0rv/ghp/internal/workspace"
 	"github.com/pkg/browser"
 )
 
-// Layout constants
+// Layout constants; the column widths are defaults the settings can override
 const (
 	minColumnWidth = 20
 	maxColumnWidth = 35
-	headerLines    = 1  // Single header line with title + status
-	pageJumpSize   = 10 // Number of items to jump with Ctrl+D/U
+	headerLines    = 1 // Single header line with title + status
 )
 
 // Styles for the board view - base styles without width/height (set dynamically)
@@ -36,6 +48,10 @@ var (
 				Foreground(lipgloss.Color("205")).
 				Bold(true)
 
+	staleCardStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("244")).
+			Faint(true)
+
 	dimStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("241"))
 
@@ -49,6 +65,9 @@ var (
 	titleStyle = lipgloss.NewStyle().
 			Bold(true)
 
+	infoStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("34"))
+
 	moveModeStyle = lipgloss.NewStyle().
 			Background(lipgloss.Color("205")).
 			Foreground(lipgloss.Color("0")).
@@ -68,6 +87,9 @@ type BoardModel struct {
 	spinner     spinner.Model
 	filterInput textinput.Model
 
+	// Triage label prompt
+	triageLabelInput textinput.Model
+
 	// Board state
 	columns        []string            // Column IDs in order
 	columnNames    map[string]string   // Column ID -> display name
@@ -82,39 +104,228 @@ type BoardModel struct {
 	height       int
 	showHelp     bool
 	filterMode   bool
+	historyIndex int    // Past query shown in the filter input, -1 for none
+	historyDraft string // What was typed before browsing past queries
 	filterText   string
-	filterMyOnly bool // Toggle to show only items assigned to me
+	filterMyOnly bool   // Toggle to show only items assigned to me
+	filterLabel  string // Only items with this label, "" for any
+	hideBots     bool   // Hide items created by bots such as dependabot
 	moveMode     bool
-	loading      bool
-	loadingMore  bool   // True while loading more pages in background
-	nextCursor   string // Cursor for next page, empty if all loaded
-	errorToast   string
+	triageMode   bool // Only untriaged cards (no assignee, no status) with quick actions
+	sweepMode    bool // Only long-untouched Done cards, with archive/close/skip
+
+	// Header status segments by name, nil for the default layout
+	statusSegments  []string
+	accents         map[string]string     // "owner/number" -> color of the selected border and title
+	syncedAt        time.Time             // When the last full load finished
+	cachedAt        time.Time             // When the cached items shown were fetched, zero once live
+	offline         bool                  // GitHub couldn't be reached; the cached board is read-only
+	autoRefresh     time.Duration         // Interval of background refreshes, 0 for none
+	scrollbar       bool                  // Columns show a scrollbar instead of "↑/↓ N more"
+	minColumn       int                   // Narrowest column from the settings, 0 for minColumnWidth
+	maxColumn       int                   // Widest column from the settings, 0 for maxColumnWidth
+	altGroupField   string                // Grouping field (name or ID) F toggles to
+	otherGroupField *domain.FieldDef      // Field F switches back to, once toggled
+	remapAll        bool                  // Move mode moves the whole removed-option column
+	moveQuery       string                // Column name typed in move mode
+	changes         map[string]cardChange // Cards changed in the last auto-refresh that found any
+	loading         bool
+	loadingMore     bool   // True while loading more pages in background
+	nextCursor      string // Cursor for next page, empty if all loaded
+
+	// Fetch budget: loading stops early (truncated) once it is spent
+	budget      gh.FetchBudget
+	pagesLoaded int
+	truncated   bool
+	errorToast  string
+
+	triageLabelMode bool // Typing a label name in triage mode
+
+	// Stats overlay
+	showStats    bool
+	statsLoading bool
+	statsError   string
+	prMeta       map[string]domain.PRMeta // PR content ID -> review metadata
+	history      []stats.Snapshot         // Recorded column snapshots for cycle times
+
+	// Project info screen; info is fetched the first time it opens
+	showInfo    bool
+	info        *domain.ProjectInfo
+	infoLoading bool
+	infoError   string
+	infoScroll  int
+
+	// Static loading text instead of spinners
+	reducedMotion bool
+
+	// Filter matching ignores diacritics ("resume" matches "résumé")
+	foldDiacritics bool
+
+	// Workspaces and hidden columns
+	workspaceName  string               // Workspace the board was launched from or last saved as
+	workspaceSorts *workspace.Workspace // Workspace whose sorts apply once the UI state loads
+	workspaceInput textinput.Model      // Name prompt for saving a workspace
+	workspaceMode  bool                 // Typing a workspace name
+	hiddenColumns  map[string]string    // Lowercased column name -> display name
+	infoToast      string               // Transient success message, cleared on the next key
+
+	// Named filter presets, shown by the preset menu
+	presets      []config.Preset
+	presetMenu   bool
+	presetCursor int
+	presetInput  textinput.Model // Name prompt for saving a preset
+	presetMode   bool            // Typing a preset name
+
+	// Team filter: only items assigned to a member of an org team
+	teamSlug    string          // Team as typed ("slug" or "org/slug"), "" for none
+	teamMembers map[string]bool // Lowercased member logins, nil until loaded
+	teamInput   textinput.Model
+	teamMode    bool // Typing a team slug
+
+	// New item form, nil when closed
+	create *createForm
+
+	// Templates for the new item form: the local ones, and each repository's
+	// issue templates once requested (nil while loading or when it has none)
+	templates     []config.Template
+	repoTemplates map[string][]config.Template
+
+	// Repository picker for converting a draft issue, nil when closed
+	convert *convertPicker
+
+	// Tags messages the runtime delivers for the board, such as the editor
+	// closing, with the comparison view pane it is in; nil outside one
+	reply replyFunc
+
+	// Items awaiting confirmation to archive, nil when not confirming
+	archiveIDs []string
+
+	// Bulk action listed for confirmation or showing its outcomes, nil when none
+	bulk *bulkAction
+
+	// Link target picker, open while linkSource is set
+	linkPicker list.Model
+	linkSource *domain.Card
+
+	// Swimlanes: rows by a second single-select field, nil when off;
+	// cardLane maps item ID to its lane's option ID
+	laneField *domain.FieldDef
+	cardLane  map[string]string
+
+	// Columns list issues, PRs, and drafts under their own sub-headers
+	splitByType bool
+
+	// Card whose URL is shown as a QR code, nil when hidden
+	qrCard *domain.Card
+
+	// Assignee picker, open while assigneeCard is set
+	assigneePicker   list.Model
+	assigneeCard     *domain.Card
+	assigneesLoading bool
+
+	// Drops repeated presses of the same mutation (shared by pointer)
+	guard *mutationGuard
+
+	// Mutations not yet confirmed by GitHub, shared with the detail view
+	outbox       *outbox
+	showOutbox   bool
+	outboxCursor int
+
+	// Session's mutations and API results, shared with the app's other boards
+	actionLog *actionLog
+	showLog   bool
+	logScroll int // Entries scrolled back from the newest
+
+	// Other local ghp sessions on this project
+	otherSessions []cache.Presence
+	boardID       int // Tells this board's presence ticks from a replaced board's
+
+	// Terminal title last sent, to only send changes
+	title string
+
+	// When the terminal lost focus, zero while focused
+	blurredAt time.Time
+
+	// Only the selected column is shown, full width
+	zoomed bool
+
+	// Cards not updated for this long are dimmed, 0 to never dim
+	staleAfter time.Duration
+
+	// Multi-selected cards by item ID, and the URLs awaiting confirmation to
+	// open, nil when not confirming
+	marked   map[string]bool
+	openURLs []string
+
+	// Item details are being fetched for a timestamp sort
+	detailsLoading bool
+
+	// Background comment fetching for the detail view (shared with it)
+	prefetcher *commentPrefetcher
+
+	// First page of items fetched at startup, taken by the first load
+	firstPage *firstPage
+
+	// Per-project UI state (column sort overrides), nil until loaded
+	uiState *uistate.State
+
+	// Session recording (nil when not recording)
+	recorder *session.Recorder
 }
 
 // NewBoardModel creates a new board model
 func NewBoardModel(s *store.Store, client *gh.Client, ctx context.Context) BoardModel {
-	sp := spinner.New()
-	sp.Spinner = spinner.Dot
-	sp.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
-
 	ti := textinput.New()
-	ti.Placeholder = "Filter..."
+	ti.Placeholder = `Filter... ("quotes" for exact match, label:bug assignee:@me repo:api is:pr state:open)`
 	ti.Prompt = "/ "
 
-	return BoardModel{
-		store:         s,
-		client:        client,
-		ctx:           ctx,
-		keymap:        DefaultKeyMap(),
-		help:          NewHelpModel(DefaultKeyMap()),
-		spinner:       sp,
-		filterInput:   ti,
-		columns:       []string{},
-		columnNames:   make(map[string]string),
-		filteredCards: make(map[string][]string),
-		selectedCard:  make(map[string]int),
-		scrollOffset:  make(map[string]int),
-	}
+	li := textinput.New()
+	li.Placeholder = "label name"
+	li.Prompt = "label: "
+
+	tmi := textinput.New()
+	tmi.Placeholder = "team slug or org/slug (empty to clear)"
+	tmi.Prompt = "team: "
+
+	wi := textinput.New()
+	wi.Placeholder = "workspace name"
+	wi.Prompt = "save workspace: "
+
+	pi := textinput.New()
+	pi.Placeholder = "preset name"
+	pi.Prompt = "save preset: "
+
+	log := &actionLog{}
+	ob := newOutbox()
+	ob.log = log
+
+	lastBoardID++
+	m := BoardModel{
+		boardID:          lastBoardID,
+		store:            s,
+		client:           client,
+		ctx:              ctx,
+		budget:           gh.DefaultFetchBudget(),
+		guard:            newMutationGuard(),
+		outbox:           ob,
+		actionLog:        log,
+		keymap:           DefaultKeyMap(),
+		help:             NewHelpModel(DefaultKeyMap()),
+		spinner:          newSpinner(),
+		filterInput:      ti,
+		triageLabelInput: li,
+		workspaceInput:   wi,
+		presetInput:      pi,
+		teamInput:        tmi,
+		columns:          []string{},
+		columnNames:      make(map[string]string),
+		filteredCards:    make(map[string][]string),
+		selectedCard:     make(map[string]int),
+		scrollOffset:     make(map[string]int),
+		marked:           make(map[string]bool),
+	}
+	m.title = m.windowTitle()
+	return m
 }
 
 // boardInitMsg triggers initial column build
@@ -123,22 +334,89 @@ type boardInitMsg struct{}
 // Init initializes the board and starts background loading
 func (m BoardModel) Init() tea.Cmd {
 	// Always rebuild columns (even if empty) and start loading
+	var teamCmd tea.Cmd
+	if m.teamSlug != "" {
+		teamCmd = m.loadTeamMembers(m.teamSlug)
+	}
 	return tea.Batch(
-		m.spinner.Tick,
+		teamCmd,
+		m.loadUIState(),
+		spinnerTick(m.spinner, m.reducedMotion),
 		tea.WindowSize(),
 		func() tea.Msg { return boardInitMsg{} },
-		m.loadNextPage(""), // Start loading first page immediately
+		m.loadItems(),
+		m.syncPresence(),
+		m.presenceTick(),
+		m.autoRefreshTick(),
+		tea.SetWindowTitle(m.title),
 	)
 }
 
 // Update handles messages
 func (m BoardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	selected := m.selectedItemID()
+	pending, _ := m.outbox.counts()
+	model, cmd := m.update(msg)
+	if board, ok := model.(BoardModel); ok {
+		board.logToasts(msg, m.infoToast, m.errorToast)
+		// Other sessions learn about unsent changes right away
+		if now, _ := board.outbox.counts(); now != pending {
+			cmd = tea.Batch(cmd, board.syncPresence())
+		}
+		if board.recorder != nil {
+			board.recordFrame(msg)
+		}
+		// Warm the comment cache for the cards around a new selection
+		if board.selectedItemID() != selected {
+			cmd = tea.Batch(cmd, board.prefetchComments())
+		}
+		if title := board.windowTitle(); title != board.title {
+			board.title = title
+			model = board
+			cmd = tea.Batch(cmd, tea.SetWindowTitle(title))
+		}
+	}
+	return model, cmd
+}
+
+// update applies a message to the board state
+func (m BoardModel) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
 		return m, nil
 
+	case uiStateLoadedMsg:
+		m.uiState = msg.state
+		(&m).applyWorkspaceSorts()
+		if len(msg.problems) > 0 {
+			m.errorToast = fmt.Sprintf("UI state: %s", msg.problems[0])
+		}
+		if len(m.uiState.HiddenRepos) > 0 {
+			m.store.SetExcludedRepos(m.uiState.HiddenRepos)
+			(&m).rebuildColumns()
+		}
+		(&m).applyFilter()
+		return m, (&m).loadSortDetails()
+
+	case tea.FocusMsg, tea.BlurMsg:
+		return m.handleFocus(msg)
+
+	case uiStateErrorMsg:
+		m.errorToast = fmt.Sprintf("UI state: %v", msg.err)
+		return m, nil
+
+	case presenceTickMsg:
+		if msg.boardID != m.boardID {
+			return m, nil
+		}
+		return m, tea.Batch(m.syncPresence(), m.presenceTick())
+
+	case presenceMsg:
+		m.otherSessions = msg.others
+		return m, nil
+
 	case boardInitMsg:
 		(&m).rebuildColumns()
 		(&m).applyFilter()
@@ -147,12 +425,40 @@ func (m BoardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case itemsLoadedMsg:
 		m.loading = false
 		m.loadingMore = false
+		m.syncedAt = time.Now()
+		m.pagesLoaded = msg.pages
+		m.nextCursor, m.truncated = m.store.GetPagination()
 		(&m).rebuildColumns()
 		(&m).applyFilter()
+		return m, tea.Batch(m.recordHistory(), (&m).loadSortDetails())
+
+	case revalidatedMsg:
+		return m.handleRevalidated(msg)
+
+	case autoRefreshMsg:
+		return m.handleAutoRefresh()
+
+	case offlineMsg:
+		if !m.cachedAt.IsZero() {
+			m.offline = true
+			m.errorToast = fmt.Sprintf("Offline: %v", msg.err)
+		}
+		return m, nil
+
+	case itemsErrorMsg:
+		m.loading = false
+		if isSSOError(msg.err) {
+			return m, reportError(msg.err)
+		}
+		m.errorToast = fmt.Sprintf("Refresh failed: %v", msg.err)
 		return m, nil
 
 	case pageLoadedMsg:
 		// Handle lazy-loaded page
+		if isSSOError(msg.err) {
+			m.loadingMore = false
+			return m, reportError(msg.err)
+		}
 		if msg.err != nil {
 			m.loadingMore = false
 			m.errorToast = fmt.Sprintf("Load failed: %v", msg.err)
@@ -161,39 +467,332 @@ func (m BoardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Add cards to store
 		m.store.UpsertCards(msg.cards)
+		m.pagesLoaded++
 		(&m).rebuildColumns()
 		(&m).applyFilter()
 
-		// If more pages, continue loading
+		// If more pages, continue loading unless the fetch budget is spent
 		if msg.hasMore && msg.nextCursor != "" {
-			m.loadingMore = true
 			m.nextCursor = msg.nextCursor
-			return m, m.loadNextPage(msg.nextCursor)
+			if m.nextPageSize() > 0 {
+				m.loadingMore = true
+				return m, m.loadNextPage(msg.nextCursor)
+			}
+			m.loadingMore = false
+			m.truncated = true
+			m.store.SetPagination(msg.nextCursor, true)
+			return m, tea.Batch(m.recordHistory(), (&m).loadSortDetails())
 		}
 
 		// All done
 		m.loadingMore = false
+		m.syncedAt = time.Now()
 		m.nextCursor = ""
-		return m, nil
+		m.truncated = false
+		m.store.SetPagination("", false)
+		return m, tea.Batch(m.recordHistory(), (&m).loadSortDetails())
+
+	case outboxResultMsg:
+		return m.update(m.outbox.resolve(msg))
 
 	case moveSuccessMsg:
 		m.moveMode = false
+		if msg.action.ItemID != "" {
+			m.store.RecordAction(msg.action)
+			(&m).recordBulkResult(msg.action.ItemID, nil)
+		}
 		(&m).rebuildColumns()
 		(&m).applyFilter()
 		return m, nil
 
+	case reorderedMsg:
+		return m, nil
+
+	case reorderErrorMsg:
+		(&m).rollbackReorder(msg)
+		return m, nil
+
 	case moveErrorMsg:
-		m.store.RollbackMove()
+		if msg.itemID != "" {
+			// Several moves may be in flight; put back the one that failed
+			_ = m.store.RevertMove(msg.itemID, msg.from, msg.to)
+			(&m).recordBulkResult(msg.itemID, msg.err)
+		} else {
+			m.store.RollbackMove()
+		}
 		(&m).rebuildColumns()
 		(&m).applyFilter()
 		m.errorToast = fmt.Sprintf("Move failed: %v", msg.err)
 		return m, nil
 
+	case editorClosedMsg:
+		defer os.Remove(msg.path)
+		if msg.err != nil {
+			m.errorToast = fmt.Sprintf("Editor failed: %v", msg.err)
+			return m, nil
+		}
+		title, body, err := editor.ReadTemp(msg.path)
+		if err != nil {
+			m.errorToast = fmt.Sprintf("Edit discarded: %v", err)
+			return m, nil
+		}
+		oldBody, _ := m.store.GetBody(msg.card.ItemID)
+		if title == msg.card.Title && body == strings.TrimSpace(oldBody) {
+			return m, nil
+		}
+		return m, m.saveContent(msg.card, title, body)
+
+	case contentSavedMsg:
+		msg.card.Title = msg.title
+		m.store.SetBody(msg.card.ItemID, msg.body)
+		(&m).applyFilter()
+		return m, nil
+
+	case editDetailsLoadedMsg:
+		m.store.SetDetails(msg.card.ItemID, msg.details)
+		return m, m.editCard(msg.card)
+
+	case sortDetailsLoadedMsg:
+		m.detailsLoading = false
+		for itemID, details := range msg.details {
+			m.store.SetDetails(itemID, details)
+		}
+		(&m).applyFilter()
+		if msg.err != nil {
+			m.errorToast = fmt.Sprintf("Sort details: %v", msg.err)
+		}
+		return m, nil
+
+	case contentErrorMsg:
+		m.errorToast = fmt.Sprintf("Save failed: %v", msg.err)
+		return m, nil
+
+	case triageAssignedMsg:
+		// Assigned cards leave the triage set, so the next card slides into place
+		msg.card.Assignees = append(msg.card.Assignees, msg.login)
+		(&m).applyFilter()
+		return m, nil
+
+	case triageLabeledMsg:
+		msg.card.Labels = append(msg.card.Labels, msg.label)
+		(&m).moveCardSelection(1)
+		return m, nil
+
+	case projectInfoLoadedMsg:
+		m.infoLoading = false
+		m.info = msg.info
+		return m, nil
+
+	case projectInfoErrorMsg:
+		m.infoLoading = false
+		m.infoError = msg.err.Error()
+		return m, nil
+
+	case prMetaLoadedMsg:
+		m.statsLoading = false
+		m.prMeta = msg.meta
+		return m, nil
+
+	case prMetaErrorMsg:
+		m.statsLoading = false
+		m.statsError = msg.err.Error()
+		return m, nil
+
+	case historyLoadedMsg:
+		m.history = msg.history
+		return m, nil
+
+	case teamMembersLoadedMsg:
+		// Ignore results for a team that has since been replaced
+		if msg.team == m.teamSlug {
+			m.teamMembers = msg.members
+			(&m).applyFilter()
+		}
+		return m, nil
+
+	case teamErrorMsg:
+		if msg.team == m.teamSlug {
+			m.teamSlug = ""
+			m.teamMembers = nil
+			m.errorToast = fmt.Sprintf("Team filter failed: %v", msg.err)
+		}
+		return m, nil
+
+	case workspaceSavedMsg:
+		m.workspaceName = msg.name
+		m.infoToast = fmt.Sprintf("Saved workspace '%s'", msg.name)
+		return m, nil
+
+	case workspaceErrorMsg:
+		m.errorToast = fmt.Sprintf("Workspace not saved: %v", msg.err)
+		return m, nil
+
+	case presetSavedMsg:
+		m.infoToast = fmt.Sprintf("Saved preset '%s'", msg.preset.Name)
+		return m, nil
+
+	case presetErrorMsg:
+		m.errorToast = fmt.Sprintf("Preset not saved: %v", msg.err)
+		return m, nil
+
+	case undoneMsg:
+		// A retry from the outbox repeated the step in the store
+		(&m).rebuildColumns()
+		(&m).applyFilter()
+		m.infoToast = "Undid: " + msg.action.Label
+		if msg.redo {
+			m.infoToast = "Redid: " + msg.action.Label
+		}
+		return m, nil
+
+	case undoErrorMsg:
+		(&m).handleUndoError(msg)
+		return m, nil
+
+	case itemsArchivedMsg:
+		for _, id := range msg.itemIDs {
+			(&m).recordBulkResult(id, nil)
+		}
+		if msg.err != nil {
+			(&m).failBulkPending(msg.err)
+		}
+		if len(msg.itemIDs) > 0 {
+			m.store.RecordAction(m.archiveAction(msg.itemIDs))
+			m.store.RemoveCards(msg.itemIDs)
+			(&m).rebuildColumns()
+			(&m).applyFilter()
+		}
+		if msg.err != nil {
+			m.errorToast = fmt.Sprintf("Archived %d, then failed: %v", len(msg.itemIDs), msg.err)
+			return m, nil
+		}
+		m.infoToast = fmt.Sprintf("Archived %d items", len(msg.itemIDs))
+		if len(msg.itemIDs) == 1 {
+			m.infoToast = "Archived 1 item"
+		}
+		return m, m.recordHistory()
+
+	case itemsLinkedMsg:
+		m.infoToast = fmt.Sprintf("Linked %s to %s", msg.source, msg.target)
+		return m, nil
+
+	case assigneesLoadedMsg:
+		if msg.card == m.assigneeCard {
+			(&m).showAssignees(msg.card, msg.users)
+		}
+		return m, nil
+
+	case assigneesErrorMsg:
+		m.assigneeCard, m.assigneesLoading = nil, false
+		m.errorToast = fmt.Sprintf("Assignees not loaded: %v", msg.err)
+		return m, nil
+
+	case assigneeSavedMsg:
+		return m, nil
+
+	case assigneeErrorMsg:
+		(&m).rollbackAssignee(msg)
+		return m, nil
+
+	case linkErrorMsg:
+		m.errorToast = fmt.Sprintf("Link failed: %v", msg.err)
+		return m, nil
+
+	case stateChangedMsg:
+		m.infoToast = stateToast(msg.card)
+		return m, nil
+
+	case stateErrorMsg:
+		msg.card.State = msg.previous
+		(&m).applyFilter()
+		m.errorToast = fmt.Sprintf("%s not changed: %v", cardLabel(msg.card), msg.err)
+		return m, nil
+
+	case snapshotSavedMsg:
+		m.infoToast = fmt.Sprintf("Saved board to %s.txt and %s.png", msg.name, msg.name)
+		return m, nil
+
+	case snapshotErrorMsg:
+		m.errorToast = fmt.Sprintf("Snapshot failed: %v", msg.err)
+		return m, nil
+
+	case itemCreatedMsg:
+		if msg.err != nil {
+			m.errorToast = fmt.Sprintf("Created %q, but not moved to %s: %v", msg.title, msg.column, msg.err)
+		} else {
+			m.infoToast = fmt.Sprintf("Created %q in %s", msg.title, msg.column)
+		}
+		m.loading = true
+		return m, m.loadAllItems()
+
+	case draftEditedMsg:
+		text, err := readDraft(msg)
+		if err != nil {
+			m.errorToast = fmt.Sprintf("Draft not loaded: %v", err)
+			return m, nil
+		}
+		if msg.target == draftCreateBody && m.create != nil {
+			m.create.body.SetValue(text)
+			return m, m.create.setFocus(createFieldBody)
+		}
+		return m, nil
+
+	case repoTemplatesMsg:
+		if msg.err != nil {
+			// Asked for again the next time the repository is chosen
+			delete(m.repoTemplates, msg.repo)
+			m.errorToast = fmt.Sprintf("Issue templates for %s: %v", msg.repo, msg.err)
+			return m, nil
+		}
+		if m.repoTemplates == nil {
+			m.repoTemplates = make(map[string][]config.Template)
+		}
+		m.repoTemplates[msg.repo] = msg.templates
+		if m.create != nil {
+			(&m).refreshTemplates()
+		}
+		return m, nil
+
+	case itemCreateErrorMsg:
+		m.errorToast = fmt.Sprintf("Create failed: %v", msg.err)
+		return m, nil
+
+	case draftConvertedMsg:
+		(&m).applyConversion(msg)
+		return m, nil
+
+	case draftConvertErrorMsg:
+		m.errorToast = fmt.Sprintf("Convert failed: %v", msg.err)
+		return m, nil
+
+	case itemCopiedMsg:
+		if msg.err != nil {
+			m.errorToast = fmt.Sprintf("Copied %q, but not moved to %s: %v", msg.title, msg.column, msg.err)
+		} else {
+			m.infoToast = fmt.Sprintf("Copied %q to %s", msg.title, msg.column)
+		}
+		m.loading = true
+		return m, m.loadAllItems()
+
+	case itemCopyErrorMsg:
+		m.errorToast = fmt.Sprintf("Copy failed: %v", msg.err)
+		return m, nil
+
+	case triageErrorMsg:
+		m.errorToast = fmt.Sprintf("Triage failed: %v", msg.err)
+		return m, nil
+
 	case spinner.TickMsg:
+		if m.reducedMotion {
+			return m, nil
+		}
 		var cmd tea.Cmd
 		m.spinner, cmd = m.spinner.Update(msg)
 		return m, cmd
 
+	case tea.MouseMsg:
+		return m.handleMouse(msg)
+
 	case tea.KeyMsg:
 		return m.handleKeyPress(msg)
 	}
@@ -208,26 +807,73 @@ func (m BoardModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, tea.Quit
 	}
 
+	m.infoToast = ""
+
 	// Help overlay
 	if m.showHelp {
-		if msg.String() == "?" || msg.String() == "q" || msg.String() == "esc" {
+		if key.Matches(msg, m.keymap.Help, m.keymap.Quit) || msg.Type == tea.KeyEsc {
 			m.showHelp = false
 		}
 		return m, nil
 	}
 
+	// Stats overlay
+	if m.showStats {
+		if key.Matches(msg, m.keymap.Stats, m.keymap.Quit) || msg.Type == tea.KeyEsc {
+			m.showStats = false
+		}
+		return m, nil
+	}
+
+	// Project info screen
+	if m.showInfo {
+		return m.handleInfoKey(msg)
+	}
+
+	// QR code of a card's URL
+	if m.qrCard != nil {
+		if key.Matches(msg, m.keymap.ShareQR, m.keymap.Quit) || msg.Type == tea.KeyEsc {
+			m.qrCard = nil
+		}
+		return m, nil
+	}
+
+	// Outbox viewer
+	if m.showOutbox {
+		return m.handleOutboxKey(msg)
+	}
+
+	// Action log panel
+	if m.showLog {
+		return m.handleActionLogKey(msg)
+	}
+
+	// New item form
+	if m.create != nil {
+		return m.handleCreateKey(msg)
+	}
+	if m.convert != nil {
+		return m.handleConvertKey(msg)
+	}
+
 	// Filter mode
 	if m.filterMode {
-		switch msg.String() {
-		case "enter":
+		switch {
+		case key.Matches(msg, m.keymap.ApplyFilter):
 			m.filterMode = false
 			m.filterText = m.filterInput.Value()
 			(&m).applyFilter()
-			return m, nil
-		case "esc":
+			return m, tea.Batch((&m).recordSearch(strings.TrimSpace(m.filterText)), (&m).loadSortDetails())
+		case key.Matches(msg, m.keymap.CancelFilter):
 			m.filterMode = false
 			m.filterInput.SetValue(m.filterText)
 			return m, nil
+		case key.Matches(msg, m.keymap.OlderFilter):
+			(&m).browseHistory(1)
+			return m, nil
+		case key.Matches(msg, m.keymap.NewerFilter):
+			(&m).browseHistory(-1)
+			return m, nil
 		default:
 			var cmd tea.Cmd
 			m.filterInput, cmd = m.filterInput.Update(msg)
@@ -235,70 +881,285 @@ func (m BoardModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	// Team prompt
+	if m.teamMode {
+		return m.handleTeamPrompt(msg)
+	}
+
+	// Workspace name prompt
+	if m.workspaceMode {
+		return m.handleWorkspacePrompt(msg)
+	}
+
+	// Preset menu and name prompt
+	if m.presetMenu {
+		return m.handlePresetMenu(msg)
+	}
+	if m.presetMode {
+		return m.handlePresetPrompt(msg)
+	}
+
+	// Link target picker
+	if m.linkSource != nil {
+		return m.handleLinkPicker(msg)
+	}
+
+	// Assignee picker
+	if m.assigneeCard != nil {
+		return m.handleAssigneePicker(msg)
+	}
+
+	// Bulk action preview and outcomes
+	if m.bulk != nil {
+		return m.handleBulkKey(msg)
+	}
+
+	// Archive confirmation
+	if m.archiveIDs != nil {
+		return m.handleArchiveConfirm(msg)
+	}
+
+	// Confirmation to open many tabs
+	if m.openURLs != nil {
+		return m.handleOpenConfirm(msg)
+	}
+
 	// Move mode
 	if m.moveMode {
 		return m.handleMoveMode(msg)
 	}
 
+	// Triage mode
+	if m.triageMode {
+		return m.handleTriageMode(msg)
+	}
+
+	// Sweep mode
+	if m.sweepMode {
+		return m.handleSweepMode(msg)
+	}
+
+	if refusal := m.offlineRefusal(msg); refusal != "" {
+		m.errorToast = refusal
+		return m, nil
+	}
+
 	// Normal navigation
-	switch msg.String() {
-	case "q":
+	k := m.keymap
+	switch {
+	case key.Matches(msg, k.Quit):
 		return m, tea.Quit
-	case "?":
+	case key.Matches(msg, k.Help):
 		m.showHelp = true
-	case "/":
-		m.filterMode = true
-		m.filterInput.Focus()
-	case "h", "left":
+	case key.Matches(msg, k.Filter):
+		(&m).startFilter()
+	case key.Matches(msg, k.Left):
 		if m.selectedColumn > 0 {
 			m.selectedColumn--
 			(&m).adjustColumnScroll()
 		}
-	case "l", "right":
+	case key.Matches(msg, k.Right):
 		if m.selectedColumn < len(m.columns)-1 {
 			m.selectedColumn++
 			(&m).adjustColumnScroll()
 		}
-	case "j", "down":
+	case key.Matches(msg, k.Down):
 		(&m).moveCardSelection(1)
-	case "k", "up":
+	case key.Matches(msg, k.Up):
 		(&m).moveCardSelection(-1)
-	case "g":
+	case key.Matches(msg, k.Reorder):
+		// Move the card down (first key) or up in the column's manual order
+		if keyIndex(msg, k.Reorder) == 0 {
+			return m, (&m).reorderCard(1)
+		}
+		return m, (&m).reorderCard(-1)
+	case key.Matches(msg, k.Top):
 		// Go to top of current column (vim: gg)
 		(&m).jumpToCard(0)
-	case "G":
+	case key.Matches(msg, k.Bottom):
 		// Go to bottom of current column (vim: G)
 		(&m).jumpToCard(-1)
-	case "ctrl+d":
-		// Page down (half screen in vim, we use fixed jump)
-		(&m).moveCardSelection(pageJumpSize)
-	case "ctrl+u":
-		// Page up
-		(&m).moveCardSelection(-pageJumpSize)
-	case "m":
-		if m.getSelectedCard() != nil {
-			m.moveMode = true
-		}
-	case "o":
+	case key.Matches(msg, k.HalfPage, k.FullPage):
+		// Half (ctrl+d/u) or full (ctrl+f/b) pages of the cards that fit, as in vim
+		if len(m.columns) > 0 {
+			page := m.visibleCardCount(m.columns[m.selectedColumn])
+			idx := keyIndex(msg, k.FullPage)
+			if key.Matches(msg, k.HalfPage) {
+				page, idx = max(page/2, 1), keyIndex(msg, k.HalfPage)
+			}
+			if idx > 0 {
+				page = -page
+			}
+			(&m).moveCardSelection(page)
+		}
+	case key.Matches(msg, k.Zoom):
+		// Show only the current column with its full list and richer rows
+		m.zoomed = !m.zoomed
+	case key.Matches(msg, k.Density):
+		// One- or two-line cards, or whichever fits
+		return m, (&m).cycleDensity()
+	case key.Matches(msg, k.Mark):
+		(&m).toggleMark()
+	case key.Matches(msg, k.Info):
+		return m, (&m).toggleInfo()
+	case key.Matches(msg, k.New):
+		// Create an item in the selected column
+		return m, (&m).startCreate()
+	case key.Matches(msg, k.ClearMarks):
+		// Clear the selection first, then leave a zoomed column
+		if len(m.marked) > 0 {
+			clear(m.marked)
+		} else {
+			m.zoomed = false
+		}
+	case key.Matches(msg, k.Move):
+		if groupField := m.store.GetGroupField(); !store.Movable(groupField) {
+			m.errorToast = fmt.Sprintf("Cards can't move between %s columns", strings.ToLower(groupField.Name))
+		} else if m.getSelectedCard() != nil {
+			m.moveMode, m.moveQuery = true, ""
+		}
+	case key.Matches(msg, k.Open):
+		if len(m.marked) > 0 {
+			return m, (&m).openMarked()
+		}
 		card := m.getSelectedCard()
 		if card != nil && card.URL != "" {
 			_ = browser.OpenURL(card.URL)
 		}
-	case "r":
+	case key.Matches(msg, k.Edit):
+		card := m.getSelectedCard()
+		if card != nil && editor.Editable(card) {
+			return m, m.editCard(card)
+		}
+	case key.Matches(msg, k.Refresh):
 		m.loading = true
+		if !m.cachedAt.IsZero() {
+			// Keep the cached cards until the live ones arrive
+			return m, m.revalidate()
+		}
 		return m, m.loadAllItems()
-	case "f":
-		// Change group field (was 'g', now 'f' for "field")
+	case key.Matches(msg, k.LoadMore):
+		// Load the items the fetch budget left out
+		if m.truncated && !m.loadingMore {
+			m.budget = m.budget.Unlimited()
+			m.truncated = false
+			m.loadingMore = true
+			return m, m.loadNextPage(m.nextCursor)
+		}
+	case key.Matches(msg, k.ChangeGroup):
 		return m, func() tea.Msg { return changeGroupFieldMsg{} }
-	case "a":
+	case key.Matches(msg, k.ToggleGroup):
+		(&m).toggleGroupField()
+	case key.Matches(msg, k.MyItems):
 		// Toggle "assigned to me" filter
 		m.filterMyOnly = !m.filterMyOnly
 		(&m).applyFilter()
-	case "enter":
+	case key.Matches(msg, k.HideBots):
+		// Hide or show bot-created items
+		(&m).toggleHideBots()
+	case key.Matches(msg, k.Triage):
+		// Enter triage mode (unassigned items without a status)
+		(&m).toggleTriage()
+	case key.Matches(msg, k.Sweep):
+		// Sweep long-untouched Done items one at a time
+		(&m).toggleSweep()
+	case key.Matches(msg, k.Remap):
+		// Move every card in the removed-option column to another column
+		(&m).startRemap()
+	case key.Matches(msg, k.SplitByType):
+		(&m).toggleTypeSplit()
+	case key.Matches(msg, k.Undo):
+		return m, (&m).undo(false)
+	case key.Matches(msg, k.Redo):
+		return m, (&m).undo(true)
+	case key.Matches(msg, k.Compare):
+		// Show another project beside this one
+		return m, func() tea.Msg { return compareMsg{} }
+	case key.Matches(msg, k.Outbox):
+		// Show mutations that haven't reached GitHub
+		m.showOutbox = true
+		m.outboxCursor = 0
+	case key.Matches(msg, k.ActionLog):
+		// Show what happened this session, after the toasts are gone
+		m.showLog = true
+		m.logScroll = 0
+	case key.Matches(msg, k.Stats):
+		// Show PR review stats per column
+		cmd := (&m).toggleStats()
+		return m, cmd
+	case key.Matches(msg, k.Sort):
+		// Cycle the selected column's sort order
+		cmd := (&m).cycleColumnSort()
+		return m, tea.Batch(cmd, (&m).loadSortDetails())
+	case key.Matches(msg, k.BoardSort):
+		// Cycle the sort for columns without their own
+		return m, (&m).cycleBoardSort()
+	case key.Matches(msg, k.Assign):
+		// Add or remove assignees of the selected item
+		return m, (&m).startAssign()
+	case key.Matches(msg, k.CloseItem):
+		// Close the selected issue or pull request
+		return m, (&m).setSelectedState(true)
+	case key.Matches(msg, k.ReopenItem):
+		// Reopen the selected issue or pull request
+		return m, (&m).setSelectedState(false)
+	case key.Matches(msg, k.Convert):
+		// Convert the selected draft issue to an issue in a repository
+		(&m).startConvert()
+		return m, nil
+	case key.Matches(msg, k.Export):
+		// Save a text and PNG snapshot of the board
+		return m, m.exportSnapshot()
+	case key.Matches(msg, k.Lanes):
+		// Split columns into swimlanes by the next single-select field
+		(&m).cycleLanes()
+		return m, nil
+	case key.Matches(msg, k.ShareQR):
+		// Show the selected item's URL as a QR code
+		(&m).toggleQR()
+		return m, nil
+	case key.Matches(msg, k.Parent):
+		// Select the selected item's parent issue
+		(&m).jumpToParent()
+		return m, nil
+	case key.Matches(msg, k.Link):
+		// Link the selected item to another item
+		(&m).startLink()
+	case key.Matches(msg, k.ArchiveDone):
+		// Archive all closed/merged items in the Done column
+		(&m).startArchiveDone()
+	case key.Matches(msg, k.HideColumn):
+		// Hide the selected column
+		(&m).hideSelectedColumn()
+	case key.Matches(msg, k.ShowColumns):
+		// Show all hidden columns
+		(&m).showAllColumns()
+	case key.Matches(msg, k.Workspace):
+		// Save the current view as a workspace
+		(&m).startWorkspacePrompt()
+	case key.Matches(msg, k.Presets):
+		// Pick a saved filter preset
+		m.presetMenu, m.presetCursor = true, 0
+	case key.Matches(msg, k.QuickFilter):
+		// Apply a pinned preset from the quick filter bar
+		return m, (&m).applyQuickFilter(keyIndex(msg, k.QuickFilter))
+	case key.Matches(msg, k.Team):
+		// Filter by team (items assigned to any member)
+		m.teamMode = true
+		m.teamInput.CursorEnd()
+		m.teamInput.Focus()
+	case key.Matches(msg, k.Project):
+		// Switch to another project of the same owner
+		return m, func() tea.Msg { return switchProjectMsg{} }
+	case key.Matches(msg, k.Owner):
+		// Switch owner, then pick one of their projects
+		return m, func() tea.Msg { return switchOwnerMsg{} }
+	case key.Matches(msg, k.View):
 		// Open card detail view
 		card := m.getSelectedCard()
 		if card != nil {
-			return m, func() tea.Msg { return openDetailMsg{card: card} }
+			summary := m.summaryStrip()
+			return m, func() tea.Msg { return openDetailMsg{card: card, summary: summary} }
 		}
 	}
 
@@ -307,19 +1168,86 @@ func (m BoardModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 // handleMoveMode handles key presses in move mode
 func (m BoardModel) handleMoveMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	case "esc", "q":
-		m.moveMode = false
+	switch {
+	case key.Matches(msg, m.keymap.CancelMove):
+		m.moveMode, m.remapAll, m.moveQuery = false, false, ""
+		return m, nil
+	case msg.Type == tea.KeyBackspace:
+		if query := []rune(m.moveQuery); len(query) > 0 {
+			m.moveQuery = string(query[:len(query)-1])
+		}
 		return m, nil
-	case "1", "2", "3", "4", "5", "6", "7", "8", "9":
-		idx := int(msg.Runes[0] - '1')
-		if idx >= 0 && idx < len(m.columns) {
-			return m, m.moveCardToColumn(m.columns[idx])
+	case key.Matches(msg, m.keymap.MoveByName):
+		if m.moveQuery == "" {
+			return m, nil
+		}
+		opt, err := store.FindOption(m.moveTargets(), m.moveQuery)
+		if err != nil {
+			m.errorToast = err.Error()
+			return m, nil
+		}
+		m.moveQuery = ""
+		return m.moveTo(opt.ID)
+	}
+
+	// Column numbers and quit are shortcuts until a column name is being typed
+	if m.moveQuery == "" {
+		switch {
+		case key.Matches(msg, m.keymap.Quit):
+			m.moveMode, m.remapAll = false, false
+			return m, nil
+		case key.Matches(msg, m.keymap.MoveTarget):
+			idx := keyIndex(msg, m.keymap.MoveTarget)
+			if idx >= len(m.columns) {
+				return m, nil
+			}
+			return m.moveTo(m.columns[idx])
 		}
 	}
+	if msg.Type == tea.KeyRunes || (msg.Type == tea.KeySpace && m.moveQuery != "") {
+		m.moveQuery += string(msg.Runes)
+	}
 	return m, nil
 }
 
+// keyIndex returns the position of msg's key among b's keys, which tells
+// apart the keys of bindings like 1-9 or J/K; -1 when b doesn't match
+func keyIndex(msg tea.KeyMsg, b key.Binding) int {
+	if !key.Matches(msg, b) {
+		return -1
+	}
+	return slices.Index(b.Keys(), msg.String())
+}
+
+// moveTo moves the selected card, or the removed-option column's cards when
+// re-mapping, to a column
+func (m BoardModel) moveTo(colID string) (tea.Model, tea.Cmd) {
+	if colID == removedOptionKey {
+		m.errorToast = "Cards can't be moved to a removed option"
+		return m, nil
+	}
+	if m.remapAll {
+		m.moveMode, m.remapAll = false, false
+		return m, (&m).remapCards(colID)
+	}
+	return m, (&m).moveCardToColumn(colID)
+}
+
+// moveTargets returns the board's columns as options of the grouping field,
+// for resolving a column typed by name in move mode
+func (m BoardModel) moveTargets() *domain.FieldDef {
+	field := &domain.FieldDef{Name: "Column"}
+	if groupField := m.store.GetGroupField(); groupField != nil {
+		field.Name = groupField.Name
+	}
+	for _, colID := range m.columns {
+		if colID != removedOptionKey {
+			field.Options = append(field.Options, domain.Option{ID: colID, Name: m.columnNames[colID]})
+		}
+	}
+	return field
+}
+
 // View renders the board - fills entire terminal exactly
 func (m BoardModel) View() string {
 	// Use sensible defaults if dimensions not yet set
@@ -345,24 +1273,82 @@ func (m BoardModel) View() string {
 	secondHeader := m.renderSecondHeader(width)
 	sections = append(sections, secondHeader)
 
+	// === QUICK FILTERS (pinned presets) ===
+	quickFilters := len(m.pinnedPresets()) > 0
+	if quickFilters {
+		sections = append(sections, m.renderQuickFilters(width))
+	}
+
 	// === FILTER INPUT (if active) ===
 	if m.filterMode {
 		sections = append(sections, m.filterInput.View())
 	}
 
+	// === WORKSPACE NAME PROMPT ===
+	if m.workspaceMode {
+		sections = append(sections, m.workspaceInput.View())
+	}
+
+	// === PRESET NAME PROMPT ===
+	if m.presetMode {
+		sections = append(sections, m.presetInput.View())
+	}
+
+	// === TEAM PROMPT ===
+	if m.teamMode {
+		sections = append(sections, m.teamInput.View())
+	}
+
 	// === MOVE MODE BANNER ===
 	if m.moveMode {
-		moveBar := moveModeStyle.Render("MOVE") + " Press 1-9 to select column, ESC to cancel"
-		sections = append(sections, moveBar)
+		sections = append(sections, m.renderMoveBanner())
+	}
+
+	// === ARCHIVE CONFIRMATION ===
+	if m.archiveIDs != nil {
+		sections = append(sections, m.renderArchiveBanner())
+	}
+
+	// === OPEN CONFIRMATION ===
+	if m.openURLs != nil {
+		sections = append(sections, m.renderOpenBanner())
+	}
+
+	// === TRIAGE MODE BANNER ===
+	if m.triageMode && !m.moveMode {
+		sections = append(sections, m.renderTriageBanner())
+	}
+
+	// === SWEEP MODE BANNER ===
+	if m.sweepMode {
+		sections = append(sections, m.renderSweepBanner())
 	}
 
 	// Calculate board height:
 	// total height - header(1) - secondHeader(1) - optional filter(1) - optional move(1)
 	boardHeight := height - 2 // header + second header
+	if quickFilters {
+		boardHeight--
+	}
 	if m.filterMode {
 		boardHeight--
 	}
-	if m.moveMode {
+	if m.workspaceMode {
+		boardHeight--
+	}
+	if m.presetMode {
+		boardHeight--
+	}
+	if m.teamMode {
+		boardHeight--
+	}
+	if m.archiveIDs != nil {
+		boardHeight--
+	}
+	if m.openURLs != nil {
+		boardHeight--
+	}
+	if m.moveMode || m.triageMode || m.sweepMode {
 		boardHeight--
 	}
 	if boardHeight < 5 {
@@ -379,12 +1365,36 @@ func (m BoardModel) View() string {
 			helpLines = helpLines[:boardHeight]
 		}
 		mainContent = strings.Join(helpLines, "\n")
+	} else if m.bulk != nil {
+		mainContent = m.renderBulk(width, boardHeight)
+	} else if m.presetMenu {
+		mainContent = m.renderPresetMenu(width)
+	} else if m.linkSource != nil {
+		mainContent = m.renderLinkPicker(width, boardHeight)
+	} else if m.assigneeCard != nil {
+		mainContent = m.renderAssigneePicker(width, boardHeight)
+	} else if m.create != nil {
+		mainContent = m.renderCreate(width)
+	} else if m.convert != nil {
+		mainContent = m.renderConvert(width)
+	} else if m.showStats {
+		mainContent = m.renderStats(width)
+	} else if m.showInfo {
+		mainContent = m.renderInfo(width, boardHeight)
+	} else if m.qrCard != nil {
+		mainContent = m.renderQR(width, boardHeight)
+	} else if m.showOutbox {
+		mainContent = m.renderOutbox(width)
+	} else if m.showLog {
+		mainContent = m.renderActionLog(width, boardHeight)
 	} else if m.loading && len(m.store.GetAllCards()) == 0 {
-		loadingMsg := m.spinner.View() + " Loading..."
+		loadingMsg := loadingText(m.spinner, m.reducedMotion, "Loading…")
 		mainContent = lipgloss.Place(width, boardHeight, lipgloss.Center, lipgloss.Center, loadingMsg)
 	} else if len(m.columns) == 0 {
 		emptyMsg := "No columns available. Press 'r' to refresh."
 		mainContent = lipgloss.Place(width, boardHeight, lipgloss.Center, lipgloss.Center, emptyMsg)
+	} else if m.laneField != nil && !m.zoomed {
+		mainContent = m.renderSwimlanes(width, boardHeight)
 	} else {
 		// Render kanban board - boardHeight includes space for column borders
 		mainContent = m.renderBoard(width, boardHeight)
@@ -397,13 +1407,15 @@ func (m BoardModel) View() string {
 
 // renderSecondHeader renders navigation hints and position info
 func (m BoardModel) renderSecondHeader(width int) string {
-	// Build left side: navigation hints
-	left := "h/l:col j/k:card m:move o:open enter:view"
+	// Build left side: hints for the current mode
+	left := formatHints(m.boardHints())
 
 	// Build right side: error toast or position info
 	right := ""
 	if m.errorToast != "" {
 		right = errorStyle.Render(m.errorToast)
+	} else if m.infoToast != "" {
+		right = infoStyle.Render(m.infoToast)
 	} else if len(m.columns) > 0 {
 		colID := m.columns[m.selectedColumn]
 		cards := m.filteredCards[colID]
@@ -431,6 +1443,29 @@ func (m BoardModel) renderSecondHeader(width int) string {
 	return dimStyle.Render(left) + strings.Repeat(" ", padding) + right
 }
 
+// itemKindCounts counts the items that have no number to show: drafts,
+// private items, and items the token can't read
+type itemKindCounts struct {
+	drafts, private, restricted int
+	sso                         bool // Some restricted items are behind SAML SSO
+}
+
+func (m BoardModel) countItemKinds() itemKindCounts {
+	var c itemKindCounts
+	for _, card := range m.store.GetAllCards() {
+		switch card.ContentType {
+		case domain.ContentTypeDraftIssue:
+			c.drafts++
+		case domain.ContentTypePrivate:
+			c.private++
+		case domain.ContentTypeRestricted:
+			c.restricted++
+			c.sso = c.sso || card.AccessHint == gh.SSOHint
+		}
+	}
+	return c
+}
+
 // renderHeader renders a single header line with title on left and status on right
 func (m BoardModel) renderHeader(width int) string {
 	project := m.store.GetProject()
@@ -441,34 +1476,12 @@ func (m BoardModel) renderHeader(width int) string {
 
 	// Left side: project title
 	title := fmt.Sprintf("%s/%d - %s (by %s)", project.Owner, project.Number, project.Title, groupField.Name)
-
-	// Right side: status info
-	var statusParts []string
-
-	// Loading indicator
-	if m.loadingMore {
-		statusParts = append(statusParts, m.spinner.View()+"loading")
-	}
-
-	// Item count
-	totalItems := 0
-	for _, cards := range m.filteredCards {
-		totalItems += len(cards)
-	}
-	statusParts = append(statusParts, fmt.Sprintf("%d items", totalItems))
-
-	// Filter indicators
-	if m.filterMyOnly {
-		statusParts = append(statusParts, "@me")
-	}
-	if m.filterText != "" {
-		statusParts = append(statusParts, fmt.Sprintf("/%s", m.filterText))
+	if m.workspaceName != "" {
+		title = fmt.Sprintf("[%s] %s", m.workspaceName, title)
 	}
 
-	// Help hint
-	statusParts = append(statusParts, "[a]@me [?]help")
-
-	status := strings.Join(statusParts, " | ")
+	// Right side: status info
+	status := m.statusLine()
 
 	// Calculate padding to right-align status
 	leftLen := len(title)
@@ -479,7 +1492,11 @@ func (m BoardModel) renderHeader(width int) string {
 	}
 
 	// Build header line
-	return titleStyle.Render(title) + strings.Repeat(" ", padding) + dimStyle.Render(status)
+	style := titleStyle
+	if color, ok := m.accent(); ok {
+		style = style.Foreground(color)
+	}
+	return style.Render(title) + strings.Repeat(" ", padding) + dimStyle.Render(status)
 }
 
 // renderBoard renders the kanban columns within the given dimensions
@@ -499,32 +1516,8 @@ func (m BoardModel) renderBoard(totalWidth, totalHeight int) string {
 		colContentHeight = 3
 	}
 
-	// Calculate how many columns can fit at minimum width
-	maxVisibleCols := totalWidth / minColumnWidth
-	if maxVisibleCols < 1 {
-		maxVisibleCols = 1
-	}
-
-	// How many columns will we actually show?
-	visibleCols := maxVisibleCols
-	if visibleCols > numCols {
-		visibleCols = numCols
-	}
-
-	// Calculate column width to fill available space evenly
-	colWidth := totalWidth / visibleCols
-	if colWidth > maxColumnWidth {
-		colWidth = maxColumnWidth
-	}
-	if colWidth < minColumnWidth {
-		colWidth = minColumnWidth
-	}
-
-	// Content width inside column (minus border and padding: 2 border + 2 padding = 4)
-	innerWidth := colWidth - 4
-	if innerWidth < 10 {
-		innerWidth = 10
-	}
+	startCol, endCol, colWidth, innerWidth := m.columnLayout(totalWidth)
+	visibleCols := endCol - startCol
 
 	// Calculate how many card lines fit inside the column
 	// Reserve: 1 line for header, potentially 2 for scroll indicators (up/down)
@@ -533,15 +1526,9 @@ func (m BoardModel) renderBoard(totalWidth, totalHeight int) string {
 		maxCardLines = 1
 	}
 
-	// Determine visible column range based on columnOffset
-	startCol := m.columnOffset
-	endCol := startCol + visibleCols
-	if endCol > numCols {
-		endCol = numCols
-		startCol = endCol - visibleCols
-		if startCol < 0 {
-			startCol = 0
-		}
+	// A zoomed column takes the whole board
+	if m.zoomed && m.selectedColumn < numCols {
+		return m.renderColumn(m.columns[m.selectedColumn], true, totalWidth, colContentHeight, totalWidth-4, maxCardLines, m.selectedColumn+1)
 	}
 
 	// Build only visible columns
@@ -579,6 +1566,68 @@ func (m BoardModel) renderBoard(totalWidth, totalHeight int) string {
 	return lipgloss.JoinHorizontal(lipgloss.Top, columnViews...)
 }
 
+// columnWidths returns the narrowest and widest a column may be
+func (m BoardModel) columnWidths() (minWidth, maxWidth int) {
+	minWidth, maxWidth = minColumnWidth, maxColumnWidth
+	if m.maxColumn > 0 {
+		maxWidth = m.maxColumn
+	}
+	if m.minColumn > 0 {
+		minWidth = m.minColumn
+	} else {
+		// A narrow maximum alone narrows the minimum with it
+		minWidth = min(minWidth, maxWidth)
+	}
+	return minWidth, max(maxWidth, minWidth)
+}
+
+// columnLayout returns the range of columns that fit in totalWidth, starting
+// at columnOffset, and the outer and inner width of each
+func (m BoardModel) columnLayout(totalWidth int) (startCol, endCol, colWidth, innerWidth int) {
+	numCols := len(m.columns)
+
+	minWidth, maxWidth := m.columnWidths()
+
+	// Calculate how many columns can fit at minimum width
+	maxVisibleCols := totalWidth / minWidth
+	if maxVisibleCols < 1 {
+		maxVisibleCols = 1
+	}
+
+	// How many columns will we actually show?
+	visibleCols := maxVisibleCols
+	if visibleCols > numCols {
+		visibleCols = numCols
+	}
+
+	// Calculate column width to fill available space evenly
+	colWidth = totalWidth / visibleCols
+	if colWidth > maxWidth {
+		colWidth = maxWidth
+	}
+	if colWidth < minWidth {
+		colWidth = minWidth
+	}
+
+	// Content width inside column (minus border and padding: 2 border + 2 padding = 4)
+	innerWidth = colWidth - 4
+	if innerWidth < 10 {
+		innerWidth = 10
+	}
+
+	// Determine visible column range based on columnOffset
+	startCol = m.columnOffset
+	endCol = startCol + visibleCols
+	if endCol > numCols {
+		endCol = numCols
+		startCol = endCol - visibleCols
+		if startCol < 0 {
+			startCol = 0
+		}
+	}
+	return startCol, endCol, colWidth, innerWidth
+}
+
 // renderColumn renders a single column with proper sizing
 // height is the inner height (content area, not including border)
 // maxCardLines is the max lines available for cards (excluding header)
@@ -588,19 +1637,26 @@ func (m BoardModel) renderColumn(colID string, selected bool, width, innerHeight
 
 	// Header: [N] Name (count)
 	headerText := fmt.Sprintf("[%d] %s (%d)", colNum, name, len(cards))
-	if len(headerText) > innerWidth {
-		headerText = headerText[:innerWidth-1] + "…"
+	if label := sortLabel(m.columnSortKey(colID)); label != "" {
+		headerText += " " + label
+	}
+	if m.zoomed {
+		headerText += " · z/esc: all columns"
+	}
+	if runes := []rune(headerText); len(runes) > innerWidth {
+		headerText = string(runes[:innerWidth-1]) + "…"
 	}
 
 	// Get scroll state
 	scrollOffset := m.scrollOffset[colID]
 	selectedIdx := m.selectedCard[colID]
 
-	// Calculate how many card slots we have
+	// Calculate how many card lines we have; a card takes perCard of them
 	// maxCardLines is total lines minus header (1 line)
+	perCard := m.cardLines()
 	cardSlots := maxCardLines - 1 // -1 for header line
-	if cardSlots < 1 {
-		cardSlots = 1
+	if cardSlots < perCard {
+		cardSlots = perCard
 	}
 
 	// Check if we need scroll indicators
@@ -614,7 +1670,7 @@ func (m BoardModel) renderColumn(colID string, selected bool, width, innerHeight
 	}
 
 	// Calculate how many cards we can show
-	endIdx := scrollOffset + availableSlots
+	endIdx := scrollOffset + availableSlots/perCard
 	if endIdx > len(cards) {
 		endIdx = len(cards)
 	}
@@ -623,12 +1679,33 @@ func (m BoardModel) renderColumn(colID string, selected bool, width, innerHeight
 	if endIdx < len(cards) {
 		needDownIndicator = true
 		availableSlots--
-		endIdx = scrollOffset + availableSlots
+		endIdx = scrollOffset + availableSlots/perCard
 		if endIdx > len(cards) {
 			endIdx = len(cards)
 		}
 	}
 
+	// A scrollbar stands in for the indicators, leaving their lines to cards
+	if m.scrollbar {
+		needUpIndicator, needDownIndicator = false, false
+		cardSlots = max(maxCardLines, perCard) // Every line below the header
+		availableSlots = cardSlots
+		endIdx = min(scrollOffset+availableSlots/perCard, len(cards))
+	}
+
+	// Section sub-headers take lines too, so fewer cards fit
+	var sections, sectionCounts []int
+	if m.splitByType {
+		sections, sectionCounts = m.cardSections(cards)
+		if !selected {
+			selectedIdx = -1
+		}
+		scrollOffset, endIdx, availableSlots = sectionWindow(sections, scrollOffset, selectedIdx, cardSlots/perCard, !m.scrollbar)
+		availableSlots *= perCard
+		needUpIndicator = scrollOffset > 0 && !m.scrollbar
+		needDownIndicator = endIdx < len(cards) && !m.scrollbar
+	}
+
 	// Build column content with exact line count
 	var lines []string
 
@@ -640,20 +1717,51 @@ func (m BoardModel) renderColumn(colID string, selected bool, width, innerHeight
 		lines = append(lines, dimStyle.Render(fmt.Sprintf("↑ %d more", scrollOffset)))
 	}
 
-	// Render visible cards
+	// Render visible cards. Parent lines only use the rows left over once
+	// every card fits, so they never push a card out of view.
+	spareLines := availableSlots - (endIdx-scrollOffset)*perCard
+	if sections != nil {
+		spareLines -= sectionHeaders(sections, scrollOffset, endIdx)
+	}
 	for i := scrollOffset; i < endIdx; i++ {
 		cardID := cards[i]
 		card, err := m.store.GetCard(cardID)
 		if err != nil {
 			continue
 		}
+		if sections != nil && startsSection(sections, scrollOffset, i) {
+			lines = append(lines, renderSectionHeader(sections[i], sectionCounts[sections[i]], innerWidth))
+		}
 
 		cardText := m.formatCardText(card, innerWidth-3) // 3 for "> " or "  " prefix
+		if m.zoomed {
+			cardText = m.formatZoomedCard(card, innerWidth-3)
+		}
+		mark, changed := m.cardMark(cardID)
 		if selected && i == selectedIdx {
-			lines = append(lines, selectedCardStyle.Render("> "+cardText))
+			lines = append(lines, selectedCardStyle.Render(">"+mark+cardText))
+		} else if changed {
+			lines = append(lines, changedCardStyle.Render(" "+mark+cardText))
+		} else if m.isStale(card) {
+			lines = append(lines, staleCardStyle.Render(" "+mark+cardText))
 		} else {
-			lines = append(lines, cardStyle.Render("  "+cardText))
+			lines = append(lines, cardStyle.Render(" "+mark+cardText))
+		}
+		if perCard > 1 {
+			lines = append(lines, dimStyle.Render("   "+formatCardMeta(card, innerWidth-3)))
 		}
+		if perCard > 2 {
+			lines = append(lines, dimStyle.Render("   "+formatCardDetails(card, innerWidth-3)))
+		}
+		if card.Parent != nil && spareLines > 0 {
+			lines = append(lines, dimStyle.Render("   "+formatParentLine(card.Parent, innerWidth-5)))
+			spareLines--
+		}
+	}
+
+	if m.scrollbar && endIdx-scrollOffset < len(cards) {
+		bar := scrollbarRows(len(cards), scrollOffset, endIdx-scrollOffset, cardSlots)
+		lines = append(lines[:1], withScrollbar(lines[1:], bar, innerWidth)...)
 	}
 
 	// Scroll down indicator
@@ -673,7 +1781,7 @@ func (m BoardModel) renderColumn(colID string, selected bool, width, innerHeight
 	// Create column style - the height here is for the CONTENT area inside the border
 	borderColor := lipgloss.Color("240")
 	if selected {
-		borderColor = lipgloss.Color("205")
+		borderColor = m.accentColor()
 	}
 
 	// Width includes border (2) + padding (2) = content width + 4
@@ -703,11 +1811,25 @@ func (m BoardModel) formatCardText(card *domain.Card, maxWidth int) string {
 		}
 	case domain.ContentTypeDraftIssue:
 		suffix = "(draft)"
+	case domain.ContentTypeRestricted:
+		suffix = "(restricted)"
 	case domain.ContentTypePrivate:
 		suffix = "(pvt)"
 	}
+	if card.AuthorIsBot {
+		suffix = strings.TrimSpace("bot " + suffix)
+	}
+
+	// PR status goes before the suffix, and counts toward its width
+	badge := prBadge(card)
+	if suffix != "" {
+		suffix = dimStyle.Render(suffix)
+	}
+	if badge != "" {
+		suffix = strings.TrimSpace(badge + " " + suffix)
+	}
 
-	suffixLen := len(suffix)
+	suffixLen := lipgloss.Width(suffix)
 	if suffixLen == 0 {
 		// No suffix, just truncate title
 		if len(title) > maxWidth {
@@ -734,7 +1856,7 @@ func (m BoardModel) formatCardText(card *domain.Card, maxWidth int) string {
 		padding = 1
 	}
 
-	return title + strings.Repeat(" ", padding) + dimStyle.Render(suffix)
+	return title + strings.Repeat(" ", padding) + suffix
 }
 
 // rebuildColumns rebuilds column structure from store
@@ -748,13 +1870,26 @@ func (m *BoardModel) rebuildColumns() {
 	m.columnNames = make(map[string]string)
 
 	for _, opt := range groupField.Options {
+		if m.isHidden(opt.Name) {
+			continue
+		}
 		m.columns = append(m.columns, opt.ID)
 		m.columnNames[opt.ID] = opt.Name
 	}
 
-	// Add "No Status" column
-	m.columns = append(m.columns, store.NoStatusKey)
-	m.columnNames[store.NoStatusKey] = "No Status"
+	// Add "No Status" column, named for what's missing when grouped by a
+	// virtual field ("Unassigned")
+	noStatus := store.NoValueName(groupField)
+	if !m.isHidden(noStatus) {
+		m.columns = append(m.columns, store.NoStatusKey)
+		m.columnNames[store.NoStatusKey] = noStatus
+	}
+
+	// Cards in deleted options get a column of their own rather than vanishing
+	if _, ok := m.boardColumns()[removedOptionKey]; ok && !m.isHidden(removedOptionName) {
+		m.columns = append(m.columns, removedOptionKey)
+		m.columnNames[removedOptionKey] = removedOptionName
+	}
 
 	// Ensure selected column is valid
 	if m.selectedColumn >= len(m.columns) {
@@ -764,10 +1899,7 @@ func (m *BoardModel) rebuildColumns() {
 
 // applyFilter filters cards and groups them by column
 func (m *BoardModel) applyFilter() {
-	storeColumns, err := m.store.GetColumns()
-	if err != nil {
-		storeColumns = make(map[string][]string)
-	}
+	storeColumns := m.boardColumns()
 
 	m.filteredCards = make(map[string][]string)
 
@@ -779,6 +1911,10 @@ func (m *BoardModel) applyFilter() {
 	// Get current user login for "my items" filter
 	viewerLogin := m.store.GetViewerLogin()
 
+	// Text filter: qualifiers such as label:bug, then title words case-folded,
+	// optionally diacritic-insensitive, exact when quoted
+	query := filter.Parse(m.filterText, m.foldDiacritics)
+
 	// Populate with filtered cards
 	for colID, cardIDs := range storeColumns {
 		filtered := make([]string, 0)
@@ -789,7 +1925,26 @@ func (m *BoardModel) applyFilter() {
 			}
 
 			// Text filter
-			if m.filterText != "" && !strings.Contains(strings.ToLower(card.Title), strings.ToLower(m.filterText)) {
+			if !query.Match(card, viewerLogin) {
+				continue
+			}
+
+			// Label filter (cards without loaded labels don't match)
+			if m.filterLabel != "" && !hasLabel(card, m.filterLabel) {
+				continue
+			}
+
+			if m.hideBots && card.AuthorIsBot {
+				continue
+			}
+
+			// Triage mode only shows untriaged cards
+			if m.triageMode && !needsTriage(card) {
+				continue
+			}
+
+			// Sweep mode only shows Done cards untouched for the sweep age
+			if m.sweepMode && !m.needsSweep(colID, card) {
 				continue
 			}
 
@@ -807,11 +1962,20 @@ func (m *BoardModel) applyFilter() {
 				}
 			}
 
+			// Team filter (applies once members have loaded)
+			if m.teamMembers != nil && !m.assignedToTeam(card.Assignees) {
+				continue
+			}
+
 			filtered = append(filtered, itemID)
 		}
+		m.sortCardIDs(filtered, m.columnSortKey(colID))
 		m.filteredCards[colID] = filtered
 	}
 
+	m.groupByType()
+	m.groupByLane()
+
 	// Reset scroll offsets and selection when filter changes
 	// to avoid showing "↑ N more" when results fit on screen
 	for colID := range m.filteredCards {
@@ -881,10 +2045,32 @@ func (m *BoardModel) jumpToCard(idx int) {
 func (m *BoardModel) adjustScroll(colID string) {
 	selectedIdx := m.selectedCard[colID]
 	scrollOffset := m.scrollOffset[colID]
+	visibleCards := m.visibleCardCount(colID)
+
+	// Scroll up if needed
+	if selectedIdx < scrollOffset {
+		m.scrollOffset[colID] = selectedIdx
+	}
+
+	// Scroll down if needed
+	if selectedIdx >= scrollOffset+visibleCards {
+		m.scrollOffset[colID] = selectedIdx - visibleCards + 1
+	}
+}
+
+// visibleCardCount returns how many of a column's cards fit on screen at once
+func (m BoardModel) visibleCardCount(colID string) int {
+	return max(m.cardRows(colID)/m.cardLines(), 1)
+}
 
+// cardRows returns how many lines of a column are left for cards
+func (m BoardModel) cardRows(colID string) int {
 	// Calculate visible cards based on current dimensions
 	contentHeight := m.height - headerLines - 2 // 2 for column borders
-	if m.moveMode {
+	if len(m.pinnedPresets()) > 0 {
+		contentHeight--
+	}
+	if m.moveMode || m.triageMode || m.sweepMode {
 		contentHeight--
 	}
 	if m.filterMode {
@@ -896,16 +2082,14 @@ func (m *BoardModel) adjustScroll(colID string) {
 	}
 
 	visibleCards := cardsHeight
-
-	// Scroll up if needed
-	if selectedIdx < scrollOffset {
-		m.scrollOffset[colID] = selectedIdx
+	if m.scrollbar {
+		// Every line below the column header, as renderColumn fills them
+		visibleCards = max(contentHeight-2, 1)
 	}
-
-	// Scroll down if needed
-	if selectedIdx >= scrollOffset+visibleCards {
-		m.scrollOffset[colID] = selectedIdx - visibleCards + 1
+	if m.splitByType {
+		visibleCards = max(visibleCards-m.sectionCount(colID), 1)
 	}
+	return visibleCards
 }
 
 // adjustColumnScroll ensures the selected column is visible (horizontal carousel)
@@ -915,7 +2099,8 @@ func (m *BoardModel) adjustColumnScroll() {
 	}
 
 	// Calculate how many columns fit on screen (same logic as renderBoard)
-	visibleCols := m.width / minColumnWidth
+	minWidth, _ := m.columnWidths()
+	visibleCols := m.width / minWidth
 	if visibleCols < 1 {
 		visibleCols = 1
 	}
@@ -934,6 +2119,37 @@ func (m *BoardModel) adjustColumnScroll() {
 	}
 }
 
+// summaryStrip describes the board position for the detail view: the current
+// column and the titles of the cards around the selection
+func (m BoardModel) summaryStrip() string {
+	if len(m.columns) == 0 {
+		return ""
+	}
+	colID := m.columns[m.selectedColumn]
+	cards := m.filteredCards[colID]
+	idx := m.selectedCard[colID]
+	if idx >= len(cards) {
+		return ""
+	}
+
+	title := func(i int) string {
+		if card, err := m.store.GetCard(cards[i]); err == nil {
+			return card.Title
+		}
+		return ""
+	}
+
+	parts := []string{fmt.Sprintf("%s %d/%d", m.columnNames[colID], idx+1, len(cards))}
+	if idx > 0 {
+		parts = append(parts, "‹ "+title(idx-1))
+	}
+	parts = append(parts, "▸ "+title(idx))
+	if idx < len(cards)-1 {
+		parts = append(parts, title(idx+1)+" ›")
+	}
+	return strings.Join(parts, " · ")
+}
+
 // getSelectedCard returns the currently selected card
 func (m BoardModel) getSelectedCard() *domain.Card {
 	if len(m.columns) == 0 {
@@ -960,41 +2176,119 @@ func (m BoardModel) getSelectedCard() *domain.Card {
 }
 
 // moveCardToColumn moves the selected card to a target column
-func (m BoardModel) moveCardToColumn(targetColID string) tea.Cmd {
+func (m *BoardModel) moveCardToColumn(targetColID string) tea.Cmd {
 	card := m.getSelectedCard()
 	if card == nil {
 		return nil
 	}
+	return m.moveCard(card, targetColID)
+}
 
+// moveCard moves a card to a column optimistically and sends the change
+// through the outbox
+func (m *BoardModel) moveCard(card *domain.Card, targetColID string) tea.Cmd {
+	from := card.GroupOptionID
 	newOptionID := targetColID
 	if targetColID == store.NoStatusKey {
 		newOptionID = ""
 	}
 
+	// A second press while the same move is in flight is dropped; a move to
+	// another column goes ahead
+	key := "move:" + card.ItemID + ":" + newOptionID
+	ctx, ok := m.guard.begin(m.ctx, key)
+	if !ok {
+		m.errorToast = fmt.Sprintf("Already moving %s to %s", cardLabel(card), m.columnNames[targetColID])
+		return nil
+	}
+
 	// Optimistic update
-	err := m.store.MoveCard(card.ItemID, newOptionID)
-	if err != nil {
+	apply := func() error { return m.store.MoveCard(card.ItemID, newOptionID) }
+	if err := apply(); err != nil {
+		m.guard.end(key)
 		return func() tea.Msg { return moveErrorMsg{err: err} }
 	}
 
-	// Send mutation to API
-	return func() tea.Msg {
-		project := m.store.GetProject()
-		groupField := m.store.GetGroupField()
-		if project == nil || groupField == nil {
-			return moveErrorMsg{err: fmt.Errorf("missing project or field")}
+	// Send mutation to API through the outbox, which keeps it for retry if it
+	// fails. The field is the one moved in, even if the board is regrouped.
+	label := fmt.Sprintf("Move %s to %s", cardLabel(card), m.columnNames[targetColID])
+	groupField := m.store.GetGroupField()
+	return m.outbox.enqueue(ctx, &outboxEntry{
+		label: label,
+		apply: apply,
+		send: func(ctx context.Context) error {
+			defer m.guard.end(key)
+			project := m.store.GetProject()
+			if project == nil || groupField == nil {
+				return fmt.Errorf("missing project or field")
+			}
+			if store.IsAssigneeField(groupField) {
+				return m.client.ReassignItem(ctx, card.ContentID, from, newOptionID)
+			}
+			return m.client.UpdateItemField(ctx, project.ID, card.ItemID, groupField.ID, gh.SingleSelectValue(newOptionID))
+		},
+		done: func(err error) tea.Msg {
+			if err != nil {
+				return moveErrorMsg{err: err, itemID: card.ItemID, from: from, to: newOptionID}
+			}
+			action := store.Action{Kind: store.ActionMove, Label: label, ItemID: card.ItemID, From: from, To: newOptionID, ContentID: card.ContentID}
+			if groupField != nil {
+				action.Field = *groupField
+			}
+			return moveSuccessMsg{action: action}
+		},
+	})
+}
+
+// editCard suspends the TUI and opens the card in the user's editor
+func (m BoardModel) editCard(card *domain.Card) tea.Cmd {
+	body, ok := m.store.GetBody(card.ItemID)
+	if !ok {
+		// Fetch the body first; editing resumes on editDetailsLoadedMsg
+		return func() tea.Msg {
+			details, err := m.client.GetItemDetails(m.ctx, []string{card.ContentID})
+			if err != nil {
+				return contentErrorMsg{err: err}
+			}
+			return editDetailsLoadedMsg{card: card, details: details[card.ContentID]}
 		}
+	}
+
+	withBody := *card
+	withBody.Body = body
+	path, err := editor.WriteTemp(&withBody)
+	if err != nil {
+		return func() tea.Msg { return contentErrorMsg{err: err} }
+	}
 
-		err := m.client.UpdateItemField(m.ctx, project.ID, card.ItemID, groupField.ID, newOptionID)
+	return tea.ExecProcess(editor.Cmd(path), func(err error) tea.Msg {
+		return m.reply.tag(editorClosedMsg{card: card, path: path, err: err})
+	})
+}
+
+// saveContent sends edited title and body to GitHub
+func (m BoardModel) saveContent(card *domain.Card, title, body string) tea.Cmd {
+	return func() tea.Msg {
+		err := m.client.UpdateContent(m.ctx, card.ContentType, card.ContentID, title, body)
 		if err != nil {
-			return moveErrorMsg{err: err}
+			return contentErrorMsg{err: err}
 		}
-		return moveSuccessMsg{}
+		return contentSavedMsg{card: card, title: title, body: body}
 	}
 }
 
+// nextPageSize returns the size of the next page within the fetch budget, 0 when spent
+func (m BoardModel) nextPageSize() int {
+	return m.budget.NextPageSize(m.pagesLoaded, len(m.store.GetAllCards()))
+}
+
 // loadNextPage fetches the next page of items (for lazy loading)
 func (m BoardModel) loadNextPage(cursor string) tea.Cmd {
+	pageSize := m.nextPageSize()
+	if pageSize == 0 {
+		// Never request an empty page
+		pageSize = m.budget.Unlimited().NextPageSize(0, 0)
+	}
 	return func() tea.Msg {
 		project := m.store.GetProject()
 		groupField := m.store.GetGroupField()
@@ -1002,7 +2296,7 @@ func (m BoardModel) loadNextPage(cursor string) tea.Cmd {
 			return pageLoadedMsg{err: fmt.Errorf("missing project or field")}
 		}
 
-		cards, nextCursor, hasMore, err := m.client.GetItems(m.ctx, project.ID, groupField.Name, cursor, 100)
+		cards, nextCursor, hasMore, err := m.getItems(project, groupField, cursor, pageSize)
 		if err != nil {
 			return pageLoadedMsg{err: err}
 		}
@@ -1020,54 +2314,127 @@ func (m BoardModel) loadNextPage(cursor string) tea.Cmd {
 	}
 }
 
-// loadAllItems fetches ALL items from GitHub (blocking - used for refresh)
+// getItems fetches a page of items, taking the first page from the one
+// fetched at startup when it matches
+func (m BoardModel) getItems(project *domain.Project, groupField *domain.FieldDef, cursor string, pageSize int) ([]domain.Card, string, bool, error) {
+	if cursor == "" {
+		if cards, nextCursor, hasMore, ok := m.firstPage.take(m.ctx, project, groupField.Name); ok {
+			return cards, nextCursor, hasMore, nil
+		}
+	}
+	return m.client.GetItems(m.ctx, project.ID, groupField.Name, cursor, pageSize)
+}
+
+// loadAllItems fetches items from GitHub until the fetch budget is spent (blocking - used for refresh)
 func (m BoardModel) loadAllItems() tea.Cmd {
 	return func() tea.Msg {
-		project := m.store.GetProject()
-		groupField := m.store.GetGroupField()
-		if project == nil || groupField == nil {
-			return itemsErrorMsg{err: fmt.Errorf("missing project or field")}
+		m.store.Clear()
+		batch, err := m.fetchAllItems()
+		if err != nil {
+			return itemsErrorMsg{err: err}
 		}
+		m.store.UpsertCards(batch.cards)
+		m.store.SetPagination(batch.cursor, batch.truncated)
+		return itemsLoadedMsg{pages: batch.pages}
+	}
+}
 
-		m.store.Clear()
+// loadItems starts loading the board: page by page into an empty board, or
+// all at once to replace cached items
+func (m BoardModel) loadItems() tea.Cmd {
+	if !m.cachedAt.IsZero() {
+		return m.revalidate()
+	}
+	return m.loadNextPage("") // Start loading first page immediately
+}
 
-		var allCards []*domain.Card
-		cursor := ""
-		pageSize := 100
+// revalidate fetches items like loadAllItems but leaves the board's cards in
+// place until they have all arrived, so a board shown from the cache stays
+// readable while it refreshes or when GitHub can't be reached
+func (m BoardModel) revalidate() tea.Cmd {
+	return func() tea.Msg {
+		batch, err := m.fetchAllItems()
+		return revalidatedMsg{batch: batch, err: err}
+	}
+}
 
-		// Keep loading until we have all items
-		for {
-			cards, nextCursor, hasMore, err := m.client.GetItems(m.ctx, project.ID, groupField.Name, cursor, pageSize)
-			if err != nil {
-				return itemsErrorMsg{err: err}
-			}
+// itemBatch is every item fetched in one go. cursor is where the fetch
+// budget stopped loading, when truncated.
+type itemBatch struct {
+	cards     []*domain.Card
+	cursor    string
+	truncated bool
+	pages     int
+}
 
-			for i := range cards {
-				allCards = append(allCards, &cards[i])
-			}
+// fetchAllItems fetches pages of items until all are loaded or the fetch
+// budget is spent
+func (m BoardModel) fetchAllItems() (itemBatch, error) {
+	project := m.store.GetProject()
+	groupField := m.store.GetGroupField()
+	if project == nil || groupField == nil {
+		return itemBatch{}, fmt.Errorf("missing project or field")
+	}
 
-			if !hasMore || nextCursor == "" {
-				break
-			}
-			cursor = nextCursor
+	var batch itemBatch
+	cursor := ""
+
+	// Keep loading until we have all items or the budget is spent
+	for {
+		cards, nextCursor, hasMore, err := m.getItems(project, groupField, cursor, m.budget.NextPageSize(batch.pages, len(batch.cards)))
+		if err != nil {
+			return itemBatch{}, err
 		}
+		batch.pages++
 
-		m.store.UpsertCards(allCards)
-		m.store.SetPagination("", false)
+		for i := range cards {
+			batch.cards = append(batch.cards, &cards[i])
+		}
 
-		return itemsLoadedMsg{}
+		if !hasMore || nextCursor == "" {
+			return batch, nil
+		}
+		cursor = nextCursor
+		if m.budget.NextPageSize(batch.pages, len(batch.cards)) == 0 {
+			batch.cursor, batch.truncated = cursor, true
+			return batch, nil
+		}
 	}
 }
 
 // Message types
 type (
-	itemsLoadedMsg      struct{}
-	itemsErrorMsg       struct{ err error }
-	moveSuccessMsg      struct{}
-	moveErrorMsg        struct{ err error }
+	itemsLoadedMsg struct{ pages int }
+	itemsErrorMsg  struct{ err error }
+	moveSuccessMsg struct{ action store.Action }
+	moveErrorMsg   struct {
+		err      error
+		itemID   string // Card to put back in its column, from
+		from, to string
+	}
 	changeGroupFieldMsg struct{}
-	openDetailMsg       struct{ card *domain.Card }
-	pageLoadedMsg       struct {
+	switchProjectMsg    struct{}
+	switchOwnerMsg      struct{}
+	openDetailMsg       struct {
+		card    *domain.Card
+		summary string // One-line board context for the detail view
+	}
+	contentErrorMsg      struct{ err error }
+	editDetailsLoadedMsg struct {
+		card    *domain.Card
+		details domain.ItemDetails
+	}
+	editorClosedMsg struct {
+		card *domain.Card
+		path string
+		err  error
+	}
+	contentSavedMsg struct {
+		card  *domain.Card
+		title string
+		body  string
+	}
+	pageLoadedMsg struct {
 		cards      []*domain.Card
 		nextCursor string
 		hasMore    bool
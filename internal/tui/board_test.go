@@ -1,13 +1,31 @@
 package tui
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/h0rv/ghp/internal/cache"
+	"github.com/h0rv/ghp/internal/config"
 	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/editor"
+	"github.com/h0rv/ghp/internal/fixture"
+	"github.com/h0rv/ghp/internal/gh"
+	"github.com/h0rv/ghp/internal/session"
 	"github.com/h0rv/ghp/internal/store"
+	"github.com/h0rv/ghp/internal/uistate"
+	"github.com/h0rv/ghp/internal/workspace"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -114,6 +132,25 @@ func TestBoardModel_ApplyFilterWithText(t *testing.T) {
 	assert.Equal(t, 0, len(board.filteredCards["opt-progress"]), "Should have 0 matching cards")
 }
 
+func TestBoardModel_ApplyFilterWithQualifiers(t *testing.T) {
+	s := createTestStore()
+	s.SetViewerLogin("alice")
+	for id, assignee := range map[string]string{"card-1": "alice", "card-3": "bob", "card-4": "alice"} {
+		card, err := s.GetCard(id)
+		require.NoError(t, err)
+		card.Assignees = []string{assignee}
+	}
+	board := NewBoardModel(s, nil, context.Background())
+
+	(&board).rebuildColumns()
+	board.filterText = "assignee:@me -state:closed task"
+	(&board).applyFilter()
+
+	assert.Equal(t, []string{"card-1"}, board.filteredCards["opt-todo"])
+	assert.Empty(t, board.filteredCards["opt-progress"])
+	assert.Equal(t, []string{"card-4"}, board.filteredCards["opt-done"])
+}
+
 func TestBoardModel_Navigation(t *testing.T) {
 	s := createTestStore()
 	board := NewBoardModel(s, nil, context.Background())
@@ -336,3 +373,3019 @@ func TestBoardModel_ColumnStyles(t *testing.T) {
 	lines := strings.Split(view, "\n")
 	assert.Greater(t, len(lines), 1, "Should have multiple lines")
 }
+
+func TestBoardModel_TriageMode(t *testing.T) {
+	s := createTestStore()
+	s.UpsertCards([]*domain.Card{
+		{ItemID: "card-8", Title: "Assigned No Status", ContentType: domain.ContentTypeIssue, Number: 108, Assignees: []string{"alice"}},
+	})
+	board := NewBoardModel(s, nil, context.Background())
+
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	board.width = 120
+	board.height = 40
+
+	// Enter triage mode
+	model, _ := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+	board = model.(BoardModel)
+
+	assert.True(t, board.triageMode)
+	assert.Equal(t, store.NoStatusKey, board.columns[board.selectedColumn], "Should focus No Status column")
+	assert.Equal(t, []string{"card-7"}, board.filteredCards[store.NoStatusKey], "Only unassigned cards without status")
+	assert.Empty(t, board.filteredCards["opt-todo"])
+
+	// Exit triage mode
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	board = model.(BoardModel)
+
+	assert.False(t, board.triageMode)
+	assert.Equal(t, 2, len(board.filteredCards[store.NoStatusKey]))
+}
+
+func TestBoardModel_StatsOverlay(t *testing.T) {
+	s := createTestStore()
+	s.UpsertCards([]*domain.Card{
+		{ItemID: "card-pr", ContentID: "pr-1", Title: "Add feature", ContentType: domain.ContentTypePullRequest, Number: 201, GroupOptionID: "opt-progress"},
+	})
+	board := NewBoardModel(s, nil, context.Background())
+
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	board.width = 120
+	board.height = 40
+	board.showStats = true
+	board.prMeta = map[string]domain.PRMeta{
+		"pr-1": {ContentID: "pr-1", CreatedAt: "2024-01-01T00:00:00Z", ReviewCount: 1},
+	}
+
+	view := board.View()
+	assert.Contains(t, view, "Pull request stats")
+	assert.Contains(t, view, "100%")
+
+	// Names are fitted by display width, not bytes
+	assert.Equal(t, "Révision  ", fitName("Révision", 10))
+	assert.Equal(t, "進行中の…", fitName("進行中の作業", 9))
+	assert.Equal(t, 9, ansi.StringWidth(fitName("進行中の作業", 9)))
+
+	// Any close key dismisses the overlay
+	model, _ := board.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	board = model.(BoardModel)
+	assert.False(t, board.showStats)
+}
+
+func TestBoardModel_RecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	rec, err := session.NewRecorder(path)
+	require.NoError(t, err)
+
+	board := NewBoardModel(createTestStore(), nil, context.Background())
+	board.recorder = rec
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+
+	// Move right twice, then open help
+	for _, key := range []rune{'l', 'l', '?'} {
+		model, _ := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{key}})
+		board = model.(BoardModel)
+	}
+	// Timers are never recorded
+	board.recordFrame(autoRefreshMsg{})
+	board.recordFrame(presenceTickMsg{})
+	require.NoError(t, rec.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	frames, err := session.Load(f)
+	require.NoError(t, err)
+	require.Len(t, frames, 3)
+	assert.Equal(t, "input", frames[0].Event)
+	assert.Equal(t, "help", frames[2].Mode)
+	assert.Len(t, frames[2].Cards, 7)
+
+	// Replay starts at the first frame and steps forward on demand
+	replay := NewReplayModel(frames, 1)
+	assert.Equal(t, 1, replay.board.selectedColumn)
+
+	model, _ := replay.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'l'}})
+	replay = model.(ReplayModel)
+	assert.True(t, replay.paused)
+	assert.Equal(t, 2, replay.board.selectedColumn)
+	assert.Equal(t, 2, len(replay.board.filteredCards["opt-todo"]))
+}
+
+func TestBoardModel_ReducedMotion(t *testing.T) {
+	board := NewBoardModel(store.New(), nil, context.Background())
+	board.reducedMotion = true
+	board.loading = true
+	board.width = 80
+	board.height = 24
+
+	assert.Contains(t, board.View(), "Loading…")
+
+	// Spinner ticks are swallowed so the animation never restarts
+	_, cmd := board.Update(board.spinner.Tick())
+	assert.Nil(t, cmd)
+}
+
+func TestBoardModel_ApplyFilterUnicode(t *testing.T) {
+	s := createTestStore()
+	s.UpsertCards([]*domain.Card{
+		{ItemID: "card-8", Title: "Update Résumé page", ContentType: domain.ContentTypeIssue, Number: 108, GroupOptionID: "opt-todo"},
+	})
+	board := NewBoardModel(s, nil, context.Background())
+	(&board).rebuildColumns()
+
+	board.filterText = "RÉSUMÉ"
+	(&board).applyFilter()
+	assert.Equal(t, []string{"card-8"}, board.filteredCards["opt-todo"], "Case folding applies to accented letters")
+
+	board.filterText = "resume"
+	(&board).applyFilter()
+	assert.Empty(t, board.filteredCards["opt-todo"], "Diacritics matter unless folding is enabled")
+
+	board.foldDiacritics = true
+	(&board).applyFilter()
+	assert.Equal(t, []string{"card-8"}, board.filteredCards["opt-todo"])
+
+	board.filterText = `"task 1"`
+	(&board).applyFilter()
+	assert.Empty(t, board.filteredCards["opt-todo"], "Quoted filters are case-sensitive")
+}
+
+func TestBoardModel_Workspace(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	board := NewBoardModel(createTestStore(), nil, context.Background())
+	(&board).applyWorkspace(&workspace.Workspace{
+		Name: "sprint", Filter: "Task", HiddenColumns: []string{"done"},
+		BoardSort: "title", ColumnSorts: map[string]string{"Todo": "-number"},
+	})
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+
+	assert.Equal(t, []string{"opt-todo", "opt-progress", store.NoStatusKey}, board.columns, "Hidden columns match case-insensitively")
+	assert.Equal(t, "Task", board.filterText)
+	assert.Equal(t, []string{"card-2", "card-1"}, board.filteredCards["opt-todo"])
+
+	// The workspace's sorts win over the project's saved ones
+	model, _ := board.Update(uiStateLoadedMsg{state: &uistate.State{BoardSort: "-updated"}})
+	board = model.(BoardModel)
+	assert.Equal(t, "title", board.columnSortKey("opt-progress"))
+	assert.Equal(t, "-number", board.columnSortKey("opt-todo"))
+
+	// Hide another column, then save the layout under a new name
+	board.selectedColumn = 2
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	board = model.(BoardModel)
+	assert.Equal(t, []string{"opt-todo", "opt-progress"}, board.columns)
+
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'W'}})
+	board = model.(BoardModel)
+	require.True(t, board.workspaceMode)
+	board.workspaceInput.SetValue("review")
+	model, cmd := board.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	board = model.(BoardModel)
+	require.NotNil(t, cmd)
+	model, _ = board.Update(cmd())
+	board = model.(BoardModel)
+
+	assert.Equal(t, "review", board.workspaceName)
+	saved, err := workspace.Get("review")
+	require.NoError(t, err)
+	assert.Equal(t, "test-owner", saved.Owner)
+	assert.Equal(t, 1, saved.Project)
+	assert.Equal(t, []string{"No Status", "done"}, saved.HiddenColumns)
+	assert.Equal(t, "title", saved.BoardSort)
+	assert.Equal(t, map[string]string{"Todo": "-number"}, saved.ColumnSorts)
+
+	// Show everything again
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'X'}})
+	board = model.(BoardModel)
+	assert.Len(t, board.columns, 4)
+}
+
+func TestAppModel_SwitchProject(t *testing.T) {
+	s := createTestStore()
+	app := NewAppModel(nil, s, context.Background(), "test-owner", 1, "Status")
+	app.ownerLogin = "test-owner"
+	app.projectsByOwner["test-owner"] = []domain.Project{
+		{ID: "proj-1", Number: 1, Title: "Test Project", Owner: "test-owner"},
+		{ID: "proj-2", Number: 2, Title: "Other Project", Owner: "test-owner"},
+	}
+
+	model, _ := app.Update(boardReadyMsg{})
+	app = model.(AppModel)
+	require.Equal(t, ScreenBoard, app.currentScreen)
+
+	// The switcher reuses the cached project list instead of refetching
+	model, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'P'}})
+	app = model.(AppModel)
+	require.NotNil(t, cmd)
+	model, cmd = app.Update(cmd())
+	app = model.(AppModel)
+	require.NotNil(t, cmd)
+	model, _ = app.Update(cmd())
+	app = model.(AppModel)
+	assert.Equal(t, ScreenProjectPicker, app.currentScreen)
+	assert.Zero(t, app.projectFlag, "Project flag must not auto-select the old project")
+
+	// Backing out returns to the board
+	model, _ = app.Update(PickerCancelledMsg{})
+	app = model.(AppModel)
+	assert.Equal(t, ScreenBoard, app.currentScreen)
+}
+
+func TestAppModel_Compare(t *testing.T) {
+	app := NewAppModel(nil, createTestStore(), context.Background(), "test-owner", 1, "")
+	app.ownerLogin = "test-owner"
+	app.projectsByOwner["test-owner"] = []domain.Project{
+		{ID: "proj-1", Number: 1, Title: "Test Project", Owner: "test-owner"},
+		{ID: "proj-2", Number: 2, Title: "Release", Owner: "test-owner"},
+	}
+	model, _ := app.Update(boardReadyMsg{})
+	app = model.(AppModel)
+
+	// d picks the second project even though a project flag was given
+	model, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	app = model.(AppModel)
+	model, cmd = app.Update(cmd())
+	app = model.(AppModel)
+	model, _ = app.Update(cmd())
+	app = model.(AppModel)
+	require.Equal(t, ScreenProjectPicker, app.currentScreen)
+
+	other := createTestStore()
+	other.SetProject(&domain.Project{ID: "proj-2", Number: 2, Title: "Release", Owner: "test-owner"})
+	other.RemoveCards([]string{"card-2", "card-3", "card-5", "card-6", "card-7"})
+	c1, _ := other.GetCard("card-1")
+	c1.ItemID, c1.ContentID = "card-1", "issue-1"
+	model, _ = app.Update(compareReadyMsg{store: other})
+	app = model.(AppModel)
+	require.Equal(t, ScreenCompare, app.currentScreen)
+
+	model, _ = app.Update(tea.WindowSizeMsg{Width: 161, Height: 40})
+	app = model.(AppModel)
+	compare := app.currentModel.(CompareModel)
+	assert.Equal(t, 80, compare.panes[0].width)
+	assert.Equal(t, 39, compare.panes[1].height, "The banner takes a line")
+	for i := range compare.panes {
+		(&compare.panes[i]).rebuildColumns()
+		(&compare.panes[i]).applyFilter()
+	}
+	view := compare.View()
+	assert.Contains(t, view, "▸ Test Project")
+	assert.Contains(t, view, "c:copy item to Release")
+
+	// Selection is per pane
+	model, _ = compare.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	compare = model.(CompareModel)
+	assert.Equal(t, 1, compare.panes[0].selectedCard["opt-todo"])
+	model, _ = compare.Update(tea.KeyMsg{Type: tea.KeyTab})
+	compare = model.(CompareModel)
+	assert.Equal(t, 1, compare.focus)
+	assert.Equal(t, 0, compare.panes[1].selectedCard["opt-todo"])
+
+	// Copying refuses items the other project already has
+	model, _ = compare.Update(tea.KeyMsg{Type: tea.KeyTab})
+	compare = model.(CompareModel)
+	compare.panes[0].selectedCard["opt-todo"] = 0
+	s0, _ := compare.panes[0].store.GetCard("card-1")
+	s0.ContentID = "issue-1"
+	model, cmd = compare.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	compare = model.(CompareModel)
+	assert.Nil(t, cmd)
+	assert.Equal(t, "#101 is already in Release", compare.toast)
+
+	compare.panes[1].client = &gh.Client{}
+	compare.panes[0].selectedCard["opt-todo"] = 1
+	s2, _ := compare.panes[0].store.GetCard("card-2")
+	s2.ContentID = "issue-2"
+	model, cmd = compare.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	compare = model.(CompareModel)
+	assert.NotNil(t, cmd)
+	assert.Empty(t, compare.toast)
+
+	// Replies go to the pane that asked
+	model, _ = compare.Update(paneMsg{pane: 1, msg: itemCopiedMsg{title: "Task 2", column: "Todo"}})
+	compare = model.(CompareModel)
+	assert.Equal(t, `Copied "Task 2" to Todo`, compare.panes[1].infoToast)
+	assert.Empty(t, compare.panes[0].infoToast)
+
+	model, _ = compare.Update(paneMsg{pane: 0, msg: openDetailMsg{}})
+	compare = model.(CompareModel)
+	assert.NotEmpty(t, compare.toast)
+
+	// d returns to the first board
+	app.currentModel = compare
+	_, cmd = compare.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	model, _ = app.Update(cmd())
+	app = model.(AppModel)
+	assert.Equal(t, ScreenBoard, app.currentScreen)
+	assert.Equal(t, 1, app.boardModel.selectedCard["opt-todo"])
+}
+
+func TestAppModel_Resume(t *testing.T) {
+	app := NewAppModel(nil, createTestStore(), context.Background(), "test-owner", 9, "Stage").WithResume()
+	_, _, _, ok := app.CurrentBoard()
+	assert.False(t, ok, "No board yet")
+
+	// A resumed project that's gone falls back to the picker
+	app.ownerLogin, app.ownerID = "test-owner", "user-1"
+	model, cmd := app.Update(resumeFailedMsg{})
+	app = model.(AppModel)
+	require.NotNil(t, cmd, "Lists the owner's projects")
+	assert.Zero(t, app.projectFlag)
+	model, _ = app.Update(projectsLoadedMsg{projects: []domain.Project{{ID: "proj-1", Number: 1, Title: "Test Project", Owner: "test-owner"}}})
+	app = model.(AppModel)
+	assert.Equal(t, ScreenProjectPicker, app.currentScreen)
+
+	// A resumed field that's gone falls back to the usual choice
+	app = NewAppModel(nil, createTestStore(), context.Background(), "test-owner", 1, "Stage").WithResume()
+	model, _ = app.Update(fieldsLoadedMsg{fields: []domain.FieldDef{*app.store.GetGroupField()}})
+	app = model.(AppModel)
+	require.NoError(t, app.err)
+	require.NotNil(t, app.groupField)
+	assert.Equal(t, "Status", app.groupField.Name)
+
+	model, _ = app.Update(boardReadyMsg{})
+	app = model.(AppModel)
+	owner, number, field, ok := app.CurrentBoard()
+	require.True(t, ok)
+	assert.Equal(t, "test-owner", owner)
+	assert.Equal(t, 1, number)
+	assert.Equal(t, "Status", field)
+}
+
+func TestAppModel_RepoProjectsFirst(t *testing.T) {
+	app := NewAppModel(nil, store.New(), context.Background(), "", 0, "").WithRepo("myorg/api")
+	model, cmd := app.Update(repoProjectsLoadedMsg{projects: []domain.Project{
+		{ID: "proj-4", Number: 4, Title: "API", Owner: "myorg"},
+		{ID: "proj-9", Number: 9, Title: "Partner roadmap", Owner: "partner"},
+	}})
+	app = model.(AppModel)
+	require.NotNil(t, cmd, "Resolves the repository owner")
+	assert.Equal(t, "myorg", app.ownerLogin)
+
+	model, _ = app.Update(ownerResolvedMsg{ownerType: gh.OwnerTypeOrganization, ownerID: "org-1"})
+	app = model.(AppModel)
+	model, _ = app.Update(projectsLoadedMsg{projects: []domain.Project{
+		{ID: "proj-1", Number: 1, Title: "Roadmap", Owner: "myorg"},
+		{ID: "proj-4", Number: 4, Title: "API", Owner: "myorg"},
+	}})
+	app = model.(AppModel)
+	require.Equal(t, ScreenProjectPicker, app.currentScreen)
+
+	var titles []string
+	for _, item := range app.currentModel.(ProjectPickerModel).list.Items() {
+		titles = append(titles, item.(projectItem).Title())
+	}
+	assert.Equal(t, []string{"4: API", "9: Partner roadmap", "1: Roadmap"}, titles, "Linked projects first, without duplicates")
+	assert.Contains(t, app.currentModel.(ProjectPickerModel).list.Items()[0].(projectItem).Description(), "linked to myorg/api")
+
+	// A repository without projects falls back to the owner picker
+	app = NewAppModel(nil, store.New(), context.Background(), "", 0, "").WithRepo("myorg/api")
+	_, cmd = app.Update(repoProjectsLoadedMsg{})
+	assert.NotNil(t, cmd, "Fetches owners")
+}
+
+func TestAppModel_CachedBoard(t *testing.T) {
+	cached := createTestStore()
+	entry := &cache.Entry{
+		FetchedAt:  time.Now().Add(-5 * time.Minute),
+		Viewer:     "test-owner",
+		Project:    *cached.GetProject(),
+		GroupField: *cached.GetGroupField(),
+	}
+	for _, card := range cached.GetAllCards() {
+		entry.Cards = append(entry.Cards, *card)
+	}
+
+	app := NewAppModel(nil, store.New(), context.Background(), "test-owner", 1, "")
+	model, _ := app.Update(cachedBoardMsg{entry: entry})
+	app = model.(AppModel)
+	require.Equal(t, ScreenBoard, app.currentScreen, "The cache shows right away")
+	assert.Len(t, app.store.GetAllCards(), 7)
+	assert.Contains(t, app.boardModel.statusLine(), "cached 5m ago, refreshing…")
+
+	// GitHub being unreachable leaves the cached board up, read-only
+	model, _ = app.Update(ErrorMsg{Err: errors.New("dial tcp: no route to host")})
+	app = model.(AppModel)
+	require.NoError(t, app.err)
+	require.True(t, app.boardModel.offline)
+	assert.Contains(t, app.boardModel.statusLine(), "offline · cached 5m ago")
+
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	app = model.(AppModel)
+	assert.False(t, app.boardModel.moveMode, "Moves are refused offline")
+	assert.Contains(t, app.boardModel.errorToast, "read-only")
+
+	// The live items replace the cached ones once they arrive
+	model, _ = app.Update(revalidatedMsg{batch: itemBatch{cards: []*domain.Card{
+		{ItemID: "card-1", Title: "Task 1", ContentType: domain.ContentTypeIssue, GroupOptionID: "opt-todo"},
+	}, pages: 1}})
+	app = model.(AppModel)
+	assert.False(t, app.boardModel.offline)
+	assert.True(t, app.boardModel.cachedAt.IsZero())
+	assert.Len(t, app.store.GetAllCards(), 1, "Cards gone from the project are dropped")
+
+	// A cache for another grouping isn't used
+	app = NewAppModel(nil, store.New(), context.Background(), "test-owner", 1, "Priority")
+	model, _ = app.Update(cachedBoardMsg{entry: entry})
+	assert.Nil(t, model.(AppModel).boardModel)
+}
+
+func TestAppModel_SwitchOwner(t *testing.T) {
+	app := NewAppModel(nil, createTestStore(), context.Background(), "", 0, "")
+	app.owners = []gh.Owner{
+		{Login: "test-owner", Type: gh.OwnerTypeUser, ID: "user-1"},
+		{Login: "other-org", Type: gh.OwnerTypeOrganization, ID: "org-1"},
+	}
+	app.projectsByOwner["other-org"] = []domain.Project{
+		{ID: "proj-9", Number: 9, Title: "Org Roadmap", Owner: "other-org"},
+	}
+
+	model, _ := app.Update(boardReadyMsg{})
+	app = model.(AppModel)
+
+	// Cached owners are shown without another API call
+	model, cmd := app.Update(switchOwnerMsg{})
+	app = model.(AppModel)
+	require.NotNil(t, cmd)
+	model, _ = app.Update(cmd())
+	app = model.(AppModel)
+	assert.Equal(t, ScreenOwner, app.currentScreen)
+
+	// Picking an owner with cached projects goes straight to the project picker
+	model, cmd = app.Update(OwnerSelectedMsg{Owner: "other-org", OwnerType: gh.OwnerTypeOrganization, OwnerID: "org-1"})
+	app = model.(AppModel)
+	require.NotNil(t, cmd)
+	model, _ = app.Update(cmd())
+	app = model.(AppModel)
+	assert.Equal(t, ScreenProjectPicker, app.currentScreen)
+	assert.Equal(t, "other-org", app.ownerLogin)
+}
+
+func TestBoardModel_TeamFilter(t *testing.T) {
+	s := createTestStore()
+	s.UpsertCards([]*domain.Card{
+		{ItemID: "card-8", Title: "Squad task", ContentType: domain.ContentTypeIssue, Number: 108, GroupOptionID: "opt-todo", Assignees: []string{"Bob"}},
+		{ItemID: "card-9", Title: "Other task", ContentType: domain.ContentTypeIssue, Number: 109, GroupOptionID: "opt-todo", Assignees: []string{"carol"}},
+	})
+	board := NewBoardModel(s, nil, context.Background())
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+
+	org, slug := parseTeam("backend", "test-owner")
+	assert.Equal(t, "test-owner", org)
+	assert.Equal(t, "backend", slug)
+	org, slug = parseTeam("acme/web", "test-owner")
+	assert.Equal(t, "acme", org)
+	assert.Equal(t, "web", slug)
+
+	board.teamSlug = "backend"
+	model, _ := board.Update(teamMembersLoadedMsg{team: "backend", members: map[string]bool{"alice": true, "bob": true}})
+	board = model.(BoardModel)
+	assert.Equal(t, []string{"card-8"}, board.filteredCards["opt-todo"], "Matches assignees case-insensitively")
+	assert.Contains(t, board.View(), "@team:backend")
+
+	// Results for a team that is no longer selected are ignored
+	model, _ = board.Update(teamMembersLoadedMsg{team: "frontend", members: map[string]bool{"carol": true}})
+	board = model.(BoardModel)
+	assert.Equal(t, []string{"card-8"}, board.filteredCards["opt-todo"])
+}
+
+func TestBoardModel_ColumnSort(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	s := createTestStore()
+	s.SetFields([]domain.FieldDef{
+		*s.GetGroupField(),
+		{ID: "field-2", Name: "Priority", Type: domain.FieldTypeSingleSelect, Options: []domain.Option{
+			{ID: "opt-p0", Name: "P0"},
+			{ID: "opt-p1", Name: "P1"},
+		}},
+	})
+	c5, _ := s.GetCard("card-5")
+	c5.FieldValues = map[string]string{"Priority": "opt-p0"}
+	c6, _ := s.GetCard("card-6")
+	c6.FieldValues = map[string]string{"Priority": "opt-p1"}
+	c4, _ := s.GetCard("card-4")
+	c4.CreatedAt = "2024-01-03T00:00:00Z"
+	c5.CreatedAt = "2024-01-01T00:00:00Z"
+	c6.CreatedAt = "2024-01-02T00:00:00Z"
+
+	board := NewBoardModel(s, nil, context.Background())
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	board.selectedColumn = 2 // Done
+	assert.Equal(t, []string{"card-4", "card-5", "card-6"}, board.filteredCards["opt-done"], "Project order by default")
+
+	cmd := (&board).cycleColumnSort()
+	require.NotNil(t, cmd)
+	assert.Nil(t, cmd(), "State saves without error")
+	assert.Equal(t, []string{"card-5", "card-6", "card-4"}, board.filteredCards["opt-done"], "Oldest first")
+	assert.Equal(t, []string{"card-1", "card-2"}, board.filteredCards["opt-todo"], "Other columns keep project order")
+
+	(&board).cycleColumnSort()
+	assert.Equal(t, []string{"card-4", "card-6", "card-5"}, board.filteredCards["opt-done"], "Newest first")
+
+	for board.columnSortKey("opt-done") != "field:Priority" {
+		(&board).cycleColumnSort()
+	}
+	assert.Equal(t, []string{"card-5", "card-6", "card-4"}, board.filteredCards["opt-done"], "Cards without a priority go last")
+	board.width = 200
+	assert.Contains(t, board.View(), "by Priority")
+
+	// A fresh board picks up the saved sort
+	fresh := NewBoardModel(s, nil, context.Background())
+	msg := fresh.loadUIState()()
+	model, _ := fresh.Update(msg)
+	fresh = model.(BoardModel)
+	(&fresh).rebuildColumns()
+	(&fresh).applyFilter()
+	assert.Equal(t, []string{"card-5", "card-6", "card-4"}, fresh.filteredCards["opt-done"])
+}
+
+func TestBoardModel_BoardSort(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	s := createTestStore()
+	c4, _ := s.GetCard("card-4")
+	c4.Title, c4.UpdatedAt, c4.Assignees = "Zebra", "2024-01-01T00:00:00Z", []string{"carol"}
+	c5, _ := s.GetCard("card-5")
+	c5.Title, c5.UpdatedAt = "apple", "2024-01-03T00:00:00Z"
+	c6, _ := s.GetCard("card-6")
+	c6.Title, c6.UpdatedAt, c6.Assignees = "Mango", "2024-01-02T00:00:00Z", []string{"Bob"}
+
+	board := NewBoardModel(s, nil, context.Background())
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	board.selectedColumn = 2 // Done
+
+	model, cmd := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("S")})
+	board = model.(BoardModel)
+	require.NotNil(t, cmd)
+	assert.Nil(t, cmd(), "State saves without error")
+	assert.Equal(t, "Board sorted ↑title", board.infoToast)
+	assert.Equal(t, []string{"card-5", "card-6", "card-4"}, board.filteredCards["opt-done"], "Titles ignore case")
+
+	(&board).cycleBoardSort() // number
+	(&board).cycleBoardSort() // recently updated
+	assert.Equal(t, []string{"card-5", "card-6", "card-4"}, board.filteredCards["opt-done"], "Most recently updated first")
+
+	(&board).cycleBoardSort()
+	assert.Equal(t, "assignee", board.uiState.BoardSort)
+	assert.Equal(t, []string{"card-6", "card-4", "card-5"}, board.filteredCards["opt-done"], "Unassigned cards go last")
+
+	// A column's own sort wins, and reordering explains which sort to clear
+	board.uiState.SetColumnSort("Status", "Done", "number")
+	(&board).applyFilter()
+	assert.Equal(t, []string{"card-4", "card-5", "card-6"}, board.filteredCards["opt-done"])
+	board.selectedColumn = 0
+	(&board).reorderCard(1)
+	assert.Contains(t, board.errorToast, "press S")
+
+	(&board).cycleBoardSort()
+	assert.Equal(t, "Board in project order", board.infoToast)
+	assert.Equal(t, []string{"card-1", "card-2"}, board.filteredCards["opt-todo"])
+}
+
+func TestBoardModel_ArchiveDone(t *testing.T) {
+	s := createTestStore()
+	for id, state := range map[string]string{"card-4": "CLOSED", "card-5": "MERGED", "card-6": "OPEN"} {
+		card, _ := s.GetCard(id)
+		card.State = state
+	}
+	board := NewBoardModel(s, nil, context.Background())
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+
+	// Only the Done column offers the action
+	(&board).startArchiveDone()
+	assert.Nil(t, board.archiveIDs)
+	assert.NotEmpty(t, board.errorToast)
+
+	board.selectedColumn = 2
+	(&board).startArchiveDone()
+	assert.Equal(t, []string{"card-4", "card-5"}, board.archiveIDs, "Open items are kept")
+	assert.Contains(t, board.renderArchiveBanner(), "Archive 2 closed/merged items from Done")
+
+	model, cmd := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	board = model.(BoardModel)
+	assert.Nil(t, board.archiveIDs)
+	assert.Nil(t, cmd)
+
+	model, _ = board.Update(itemsArchivedMsg{itemIDs: []string{"card-4", "card-5"}})
+	board = model.(BoardModel)
+	assert.Equal(t, []string{"card-6"}, board.filteredCards["opt-done"])
+	assert.Equal(t, "Archived 2 items", board.infoToast)
+}
+
+func TestBoardModel_StatusSegments(t *testing.T) {
+	s := createTestStore()
+	today := time.Now().Format(time.DateOnly)
+	s.SetFields(append(s.GetFields(), domain.FieldDef{ID: "field-it", Name: "Sprint", Type: domain.FieldTypeIteration, Iterations: []domain.Option{
+		{ID: "it-1", Name: "Sprint 1 (2020-01-01)"},
+		{ID: "it-2", Name: "Sprint 2 (" + today + ")"},
+		{ID: "it-3", Name: "Sprint 3 (2999-01-01)"},
+	}}))
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 200, 30
+	model, _ := board.Update(itemsLoadedMsg{})
+	board = model.(BoardModel)
+
+	assert.Equal(t, "7 items | [a]@me [?]help", board.statusLine(), "Default layout")
+
+	board.statusSegments = []string{"iteration", "items", "synced", "ratelimit"}
+	assert.Equal(t, "Sprint 2 | 7 items | synced just now", board.statusLine(), "Segments without data are left out")
+	assert.Contains(t, board.View(), "Sprint 2 | 7 items")
+}
+
+func TestBoardModel_Accent(t *testing.T) {
+	board := NewBoardModel(createTestStore(), nil, context.Background())
+	assert.Equal(t, defaultAccent, board.accentColor(), "No accents configured")
+
+	board.accents = map[string]string{"other/1": "33"}
+	assert.Equal(t, defaultAccent, board.accentColor(), "Another project's accent")
+
+	board.accents = map[string]string{"Test-Owner/1": "#e5c07b"}
+	color, ok := board.accent()
+	require.True(t, ok, "Owner matches case-insensitively")
+	assert.Equal(t, lipgloss.Color("#e5c07b"), color)
+	assert.Equal(t, color, board.accentColor())
+}
+
+func TestBoardModel_AutoRefreshHighlights(t *testing.T) {
+	s := createTestStore()
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 160, 40
+	board.autoRefresh = time.Minute
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+
+	var fresh []*domain.Card
+	for _, card := range s.GetAllCards() {
+		c := *card
+		switch c.ItemID {
+		case "card-2":
+			c.GroupOptionID = "opt-progress"
+		case "card-4":
+			c.Title = "Task 4, renamed"
+		case "card-7":
+			continue
+		}
+		fresh = append(fresh, &c)
+	}
+	fresh = append(fresh, &domain.Card{ItemID: "card-8", Title: "Task 8", ContentType: domain.ContentTypeIssue, GroupOptionID: "opt-todo"})
+
+	model, cmd := board.Update(revalidatedMsg{batch: itemBatch{cards: fresh, pages: 1}, auto: true})
+	board = model.(BoardModel)
+	assert.NotNil(t, cmd, "Schedules the next refresh")
+	assert.Equal(t, map[string]cardChange{"card-2": changeMoved, "card-4": changeUpdated, "card-8": changeAdded}, board.changes)
+	assert.Equal(t, "3 items changed (1 new, 1 moved, 1 updated)", board.infoToast)
+	assert.Len(t, s.GetAllCards(), 7, "Removed items are dropped")
+
+	view := board.View()
+	assert.Contains(t, view, "+Task 8")
+	assert.Contains(t, view, "→Task 2")
+	assert.Contains(t, view, "~Task 4, renamed")
+
+	// A refresh without changes keeps the highlights
+	model, _ = board.Update(revalidatedMsg{batch: itemBatch{cards: s.GetAllCards(), pages: 1}, auto: true})
+	assert.Len(t, model.(BoardModel).changes, 3)
+}
+
+func TestBoardModel_SplitByType(t *testing.T) {
+	s := createTestStore()
+	s.UpsertCards([]*domain.Card{
+		{ItemID: "card-8", Title: "Draft idea", ContentType: domain.ContentTypeDraftIssue, GroupOptionID: "opt-done"},
+		{ItemID: "card-9", Title: "Fix the build", ContentType: domain.ContentTypePullRequest, Number: 109, GroupOptionID: "opt-done"},
+	})
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 200, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	assert.NotContains(t, board.View(), "─ Issues")
+
+	model, _ := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Y")})
+	board = model.(BoardModel)
+	require.True(t, board.splitByType)
+	assert.Equal(t, []string{"card-4", "card-5", "card-6", "card-9", "card-8"}, board.filteredCards["opt-done"],
+		"Issues, then PRs, then drafts, each in project order")
+
+	view := board.View()
+	assert.Contains(t, view, "─ Issues (3)")
+	assert.Contains(t, view, "─ Pull requests (1)")
+	assert.Contains(t, view, "─ Drafts (1)")
+	assert.Less(t, strings.Index(view, "Pull requests"), strings.Index(view, "Draft idea"))
+
+	// A short terminal still shows the selected card below the sub-headers
+	board.height = 12
+	board.selectedColumn = slices.Index(board.columns, "opt-done")
+	(&board).jumpToCard(-1)
+	assert.Contains(t, board.View(), "Draft idea")
+
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Y")})
+	board = model.(BoardModel)
+	assert.NotContains(t, board.View(), "─ Issues")
+}
+
+func TestBoardModel_Undo(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	board := NewBoardModel(createTestStore(), nil, context.Background())
+	board.width, board.height = 200, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+
+	update := func(msg tea.Msg) {
+		model, _ := board.Update(msg)
+		board = model.(BoardModel)
+	}
+	// finish stands in for GitHub answering the latest outbox entry
+	finish := func(err error) {
+		entries := board.outbox.entries
+		require.NotEmpty(t, entries)
+		clear(board.guard.actions)
+		update(entries[len(entries)-1].done(err))
+	}
+	column := func(itemID string) string {
+		card, err := board.store.GetCard(itemID)
+		require.NoError(t, err)
+		return card.GroupOptionID
+	}
+
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	assert.Equal(t, "Nothing to undo", board.infoToast)
+
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("3")})
+	finish(nil)
+	require.Equal(t, "opt-done", column("card-1"))
+
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	assert.Equal(t, "opt-todo", column("card-1"), "Undo shows right away")
+	finish(nil)
+	assert.Equal(t, "Undid: Move #101 to Done", board.infoToast)
+
+	update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	assert.Equal(t, "opt-done", column("card-1"))
+	finish(nil)
+	assert.Equal(t, "Redid: Move #101 to Done", board.infoToast)
+
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	finish(assert.AnError)
+	assert.Equal(t, "opt-done", column("card-1"), "A refused undo is put back")
+	assert.Contains(t, board.errorToast, "Undo failed")
+
+	// Retrying it from the outbox takes the step again
+	entry := board.outbox.entries[len(board.outbox.entries)-1]
+	require.NotNil(t, entry.apply)
+	require.NoError(t, entry.apply())
+	assert.Equal(t, "opt-todo", column("card-1"))
+	finish(nil)
+
+	// Two quick presses undo two steps
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	finish(nil)
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("3")})
+	finish(nil)
+	require.Equal(t, "opt-progress", column("card-1"))
+	require.Equal(t, "opt-done", column("card-2"))
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	assert.Equal(t, "opt-todo", column("card-1"))
+	assert.Equal(t, "opt-todo", column("card-2"))
+}
+
+func TestBoardModel_MoveByName(t *testing.T) {
+	board := NewBoardModel(createTestStore(), nil, context.Background())
+	board.width, board.height = 200, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	card := board.getSelectedCard()
+	require.NotNil(t, card)
+
+	press := func(keys ...tea.KeyMsg) {
+		for _, k := range keys {
+			model, _ := board.Update(k)
+			board = model.(BoardModel)
+		}
+	}
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+
+	press(runes("m"), runes("x"), runes("q"))
+	assert.True(t, board.moveMode, "q is typed once a name is started")
+	assert.Equal(t, "xq", board.moveQuery)
+	assert.Contains(t, board.View(), "no single match")
+
+	press(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Contains(t, board.errorToast, "option 'xq' not found")
+
+	press(tea.KeyMsg{Type: tea.KeyBackspace}, tea.KeyMsg{Type: tea.KeyBackspace}, runes("d"), runes("o"))
+	assert.Contains(t, board.View(), "→ Done")
+	assert.Equal(t, "enter:move esc:cancel", formatHints(board.boardHints()))
+
+	press(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Empty(t, board.moveQuery)
+	moved, err := board.store.GetCard(card.ItemID)
+	require.NoError(t, err)
+	assert.Equal(t, "opt-done", moved.GroupOptionID)
+}
+
+func TestBoardModel_RemovedOption(t *testing.T) {
+	s := createTestStore()
+	c3, _ := s.GetCard("card-3")
+	c3.GroupOptionID = "opt-deleted"
+	c6, _ := s.GetCard("card-6")
+	c6.GroupOptionID = "opt-also-deleted"
+	s.UpsertCards([]*domain.Card{c3, c6})
+
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 200, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+
+	require.Equal(t, removedOptionKey, board.columns[len(board.columns)-1])
+	assert.Equal(t, []string{"card-3", "card-6"}, board.filteredCards[removedOptionKey], "Both removed options, in project order")
+	assert.Contains(t, board.View(), "(removed option) (2)")
+
+	model, _ := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("M")})
+	board = model.(BoardModel)
+	assert.False(t, board.moveMode, "M only works in the removed-option column")
+
+	board.selectedColumn = len(board.columns) - 1
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("M")})
+	board = model.(BoardModel)
+	require.True(t, board.remapAll)
+	assert.Contains(t, board.View(), "RE-MAP")
+
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("5")})
+	board = model.(BoardModel)
+	assert.Contains(t, board.errorToast, "can't be moved to a removed option")
+
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	board = model.(BoardModel)
+	assert.False(t, board.moveMode)
+	assert.Equal(t, "Re-mapped 2 cards to Todo", board.infoToast)
+	assert.NotContains(t, board.columns, removedOptionKey, "The column goes once it's empty")
+	assert.ElementsMatch(t, []string{"card-1", "card-2", "card-3", "card-6"}, board.filteredCards["opt-todo"])
+}
+
+func TestBoardModel_Sweep(t *testing.T) {
+	s := createTestStore()
+	old := time.Now().Add(-60 * 24 * time.Hour).Format(time.RFC3339)
+	c4, _ := s.GetCard("card-4")
+	c4.ContentID, c4.State, c4.UpdatedAt = "issue-4", "OPEN", old
+	c5, _ := s.GetCard("card-5")
+	c5.State, c5.UpdatedAt = "CLOSED", old
+	c6, _ := s.GetCard("card-6")
+	c6.UpdatedAt = time.Now().Format(time.RFC3339)
+	c1, _ := s.GetCard("card-1")
+	c1.UpdatedAt = old // Old, but not in Done
+
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 160, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+
+	model, _ := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+	board = model.(BoardModel)
+	require.True(t, board.sweepMode)
+	assert.Equal(t, 2, board.selectedColumn, "Focuses Done")
+	assert.Equal(t, []string{"card-4", "card-5"}, board.filteredCards["opt-done"])
+	assert.Empty(t, board.filteredCards["opt-todo"])
+	assert.Contains(t, board.View(), "1/2 Done items untouched for 30 days")
+
+	// Closing an open item moves on to the next
+	model, cmd := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	board = model.(BoardModel)
+	assert.NotNil(t, cmd)
+	assert.Equal(t, "CLOSED", c4.State)
+	assert.Equal(t, 1, board.selectedCard["opt-done"])
+
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	board = model.(BoardModel)
+	assert.Contains(t, board.errorToast, "already closed")
+
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	board = model.(BoardModel)
+	assert.Equal(t, "End of sweep; esc to leave", board.infoToast)
+
+	model, cmd = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	board = model.(BoardModel)
+	assert.NotNil(t, cmd)
+	model, _ = board.Update(itemsArchivedMsg{itemIDs: []string{"card-5"}})
+	board = model.(BoardModel)
+	assert.Equal(t, "Archived 1 item", board.infoToast)
+	assert.Equal(t, []string{"card-4"}, board.filteredCards["opt-done"])
+
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	board = model.(BoardModel)
+	assert.False(t, board.sweepMode)
+	assert.Equal(t, []string{"card-4", "card-6"}, board.filteredCards["opt-done"])
+
+	// A longer stale threshold leaves nothing to sweep
+	board.staleAfter = 90 * 24 * time.Hour
+	(&board).toggleSweep()
+	assert.False(t, board.sweepMode)
+	assert.Equal(t, "No Done items older than 90 days", board.infoToast)
+}
+
+func TestBoardModel_LinkPicker(t *testing.T) {
+	s := createTestStore()
+	for _, card := range s.GetAllCards() {
+		card.Repo = "test-owner/repo"
+	}
+	other, _ := s.GetCard("card-3")
+	other.Repo = "test-owner/other"
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 120, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+
+	(&board).startLink()
+	require.NotNil(t, board.linkSource)
+	assert.Equal(t, "card-1", board.linkSource.ItemID)
+	assert.Len(t, board.linkPicker.Items(), 6, "All other issues are targets")
+	assert.Contains(t, board.View(), "Link test-owner/repo#101")
+
+	source, _ := s.GetCard("card-1")
+	target, _ := s.GetCard("card-2")
+	assert.Equal(t, "#102", linkRef(source, target))
+	assert.Equal(t, "test-owner/other#103", linkRef(source, other))
+
+	model, cmd := board.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	board = model.(BoardModel)
+	assert.Nil(t, board.linkSource)
+	assert.Nil(t, cmd)
+
+	// Drafts have nothing to comment on
+	draft, _ := s.GetCard("card-1")
+	draft.ContentType = domain.ContentTypeDraftIssue
+	(&board).startLink()
+	assert.Nil(t, board.linkSource)
+	assert.NotEmpty(t, board.errorToast)
+}
+
+func TestDetailModel_PaneLayout(t *testing.T) {
+	card := &domain.Card{ItemID: "card-1", Title: "Task 1", ContentType: domain.ContentTypeIssue, Body: strings.Repeat("body line\n", 40)}
+	detail := NewDetailModel(card, nil, context.Background())
+
+	model, _ := detail.Update(tea.WindowSizeMsg{Width: 200, Height: 40})
+	detail = model.(DetailModel)
+	assert.True(t, detail.sideBySide, "Wide terminals start side by side")
+
+	model, _ = detail.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	detail = model.(DetailModel)
+	assert.False(t, detail.sideBySide, "Narrow terminals stack panes")
+	assert.Contains(t, detail.View(), "Description")
+
+	// The user's choice survives resizes
+	model, _ = detail.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+	detail = model.(DetailModel)
+	model, _ = detail.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	detail = model.(DetailModel)
+	assert.True(t, detail.sideBySide)
+
+	// Scrolling follows focus; panes scroll independently
+	model, _ = detail.Update(tea.KeyMsg{Type: tea.KeyTab})
+	detail = model.(DetailModel)
+	assert.Equal(t, bodyPane, detail.focus)
+	model, _ = detail.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	detail = model.(DetailModel)
+	assert.Equal(t, 1, detail.bodyView.YOffset)
+	assert.Equal(t, 0, detail.viewport.YOffset)
+}
+
+func TestDetailModel_MarkdownToggle(t *testing.T) {
+	card := &domain.Card{ItemID: "card-1", Title: "Task 1", ContentType: domain.ContentTypeIssue, Body: "# Plan\n\n- first step"}
+	detail := NewDetailModel(card, nil, context.Background())
+	model, _ := detail.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	detail = model.(DetailModel)
+	model, _ = detail.Update(commentsLoadedMsg{comments: []domain.Comment{{Author: "alice", Body: "Run `make test`"}}})
+	detail = model.(DetailModel)
+
+	view := ansi.Strip(detail.View())
+	assert.Contains(t, view, "Plan")
+	assert.NotContains(t, view, "# Plan", "Headings are styled, not shown as written")
+	assert.NotContains(t, view, "- first step")
+	assert.NotContains(t, view, "`make test`")
+
+	model, _ = detail.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	detail = model.(DetailModel)
+	assert.True(t, detail.rawMarkdown)
+	view = ansi.Strip(detail.View())
+	assert.Contains(t, view, "# Plan")
+	assert.Contains(t, view, "- first step")
+	assert.Contains(t, view, "`make test`")
+}
+
+func TestDetailModel_ReplyQuotesComment(t *testing.T) {
+	card := &domain.Card{ItemID: "card-1", Title: "Task 1", ContentType: domain.ContentTypeIssue, Repo: "o/r", Number: 1}
+	detail := NewDetailModel(card, nil, context.Background())
+	model, _ := detail.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	detail = model.(DetailModel)
+	model, _ = detail.Update(commentsLoadedMsg{comments: []domain.Comment{
+		{Author: "alice", Body: "First"},
+		{Author: "bob", Body: "> First\n\nOne\nTwo\nThree\nFour"},
+	}})
+	detail = model.(DetailModel)
+
+	model, _ = detail.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("J")})
+	detail = model.(DetailModel)
+	assert.Equal(t, 1, detail.selectedComment)
+
+	model, _ = detail.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	detail = model.(DetailModel)
+	require.True(t, detail.commentMode)
+	assert.Equal(t, "@bob\n> One\n> Two\n> Three …\n\n", detail.commentInput.Value(), "Existing quotes are not nested")
+
+	rendered := renderCommentBody("> quoted\nplain", 40)
+	assert.Contains(t, rendered, "│ ")
+	assert.Contains(t, rendered, "plain")
+}
+
+func TestDetailModel_Permalink(t *testing.T) {
+	card := &domain.Card{ItemID: "card-1", ContentType: domain.ContentTypeIssue, URL: "https://github.com/o/r/issues/1"}
+	detail := NewDetailModel(card, nil, context.Background())
+	model, _ := detail.Update(commentsLoadedMsg{comments: []domain.Comment{
+		{Author: "alice", URL: "https://github.com/o/r/issues/1#issuecomment-10"},
+		{Author: "bob", URL: "https://github.com/o/r/issues/1#issuecomment-11"},
+	}})
+	detail = model.(DetailModel)
+
+	model, _ = detail.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("J")})
+	detail = model.(DetailModel)
+	assert.Equal(t, "https://github.com/o/r/issues/1#issuecomment-11", detail.permalink())
+
+	model, _ = detail.Update(yankedMsg{text: detail.permalink()})
+	detail = model.(DetailModel)
+	assert.Contains(t, detail.successMsg, "#issuecomment-11")
+
+	// Without a clipboard tool the copy goes to the terminal, unconfirmed
+	_, cmd := detail.Update(osc52Msg{text: "https://x"})
+	assert.NotNil(t, cmd)
+	var out bytes.Buffer
+	c := &osc52Copy{text: "https://x"}
+	c.SetStdout(&out)
+	require.NoError(t, c.Run())
+	assert.Contains(t, out.String(), "\x1b]52;c;")
+	model, _ = detail.Update(yankedMsg{text: "https://x", unconfirmed: true})
+	assert.Contains(t, model.(DetailModel).successMsg, "if it supports OSC 52")
+
+	detail.focus = bodyPane
+	assert.Equal(t, card.URL, detail.permalink(), "The body pane yanks the item link")
+}
+
+func TestDetailModel_OpenVisibleLink(t *testing.T) {
+	assert.Equal(t, []string{"https://a.example/x", "http://b.example"},
+		extractLinks("See [docs](https://a.example/x) and http://b.example. Again: https://a.example/x"))
+
+	card := &domain.Card{ItemID: "card-1", Title: "Task 1", ContentType: domain.ContentTypeIssue, Repo: "o/r", Number: 1}
+	detail := NewDetailModel(card, nil, context.Background())
+	model, _ := detail.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	detail = model.(DetailModel)
+	model, _ = detail.Update(commentsLoadedMsg{comments: []domain.Comment{
+		{Author: "alice", Body: "Old https://old.example" + strings.Repeat("\nfiller", 60)},
+		{Author: "bob", Body: "Logs at https://logs.example/1 and https://ci.example/2"},
+	}})
+	detail = model.(DetailModel)
+	detail.focus = commentsPane
+	press := func(keys string) {
+		for _, r := range keys {
+			model, _ := detail.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+			detail = model.(DetailModel)
+		}
+	}
+
+	press("gx")
+	assert.Equal(t, []string{"https://old.example"}, detail.links, "Only comments on screen")
+	press("q")
+	assert.Nil(t, detail.links)
+
+	// g scrolls to the top, but gx numbers the links that were on screen
+	press("G")
+	offset := detail.viewport.YOffset
+	press("gx")
+	assert.Equal(t, offset, detail.viewport.YOffset)
+	require.Len(t, detail.links, 3)
+	assert.Contains(t, detail.View(), "2 logs.example/1")
+	press("3")
+	assert.Nil(t, detail.links, "No longer number is possible, so the link opens")
+	assert.Equal(t, "Opened https://ci.example/2", detail.successMsg)
+}
+
+func TestDetailModel_Search(t *testing.T) {
+	card := &domain.Card{ItemID: "card-1", Title: "Task 1", ContentType: domain.ContentTypeIssue, Repo: "o/r", Number: 1, Body: "Crash on startup"}
+	detail := NewDetailModel(card, nil, context.Background())
+	model, _ := detail.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	detail = model.(DetailModel)
+	model, _ = detail.Update(commentsLoadedMsg{comments: []domain.Comment{
+		{Author: "alice", Body: "Can't reproduce" + strings.Repeat("\nfiller", 60)},
+		{Author: "bob", Body: "The crash is in the CRASH handler"},
+	}})
+	detail = model.(DetailModel)
+	key := func(msg tea.KeyMsg) {
+		model, _ := detail.Update(msg)
+		detail = model.(DetailModel)
+	}
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+
+	key(runes("/"))
+	require.True(t, detail.searchMode)
+	key(runes("crash"))
+	key(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Len(t, detail.bodyHits, 1)
+	require.Len(t, detail.commentHits, 1, "Both hits on one line count once")
+	assert.Equal(t, commentsPane, detail.focus, "Starts at the focused pane's first match")
+	assert.Contains(t, detail.View(), "/crash 2/2")
+	assert.Contains(t, ansi.Strip(detail.viewport.View()), "The crash is in the CRASH handler", "Highlighting keeps the text")
+
+	key(runes("n"))
+	assert.Equal(t, bodyPane, detail.focus, "n wraps to the description")
+	key(runes("N"))
+	assert.Equal(t, commentsPane, detail.focus)
+
+	key(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Empty(t, detail.searchQuery, "esc clears the search first")
+	assert.Empty(t, detail.commentHits)
+}
+
+func TestBoardModel_SummaryStrip(t *testing.T) {
+	board := NewBoardModel(createTestStore(), nil, context.Background())
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	board.selectedColumn = 2
+	board.selectedCard["opt-done"] = 1
+
+	assert.Equal(t, "Done 2/3 · ‹ Task 4 · ▸ Task 5 · Task 6 ›", board.summaryStrip())
+
+	detail := NewDetailModel(&domain.Card{Title: "Task 5"}, nil, context.Background())
+	detail.boardSummary = board.summaryStrip()
+	assert.NotContains(t, detail.View(), "‹ Task 4", "Strip is off by default")
+	model, _ := detail.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+	detail = model.(DetailModel)
+	assert.Contains(t, detail.View(), "‹ Task 4")
+}
+
+func TestAppModel_DirectProjectLoad(t *testing.T) {
+	s := store.New()
+	app := NewAppModel(nil, s, context.Background(), "test-owner", 1, "")
+	status := createTestStore().GetGroupField()
+
+	model, _ := app.Update(projectResolvedMsg{
+		project: domain.Project{ID: "proj-1", Number: 1, Title: "Test Project", Owner: "test-owner"},
+		fields:  []domain.FieldDef{*status},
+	})
+	app = model.(AppModel)
+	assert.Equal(t, "proj-1", s.GetProject().ID)
+	assert.Equal(t, "Status", s.GetGroupField().Name, "Fields go through the usual group field selection")
+
+	// The owner resolution running alongside must not restart project loading
+	model, cmd := app.Update(ownerResolvedMsg{ownerType: gh.OwnerTypeOrganization, ownerID: "org-1"})
+	app = model.(AppModel)
+	assert.Nil(t, cmd)
+	assert.Equal(t, "org-1", app.ownerID)
+	assert.Equal(t, "test-owner", app.ownerLogin)
+
+	// The board's first load takes the page fetched alongside the project
+	page := newFirstPage()
+	page.started, page.owner, page.number, page.fieldName = true, "test-owner", 1, "Status"
+	page.cards = []domain.Card{{ItemID: "card-9", Title: "Prefetched", GroupOptionID: "opt-todo"}}
+	close(page.done)
+	board := NewBoardModel(createTestStore(), nil, context.Background())
+	board.firstPage = page
+	msg, ok := board.loadNextPage("")().(pageLoadedMsg)
+	require.True(t, ok)
+	require.NoError(t, msg.err)
+	require.Len(t, msg.cards, 1)
+	assert.Equal(t, "card-9", msg.cards[0].ItemID)
+	_, _, _, ok = page.take(context.Background(), board.store.GetProject(), "Status")
+	assert.False(t, ok, "Later loads fetch fresh items")
+}
+
+func TestCommentPrefetcher_LRU(t *testing.T) {
+	p := newCommentPrefetcher(2)
+	a := &domain.Card{Repo: "o/r", Number: 1}
+	b := &domain.Card{Repo: "o/r", Number: 2}
+	c := &domain.Card{Repo: "o/r", Number: 3}
+
+	p.Put(a, domain.CommentPage{Comments: []domain.Comment{{Body: "a"}}})
+	p.Put(b, domain.CommentPage{Comments: []domain.Comment{{Body: "b"}}})
+	_, ok := p.Get(a) // a becomes most recently used
+	require.True(t, ok)
+	p.Put(c, domain.CommentPage{Comments: []domain.Comment{{Body: "c"}}})
+
+	_, ok = p.Get(b)
+	assert.False(t, ok, "Least recently used entry is evicted")
+	got, ok := p.Get(a)
+	require.True(t, ok)
+	assert.Equal(t, "a", got.Comments[0].Body)
+
+	// The detail view opens with cached comments instead of a spinner
+	card := &domain.Card{ContentType: domain.ContentTypeIssue, Repo: "o/r", Number: 3}
+	detail := NewDetailModel(card, nil, context.Background())
+	assert.True(t, detail.loadingComments)
+	detail.prefetcher = p
+	detail.usePrefetched()
+	assert.False(t, detail.loadingComments)
+	assert.Equal(t, "c", detail.comments[0].Body)
+}
+
+func TestDetailModel_OlderCommentsAndThreads(t *testing.T) {
+	card := &domain.Card{ItemID: "card-1", Title: "PR", ContentType: domain.ContentTypePullRequest, Repo: "o/r", Number: 1}
+	detail := NewDetailModel(card, nil, context.Background())
+	model, _ := detail.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+	detail = model.(DetailModel)
+	model, _ = detail.Update(commentsLoadedMsg{
+		comments:    []domain.Comment{{Author: "carol", Body: "Newest"}},
+		olderCursor: "cursor-1",
+		threads: []domain.ReviewThread{{
+			Path: "main.go", Line: 12, IsResolved: true,
+			Comments: []domain.Comment{{Author: "dave", Body: "Rename this"}, {Author: "carol", Body: "Done"}},
+		}},
+	})
+	detail = model.(DetailModel)
+	view := ansi.Strip(detail.View())
+	assert.Contains(t, view, "Older comments not shown")
+	assert.Contains(t, view, "Review threads (1)")
+	assert.Contains(t, view, "main.go:12 · resolved")
+	assert.Contains(t, view, "│ ↳ carol")
+
+	// An older page goes above the loaded comments, keeping the selection
+	model, _ = detail.Update(olderCommentsLoadedMsg{cursor: "cursor-1", comments: []domain.Comment{{Author: "alice", Body: "First"}, {Author: "bob", Body: "Second"}}})
+	detail = model.(DetailModel)
+	assert.Equal(t, []string{"First", "Second", "Newest"}, []string{detail.comments[0].Body, detail.comments[1].Body, detail.comments[2].Body})
+	assert.Equal(t, 2, detail.selectedComment)
+	assert.Empty(t, detail.olderCursor)
+	assert.NotContains(t, ansi.Strip(detail.View()), "Older comments not shown")
+
+	model, _ = detail.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("O")})
+	detail = model.(DetailModel)
+	assert.Equal(t, "All comments are loaded", detail.errorMsg)
+
+	// A page for comments that were since reloaded is dropped
+	model, _ = detail.Update(olderCommentsLoadedMsg{cursor: "stale", comments: []domain.Comment{{Body: "Stale"}}})
+	detail = model.(DetailModel)
+	assert.Len(t, detail.comments, 3)
+}
+
+func TestDetailModel_LoadsDetailsOnDemand(t *testing.T) {
+	card := &domain.Card{ItemID: "card-1", ContentID: "I_1", ContentType: domain.ContentTypeIssue, Repo: "o/r", Number: 1}
+	detail := NewDetailModel(card, nil, context.Background())
+	assert.False(t, detail.bodyLoaded)
+	assert.Contains(t, detail.View(), "Loading")
+
+	model, _ := detail.Update(detailsLoadedMsg{itemID: "card-1", details: domain.ItemDetails{Body: "Lazy body"}})
+	detail = model.(DetailModel)
+	assert.True(t, detail.bodyLoaded)
+	assert.Contains(t, detail.View(), "Lazy body")
+}
+
+func TestBoardModel_FetchBudget(t *testing.T) {
+	budget := gh.FetchBudget{PageSize: 100, MaxItems: 250}
+	assert.Equal(t, 100, budget.NextPageSize(1, 100))
+	assert.Equal(t, 50, budget.NextPageSize(2, 200), "Last page stops at the item cap")
+	assert.Equal(t, 0, budget.NextPageSize(3, 250))
+	assert.Equal(t, 0, gh.FetchBudget{PageSize: 50, MaxPages: 2}.NextPageSize(2, 100))
+	assert.Error(t, gh.FetchBudget{PageSize: 101}.Validate())
+
+	s := createTestStore()
+	board := NewBoardModel(s, nil, context.Background())
+	board.budget = gh.FetchBudget{PageSize: 100, MaxItems: 8}
+	board.width = 200
+
+	model, _ := board.Update(pageLoadedMsg{
+		cards:      []*domain.Card{{ItemID: "card-8", Title: "Task 8", GroupOptionID: "opt-todo"}},
+		nextCursor: "cursor-2",
+		hasMore:    true,
+	})
+	board = model.(BoardModel)
+	assert.True(t, board.truncated, "Loading stops once the budget is spent")
+	assert.False(t, board.loadingMore)
+	assert.Equal(t, "cursor-2", board.nextCursor)
+	assert.Contains(t, board.View(), "fetch limit reached")
+
+	model, cmd := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'+'}})
+	board = model.(BoardModel)
+	assert.NotNil(t, cmd, "+ loads the rest")
+	assert.True(t, board.loadingMore)
+	assert.False(t, board.truncated)
+	assert.Zero(t, board.budget.MaxItems, "The rest loads without a cap")
+}
+
+func TestBoardModel_RestrictedItems(t *testing.T) {
+	s := createTestStore()
+	s.UpsertCards([]*domain.Card{{
+		ItemID:        "card-8",
+		ContentType:   domain.ContentTypeRestricted,
+		Title:         "(restricted item)",
+		AccessHint:    gh.SSOHint,
+		GroupOptionID: "opt-todo",
+	}})
+	board := NewBoardModel(s, nil, context.Background())
+	board.width = 250
+	board.height = 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+
+	view := board.View()
+	assert.Contains(t, view, "(restricted)")
+	assert.Contains(t, view, "1 restricted (authorize SSO)")
+
+	card, err := s.GetCard("card-8")
+	require.NoError(t, err)
+	detail := NewDetailModel(card, nil, context.Background())
+	detail.width = 120
+	detail.height = 40
+	assert.Contains(t, detail.View(), "Access: Organization requires SAML")
+}
+
+func TestAppModel_SSOScreen(t *testing.T) {
+	app := NewAppModel(nil, store.New(), context.Background(), "acme", 0, "")
+	ssoErr := &gh.SSORequiredError{
+		URL: "https://github.com/orgs/acme/sso?authorization_request=abc",
+		Err: assert.AnError,
+	}
+
+	model, _ := app.Update(ErrorMsg{Err: fmt.Errorf("failed to resolve owner: %w", ssoErr)})
+	app = model.(AppModel)
+	view := app.View()
+	assert.Contains(t, view, "SAML SSO authorization required")
+	assert.Contains(t, view, "authorization_request=abc")
+	assert.NotContains(t, view, "Error:", "Not the generic error screen")
+
+	_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	require.NotNil(t, cmd)
+	assert.IsType(t, tea.QuitMsg{}, cmd())
+
+	// Without a URL from GitHub, point at the organization's SSO page
+	assert.Equal(t, "https://github.com/orgs/acme/sso", (&gh.SSORequiredError{Err: assert.AnError}).AuthorizationURL("acme"))
+}
+
+func TestMutationGuard_DropsRepeats(t *testing.T) {
+	g := newMutationGuard()
+	now := time.Now()
+	g.now = func() time.Time { return now }
+
+	ctx, ok := g.begin(context.Background(), "move:card-1")
+	require.True(t, ok)
+	assert.NotEmpty(t, gh.MutationID(ctx), "Each action carries a client mutation ID")
+
+	_, ok = g.begin(context.Background(), "move:card-1")
+	assert.False(t, ok, "Blocked while in flight")
+	_, ok = g.begin(context.Background(), "move:card-2")
+	assert.True(t, ok, "Other actions are independent")
+
+	g.end("move:card-1")
+	_, ok = g.begin(context.Background(), "move:card-1")
+	assert.False(t, ok, "Blocked within the debounce window")
+
+	now = now.Add(mutationDebounce)
+	ctx2, ok := g.begin(context.Background(), "move:card-1")
+	assert.True(t, ok)
+	assert.NotEqual(t, gh.MutationID(ctx), gh.MutationID(ctx2))
+
+	// Repeating a move is refused with a toast; moving elsewhere goes ahead
+	board := NewBoardModel(createTestStore(), nil, context.Background())
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	card, err := board.store.GetCard("card-1")
+	require.NoError(t, err)
+	assert.NotNil(t, (&board).moveCard(card, "opt-progress"))
+	assert.Nil(t, (&board).moveCard(card, "opt-progress"), "The same move is dropped")
+	assert.Contains(t, board.errorToast, "Already moving")
+	assert.NotNil(t, (&board).moveCard(card, "opt-done"), "A move to another column goes ahead")
+}
+
+// findOutboxResult runs cmd, which may be a batch, and returns its outbox result
+func findOutboxResult(t *testing.T, cmd tea.Cmd) outboxResultMsg {
+	t.Helper()
+	switch msg := cmd().(type) {
+	case outboxResultMsg:
+		return msg
+	case tea.BatchMsg:
+		for _, c := range msg {
+			if c == nil {
+				continue
+			}
+			if result, ok := c().(outboxResultMsg); ok {
+				return result
+			}
+		}
+	}
+	t.Fatal("command did not send an outbox entry")
+	return outboxResultMsg{}
+}
+
+func TestBoardModel_Outbox(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	board := NewBoardModel(createTestStore(), nil, context.Background())
+	board.width = 200
+
+	sendErr := assert.AnError
+	applied := 0
+	cmd := board.outbox.enqueue(context.Background(), &outboxEntry{
+		label: "Move #101 to Done",
+		apply: func() error { applied++; return nil },
+		send:  func(ctx context.Context) error { return sendErr },
+		done: func(err error) tea.Msg {
+			if err != nil {
+				return moveErrorMsg{err: err}
+			}
+			return moveSuccessMsg{}
+		},
+	})
+	pending, failed := board.outbox.counts()
+	assert.Equal(t, 1, pending)
+	assert.Zero(t, failed)
+
+	model, _ := board.Update(cmd())
+	board = model.(BoardModel)
+	_, failed = board.outbox.counts()
+	assert.Equal(t, 1, failed, "Failed mutations stay in the outbox")
+	assert.Contains(t, board.View(), "1 failed [Q]")
+
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'Q'}})
+	board = model.(BoardModel)
+	view := board.View()
+	assert.Contains(t, view, "Move #101 to Done")
+	assert.Contains(t, view, "failed")
+
+	// Retrying re-applies the local change and resends; success clears the entry
+	sendErr = nil
+	model, cmd = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	board = model.(BoardModel)
+	require.NotNil(t, cmd)
+	assert.Equal(t, 1, applied)
+	model, _ = board.Update(findOutboxResult(t, cmd))
+	board = model.(BoardModel)
+	assert.Empty(t, board.outbox.entries)
+	assert.Contains(t, board.View(), "Everything has reached GitHub")
+
+	// Discarding drops a failed entry
+	sendErr = assert.AnError
+	cmd = board.outbox.enqueue(context.Background(), &outboxEntry{
+		label: "Link #101 to #102",
+		send:  func(ctx context.Context) error { return sendErr },
+		done:  func(err error) tea.Msg { return linkErrorMsg{err: err} },
+	})
+	model, _ = board.Update(cmd())
+	board = model.(BoardModel)
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	board = model.(BoardModel)
+	assert.Empty(t, board.outbox.entries)
+}
+
+func TestBoardModel_PresenceWarning(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	s := createTestStore()
+	board := NewBoardModel(s, nil, context.Background())
+	board.width = 250
+	assert.Empty(t, board.presenceLabel())
+
+	// Another local session with unsent changes on the same project
+	require.NoError(t, cache.WritePresence("test-owner", 1, cache.Presence{PID: os.Getpid() + 1, Pending: 2, UpdatedAt: time.Now()}))
+	model, _ := board.Update(board.syncPresence()())
+	board = model.(BoardModel)
+	assert.Contains(t, board.View(), "another ghp has 2 unsent changes")
+
+	// Our own presence file is written but not counted
+	others, err := cache.OtherPresences("test-owner", 1, os.Getpid()+1, time.Now())
+	require.NoError(t, err)
+	require.Len(t, others, 1)
+	assert.Equal(t, os.Getpid(), others[0].PID)
+
+	// Ticks from a replaced board are dropped
+	_, cmd := board.Update(presenceTickMsg{boardID: board.boardID + 1})
+	assert.Nil(t, cmd)
+}
+
+func TestBoardModel_WindowTitle(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	s := createTestStore()
+	board := NewBoardModel(s, nil, context.Background())
+	assert.Equal(t, "ghp: Test Project", board.title, "No count until the viewer is known")
+
+	s.SetViewerLogin("alice")
+	card, err := s.GetCard("card-1")
+	require.NoError(t, err)
+	card.Assignees = []string{"Alice"}
+	assert.Equal(t, "ghp: Test Project (1 assigned to me)", board.windowTitle())
+
+	// The title is only sent when it changes
+	model, cmd := board.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	board = model.(BoardModel)
+	assert.Equal(t, "ghp: Test Project (1 assigned to me)", board.title)
+	assert.NotNil(t, cmd)
+	_, cmd = board.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	assert.Nil(t, cmd)
+}
+
+func TestBoardModel_RefreshOnFocus(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	s := createTestStore()
+	board := NewBoardModel(s, nil, context.Background())
+
+	// Focus without a preceding blur does nothing
+	model, _ := board.Update(tea.FocusMsg{})
+	board = model.(BoardModel)
+	assert.False(t, board.loading)
+
+	// A short trip away doesn't refresh
+	model, _ = board.Update(tea.BlurMsg{})
+	board = model.(BoardModel)
+	model, _ = board.Update(tea.FocusMsg{})
+	board = model.(BoardModel)
+	assert.False(t, board.loading)
+
+	// Coming back after being idle does
+	board.blurredAt = time.Now().Add(-focusRefreshIdle)
+	model, cmd := board.Update(tea.FocusMsg{})
+	board = model.(BoardModel)
+	assert.True(t, board.loading)
+	assert.NotNil(t, cmd)
+	assert.True(t, board.blurredAt.IsZero())
+}
+
+func TestBoardModel_ZoomColumn(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	s := createTestStore()
+	card, err := s.GetCard("card-1")
+	require.NoError(t, err)
+	card.Repo = "org/api"
+	card.Assignees = []string{"alice"}
+
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 160, 30
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	assert.Contains(t, board.View(), "In Progress")
+
+	model, _ := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'Z'}})
+	board = model.(BoardModel)
+	view := board.View()
+	assert.NotContains(t, view, "In Progress", "Other columns are hidden")
+	assert.Contains(t, view, "org/api#101")
+	assert.Contains(t, view, "@alice")
+
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	board = model.(BoardModel)
+	assert.Contains(t, board.View(), "In Progress")
+}
+
+func TestBoardModel_StaleCards(t *testing.T) {
+	s := createTestStore()
+	board := NewBoardModel(s, nil, context.Background())
+	old := &domain.Card{UpdatedAt: time.Now().Add(-30 * 24 * time.Hour).Format(time.RFC3339)}
+	recent := &domain.Card{UpdatedAt: time.Now().Add(-time.Hour).Format(time.RFC3339)}
+	assert.False(t, board.isStale(old), "Dimming is off by default")
+
+	board.staleAfter = 14 * 24 * time.Hour
+	assert.True(t, board.isStale(old))
+	assert.False(t, board.isStale(recent))
+	assert.False(t, board.isStale(&domain.Card{}), "Cards without details are not dimmed")
+}
+
+func TestBoardModel_ItemKindCounts(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	s := createTestStore()
+	s.UpsertCards([]*domain.Card{
+		{ItemID: "draft-1", Title: "Idea", ContentType: domain.ContentTypeDraftIssue, GroupOptionID: "opt-todo"},
+		{ItemID: "draft-2", Title: "Another idea", ContentType: domain.ContentTypeDraftIssue, GroupOptionID: "opt-todo"},
+		{ItemID: "pvt-1", Title: "(private item)", ContentType: domain.ContentTypePrivate, GroupOptionID: "opt-done"},
+	})
+	board := NewBoardModel(s, nil, context.Background())
+	board.width = 250
+
+	assert.Equal(t, itemKindCounts{drafts: 2, private: 1}, board.countItemKinds())
+	header := board.renderHeader(250)
+	assert.Contains(t, header, "2 draft")
+	assert.Contains(t, header, "1 private")
+	assert.NotContains(t, header, "restricted")
+}
+
+func TestBoardModel_OpenMarkedCards(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	s := createTestStore()
+	for _, card := range s.GetAllCards() {
+		card.URL = fmt.Sprintf("https://github.com/org/repo/issues/%d", card.Number)
+	}
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 200, 30
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+
+	press := func(k tea.KeyMsg) tea.Cmd {
+		model, cmd := board.Update(k)
+		board = model.(BoardModel)
+		return cmd
+	}
+	space := tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+	o := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}}
+
+	// Marking toggles
+	press(space)
+	press(space)
+	assert.Empty(t, board.marked)
+
+	// A few tabs open without asking (the command is not run here)
+	press(space)
+	assert.Contains(t, board.renderHeader(200), "1 selected")
+	assert.NotNil(t, press(o))
+	assert.Nil(t, board.openURLs)
+
+	// More than the threshold asks first
+	for _, card := range s.GetAllCards() {
+		board.marked[card.ItemID] = true
+	}
+	assert.Nil(t, press(o))
+	assert.Len(t, board.openURLs, 7)
+	assert.Contains(t, board.View(), "Open 7 browser tabs?")
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	assert.Nil(t, board.openURLs)
+
+	press(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Empty(t, board.marked, "esc clears the selection")
+}
+
+func TestBoardModel_ProjectInfo(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	s := createTestStore()
+	s.SetFields([]domain.FieldDef{*s.GetGroupField()})
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 120, 40
+
+	model, _ := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+	board = model.(BoardModel)
+	require.True(t, board.showInfo)
+
+	model, _ = board.Update(projectInfoLoadedMsg{info: &domain.ProjectInfo{
+		ShortDescription: "Where the web team plans",
+		Readme:           "Move cards to Done when deployed.",
+		URL:              "https://github.com/orgs/test-owner/projects/1",
+		Workflows:        []domain.Workflow{{Name: "Item closed", Enabled: true}, {Name: "Auto-archive items"}},
+	}})
+	board = model.(BoardModel)
+	view := board.View()
+	assert.Contains(t, view, "Where the web team plans")
+	assert.Contains(t, view, "Todo, In Progress, Done")
+	assert.Contains(t, view, "✓ Item closed")
+	assert.Contains(t, view, "Auto-archive items (off)")
+	assert.Contains(t, view, "Move cards to Done when deployed.")
+
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, model.(BoardModel).showInfo)
+}
+
+func TestBoardModel_CreateItemForm(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	board := NewBoardModel(createTestStore(), nil, context.Background())
+	board.width, board.height = 120, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	board.selectedColumn = 1
+
+	model, _ := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	board = model.(BoardModel)
+	require.NotNil(t, board.create)
+	assert.Equal(t, "opt-progress", board.create.columnID)
+	assert.Contains(t, board.View(), "New item in In Progress")
+
+	// A title is required
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	board = model.(BoardModel)
+	require.NotNil(t, board.create)
+	assert.Contains(t, board.View(), "A title is required")
+
+	// The repository cycles through the draft option and the board's repos
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	board = model.(BoardModel)
+	start := board.create.repo
+	for range board.create.repos {
+		model, _ = board.Update(tea.KeyMsg{Type: tea.KeyRight})
+		board = model.(BoardModel)
+	}
+	assert.Equal(t, start, board.create.repo)
+	assert.Equal(t, "", board.create.repos[0])
+
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Nil(t, model.(BoardModel).create)
+}
+
+func TestBoardModel_ModeHints(t *testing.T) {
+	board := NewBoardModel(createTestStore(), nil, context.Background())
+	board.width, board.height = 120, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+
+	assert.Equal(t, "h/l:col j/k:card m:move o:open e:edit enter:view", formatHints(board.boardHints()))
+
+	board.moveMode = true
+	assert.Equal(t, "1-9:target column esc:cancel", formatHints(board.boardHints()))
+	assert.Contains(t, board.View(), "1:Todo 2:In Progress 3:Done")
+	board.moveMode = false
+
+	board.marked["card-1"] = true
+	assert.Equal(t, "j/k:card space:toggle o:open 1 esc:clear", formatHints(board.boardHints()))
+	clear(board.marked)
+
+	// Hints follow remapped keys
+	board.filterMode = true
+	board.keymap.ApplyFilter = key.NewBinding(key.WithKeys("ctrl+j"))
+	assert.Equal(t, "ctrl+j:apply esc:cancel", formatHints(board.boardHints()))
+
+	// ...and so do the keys that act
+	board.filterInput.SetValue("Task")
+	model, _ := board.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	board = model.(BoardModel)
+	assert.True(t, board.filterMode, "enter no longer applies the filter")
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyCtrlJ})
+	board = model.(BoardModel)
+	assert.False(t, board.filterMode)
+	assert.Equal(t, "Task", board.filterText)
+
+	board.keymap.MoveTarget = key.NewBinding(key.WithKeys("a", "s", "d"), key.WithHelp("a-d", "target column"))
+	board.moveMode = true
+	assert.Equal(t, "a-d:target column esc:cancel", formatHints(board.boardHints()))
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	board = model.(BoardModel)
+	assert.Equal(t, "1", board.moveQuery, "digits are typed as a column name")
+}
+
+func TestBoardModel_HideBots(t *testing.T) {
+	s := createTestStore()
+	bot, _ := s.GetCard("card-2")
+	bot.Author, bot.AuthorIsBot = "dependabot", true
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 120, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+
+	assert.Contains(t, board.filteredCards["opt-todo"], "card-2")
+	assert.Contains(t, board.formatCardText(bot, 30), "bot #102")
+
+	model, _ := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'B'}})
+	board = model.(BoardModel)
+	assert.NotContains(t, board.filteredCards["opt-todo"], "card-2")
+	assert.Contains(t, board.filteredCards["opt-todo"], "card-1")
+	assert.Contains(t, board.View(), "no bots")
+
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'B'}})
+	assert.Contains(t, model.(BoardModel).filteredCards["opt-todo"], "card-2")
+}
+
+func TestDetailModel_EditFields(t *testing.T) {
+	card := &domain.Card{ItemID: "card-1", Title: "Task 1", ContentType: domain.ContentTypeIssue}
+	detail := NewDetailModel(card, nil, context.Background())
+	detail.setProjectFields("proj-1", []domain.FieldDef{
+		{ID: "f-title", Name: "Title", Type: "TITLE"},
+		{ID: "f-est", Name: "Estimate", Type: domain.FieldTypeNumber},
+		{ID: "f-pri", Name: "Priority", Type: domain.FieldTypeSingleSelect, Options: []domain.Option{{ID: "p0", Name: "P0"}, {ID: "p1", Name: "P1"}}},
+	})
+	require.Len(t, detail.fieldDefs, 2, "Only editable fields are listed")
+
+	key := func(s string) {
+		t.Helper()
+		msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+		switch s {
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "backspace":
+			msg = tea.KeyMsg{Type: tea.KeyBackspace}
+		}
+		model, _ := detail.Update(msg)
+		detail = model.(DetailModel)
+	}
+
+	key("f")
+	require.True(t, detail.editFields)
+	model, _ := detail.Update(fieldValuesLoadedMsg{itemID: "card-1", values: []domain.FieldValue{
+		{FieldID: "f-pri", Field: "Priority", Type: domain.FieldTypeSingleSelect, Text: "P1", ID: "p1"},
+	}})
+	detail = model.(DetailModel)
+	assert.Contains(t, detail.View(), "Priority")
+
+	// Numbers are checked before anything is sent
+	key("enter")
+	key("x")
+	key("enter")
+	assert.True(t, detail.fieldEditing)
+	assert.Contains(t, detail.errorMsg, "not a number")
+	key("backspace")
+	key("3")
+	key("enter")
+	assert.False(t, detail.fieldEditing)
+	assert.Equal(t, "3", detail.fieldValues["f-est"].Text, "New values show right away")
+
+	// Single-select values are picked from the options
+	key("j")
+	key("enter")
+	assert.Equal(t, 1, detail.fieldChoice, "Picking starts at the current option")
+	key("k")
+	key("enter")
+	assert.Equal(t, "p0", detail.fieldValues["f-pri"].ID)
+
+	// A refused change is rolled back
+	model, _ = detail.Update(fieldValueErrorMsg{field: detail.fieldDefs[1], previous: &domain.FieldValue{FieldID: "f-pri", Text: "P1", ID: "p1"}, err: fmt.Errorf("forbidden")})
+	detail = model.(DetailModel)
+	assert.Equal(t, "p1", detail.fieldValues["f-pri"].ID)
+	assert.Contains(t, detail.errorMsg, "Priority not saved")
+
+	key("f")
+	assert.False(t, detail.editFields)
+}
+
+func TestBoardModel_HiddenRepos(t *testing.T) {
+	s := createTestStore()
+	archived, _ := s.GetCard("card-2")
+	archived.Repo = "o/archived"
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 120, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+
+	model, _ := board.Update(uiStateLoadedMsg{state: &uistate.State{HiddenRepos: []string{"o/archived"}}})
+	board = model.(BoardModel)
+	assert.NotContains(t, board.filteredCards["opt-todo"], "card-2")
+	_, err := s.GetCard("card-2")
+	assert.Error(t, err, "Hidden items are not stored at all")
+	assert.Contains(t, board.View(), "1 from hidden repos")
+}
+
+func TestBoardModel_ReorderCard(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	board := NewBoardModel(createTestStore(), nil, context.Background())
+	board.width, board.height = 120, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	require.Equal(t, []string{"card-1", "card-2"}, board.filteredCards["opt-todo"])
+
+	model, cmd := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'J'}})
+	board = model.(BoardModel)
+	require.NotNil(t, cmd)
+	assert.Equal(t, []string{"card-2", "card-1"}, board.filteredCards["opt-todo"])
+	assert.Equal(t, 1, board.selectedCard["opt-todo"], "Selection follows the card")
+	pending, _ := board.outbox.counts()
+	assert.Equal(t, 1, pending)
+
+	// Already last: nothing to do
+	model, cmd = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'J'}})
+	board = model.(BoardModel)
+	assert.Nil(t, cmd)
+
+	model, _ = board.Update(reorderErrorMsg{itemID: "card-1", err: fmt.Errorf("boom")})
+	board = model.(BoardModel)
+	assert.Equal(t, []string{"card-1", "card-2"}, board.filteredCards["opt-todo"], "A refused reorder is rolled back")
+	assert.Contains(t, board.errorToast, "Reorder failed")
+
+	// With a filter hiding the card between them, only the selected card moves
+	board.store.UpsertCards([]*domain.Card{{ItemID: "card-7", Title: "Other", ContentType: domain.ContentTypeIssue, Number: 107, GroupOptionID: "opt-todo"}})
+	require.NoError(t, board.store.MoveAfter("card-7", "card-1"))
+	board.filterText = "task"
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	require.Equal(t, []string{"card-1", "card-2"}, board.filteredCards["opt-todo"])
+	board.selectedCard["opt-todo"] = 1
+	model, cmd = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'K'}})
+	board = model.(BoardModel)
+	require.NotNil(t, cmd)
+	assert.Equal(t, []string{"card-2", "card-1", "card-7"}, board.store.GetColumnCardIDs("opt-todo"), "The hidden card stays put")
+	board.filterText = ""
+	(&board).applyFilter()
+
+	// Sorted columns have no manual order to change
+	board.uiState = &uistate.State{}
+	board.uiState.SetColumnSort("Status", "Todo", "title")
+	(&board).applyFilter()
+	model, cmd = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'K'}})
+	assert.Nil(t, cmd)
+	assert.Contains(t, model.(BoardModel).errorToast, "sorted")
+}
+
+func TestBoardModel_AssigneePicker(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	s := createTestStore()
+	card, err := s.GetCard("card-1")
+	require.NoError(t, err)
+	card.Repo, card.ContentID = "o/r", "I_1"
+	card.Assignees = []string{"bob"}
+
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 120, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+
+	model, cmd := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'A'}})
+	board = model.(BoardModel)
+	require.NotNil(t, cmd)
+	assert.True(t, board.assigneesLoading)
+
+	users := []gh.User{{ID: "U_a", Login: "alice"}, {ID: "U_b", Login: "bob"}}
+	model, _ = board.Update(assigneesLoadedMsg{card: card, users: users})
+	board = model.(BoardModel)
+	require.False(t, board.assigneesLoading)
+	first := board.assigneePicker.Items()[0].(assigneeItem)
+	assert.Equal(t, "bob", first.user.Login, "Assigned users are listed first")
+	assert.True(t, first.assigned)
+
+	// Move to alice and assign her
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyDown})
+	board = model.(BoardModel)
+	model, cmd = board.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	board = model.(BoardModel)
+	require.NotNil(t, cmd)
+	assert.ElementsMatch(t, []string{"bob", "alice"}, card.Assignees)
+	pending, _ := board.outbox.counts()
+	assert.Equal(t, 1, pending)
+
+	// A second press while alice's change is in flight leaves her checked
+	model, cmd = board.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	board = model.(BoardModel)
+	assert.Nil(t, cmd)
+	assert.True(t, board.assigneePicker.Items()[1].(assigneeItem).assigned)
+	assert.ElementsMatch(t, []string{"bob", "alice"}, card.Assignees)
+	assert.Contains(t, board.errorToast, "still being changed")
+
+	model, _ = board.Update(assigneeErrorMsg{card: card, login: "alice", assigned: true, err: fmt.Errorf("boom")})
+	board = model.(BoardModel)
+	assert.Equal(t, []string{"bob"}, card.Assignees, "A refused change is rolled back")
+	assert.False(t, board.assigneePicker.Items()[1].(assigneeItem).assigned)
+	assert.Contains(t, board.errorToast, "Assignee not changed")
+
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Nil(t, model.(BoardModel).assigneeCard)
+
+	// Drafts have no assignees to change
+	board = model.(BoardModel)
+	board.selectedColumn = 1
+	model, cmd = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'A'}})
+	assert.Nil(t, cmd)
+	assert.Nil(t, model.(BoardModel).assigneeCard)
+}
+
+func TestBoardModel_ParentIssue(t *testing.T) {
+	s := createTestStore()
+	parent, err := s.GetCard("card-3")
+	require.NoError(t, err)
+	parent.Repo = "o/r"
+	child, err := s.GetCard("card-1")
+	require.NoError(t, err)
+	child.Parent = &domain.IssueRef{Repo: "O/R", Number: 103, Title: "Epic: Task 3"}
+
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 120, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	assert.Contains(t, board.renderAllColumns(), "↳ Epic: Task 3")
+
+	model, _ := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+	board = model.(BoardModel)
+	assert.Equal(t, "card-3", board.getSelectedCard().ItemID, "p selects the parent")
+
+	// A parent outside the project can't be jumped to
+	board.selectedColumn = 0
+	child.Parent = &domain.IssueRef{Repo: "o/other", Number: 9}
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+	board = model.(BoardModel)
+	assert.Equal(t, "card-1", board.getSelectedCard().ItemID)
+	assert.Contains(t, board.errorToast, "o/other#9 is not on the board")
+}
+
+func TestDetailModel_LabelPicker(t *testing.T) {
+	card := &domain.Card{ItemID: "card-1", ContentID: "I_1", Title: "Task 1", ContentType: domain.ContentTypeIssue,
+		Repo: "o/r", Number: 1, Body: "body", Labels: []string{"bug"}}
+	detail := NewDetailModel(card, nil, context.Background())
+	key := func(msg tea.KeyMsg) {
+		t.Helper()
+		model, _ := detail.Update(msg)
+		detail = model.(DetailModel)
+	}
+
+	key(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("L")})
+	require.True(t, detail.editLabels)
+	model, _ := detail.Update(labelsLoadedMsg{itemID: "card-1", labels: []gh.Label{
+		{ID: "L_bug", Name: "bug", Color: "d73a4a"},
+		{ID: "L_docs", Name: "docs", Color: "0075ca", Description: "Documentation"},
+	}})
+	detail = model.(DetailModel)
+	view := detail.View()
+	assert.Contains(t, view, "[x]")
+	assert.Contains(t, view, "docs")
+
+	// Add docs, then remove bug
+	key(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	key(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, []string{"bug", "docs"}, card.Labels)
+	key(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k")})
+	key(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	assert.Equal(t, []string{"docs"}, card.Labels)
+	pending, _ := detail.outbox.counts()
+	assert.Equal(t, 2, pending)
+
+	model, _ = detail.Update(labelErrorMsg{name: "docs", added: true, err: fmt.Errorf("boom")})
+	detail = model.(DetailModel)
+	assert.Empty(t, card.Labels, "A refused change is rolled back")
+	assert.Contains(t, detail.errorMsg, "docs not changed")
+
+	key(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, detail.editLabels)
+}
+
+func TestBoardModel_ShareQR(t *testing.T) {
+	s := createTestStore()
+	card, err := s.GetCard("card-1")
+	require.NoError(t, err)
+	card.URL = "https://github.com/o/r/issues/101"
+
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 120, 50
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+
+	model, _ := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'U'}})
+	board = model.(BoardModel)
+	require.Equal(t, card, board.qrCard)
+	view := board.View()
+	assert.Contains(t, view, "▀")
+	assert.Contains(t, view, card.URL)
+
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	board = model.(BoardModel)
+	assert.Nil(t, board.qrCard)
+
+	// Drafts have no URL to share
+	card.URL = ""
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'U'}})
+	board = model.(BoardModel)
+	assert.Nil(t, board.qrCard)
+	assert.Contains(t, board.errorToast, "no URL")
+}
+
+func TestRenderBoard(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	view := RenderBoard(createTestStore(), 120, 30)
+	assert.Contains(t, view, "Todo")
+	assert.Contains(t, view, "Task 1")
+	assert.NotContains(t, view, "Loading")
+}
+
+func TestBoardModel_CloseReopen(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	s := createTestStore()
+	card, err := s.GetCard("card-1")
+	require.NoError(t, err)
+	card.ContentID, card.Repo, card.State = "I_1", "o/r", "OPEN"
+
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 120, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+
+	model, cmd := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'C'}})
+	board = model.(BoardModel)
+	require.NotNil(t, cmd)
+	assert.Equal(t, "CLOSED", card.State, "The card closes before GitHub answers")
+
+	model, cmd = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'C'}})
+	board = model.(BoardModel)
+	assert.Nil(t, cmd)
+	assert.Contains(t, board.errorToast, "already closed")
+
+	model, _ = board.Update(stateErrorMsg{card: card, previous: "OPEN", err: fmt.Errorf("boom")})
+	board = model.(BoardModel)
+	assert.Equal(t, "OPEN", card.State, "A refused close is rolled back")
+
+	// Merged pull requests stay merged
+	card.ContentType, card.State = domain.ContentTypePullRequest, "MERGED"
+	model, cmd = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'R'}})
+	assert.Nil(t, cmd)
+	assert.Contains(t, model.(BoardModel).errorToast, "Merged")
+
+	// The detail view reopens too
+	card.ContentType, card.State = domain.ContentTypeIssue, "CLOSED"
+	detail := NewDetailModel(card, nil, context.Background())
+	dm, cmd := detail.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'R'}})
+	require.NotNil(t, cmd)
+	assert.Equal(t, "OPEN", card.State)
+	dm, _ = dm.Update(stateChangedMsg{card: card})
+	assert.Equal(t, "Reopened #101", dm.(DetailModel).successMsg)
+}
+
+func TestBoardModel_Swimlanes(t *testing.T) {
+	s := createTestStore()
+	priority := domain.FieldDef{ID: "f-pri", Name: "Priority", Type: domain.FieldTypeSingleSelect,
+		Options: []domain.Option{{ID: "p0", Name: "P0"}, {ID: "p1", Name: "P1"}}}
+	s.SetFields([]domain.FieldDef{*s.GetGroupField(), priority})
+	for id, p := range map[string]string{"card-1": "p1", "card-2": "p0", "card-4": "p1"} {
+		card, err := s.GetCard(id)
+		require.NoError(t, err)
+		card.FieldValues = map[string]string{"Priority": p}
+	}
+
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 120, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+
+	model, _ := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	board = model.(BoardModel)
+	require.NotNil(t, board.laneField)
+	assert.Equal(t, "Priority", board.laneField.Name)
+	assert.Equal(t, []string{"card-2", "card-1"}, board.filteredCards["opt-todo"], "Cards are ordered lane by lane")
+
+	view := board.View()
+	assert.Contains(t, view, "Priority: P0 (1)")
+	assert.Contains(t, view, "Priority: P1 (2)")
+	assert.Contains(t, view, "No Priority")
+	assert.Less(t, strings.Index(view, "Priority: P0"), strings.Index(view, "Priority: P1"))
+
+	// The only other field, so the next press turns lanes off
+	model, _ = board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	board = model.(BoardModel)
+	assert.Nil(t, board.laneField)
+	assert.NotContains(t, board.View(), "Priority:")
+}
+
+func BenchmarkBoardModel_View(b *testing.B) {
+	board := NewBoardModel(fixture.Board(5000, 1), nil, context.Background())
+	board.width, board.height = 200, 50
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+
+	for b.Loop() {
+		_ = board.View()
+	}
+}
+
+func BenchmarkBoardModel_Filter(b *testing.B) {
+	board := NewBoardModel(fixture.Board(5000, 1), nil, context.Background())
+	board.width, board.height = 200, 50
+	(&board).rebuildColumns()
+	board.filterText = "cache"
+
+	for b.Loop() {
+		(&board).applyFilter()
+	}
+}
+
+func TestMeasureBoard(t *testing.T) {
+	// Timings depend on the machine and its clock resolution, so only check
+	// that every operation ran and reported a duration
+	s := fixture.Board(200, 1)
+	var result BenchResult
+	require.NotPanics(t, func() { result = MeasureBoard(s, 120, 30, 2, "cache") })
+	for name, d := range map[string]time.Duration{
+		"layout": result.Layout, "render": result.Render, "filter": result.Filter, "navigate": result.Navigate,
+	} {
+		assert.GreaterOrEqual(t, d, time.Duration(0), name)
+	}
+	assert.Len(t, s.GetAllCards(), 200, "Measuring leaves the store's cards alone")
+}
+
+func TestDetailModel_LinkedItems(t *testing.T) {
+	s := createTestStore()
+	card, err := s.GetCard("card-1")
+	require.NoError(t, err)
+	linkedCard, err := s.GetCard("card-3")
+	require.NoError(t, err)
+	card.Repo, linkedCard.Repo = "o/r", "o/r"
+
+	app := NewAppModel(nil, s, context.Background(), "test-owner", 1, "")
+	model, _ := app.Update(openDetailMsg{card: card})
+	app = model.(AppModel)
+	model, _ = app.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	app = model.(AppModel)
+	detail := app.currentModel.(DetailModel)
+	assert.Contains(t, detail.View(), "Loading…")
+
+	model, _ = app.Update(linkedLoadedMsg{items: []domain.LinkedItem{
+		{ContentType: domain.ContentTypePullRequest, Repo: "o/r", Number: 12, Title: "Fix it", State: "MERGED", Relation: "closed by"},
+		{ContentType: domain.ContentTypeIssue, Repo: "O/R", Number: 103, Title: "Task 3", State: "OPEN", Relation: "mentioned in"},
+	}})
+	app = model.(AppModel)
+	view := app.currentModel.(DetailModel).View()
+	assert.Contains(t, view, "closed by PR #12 Fix it (merged)")
+	assert.Contains(t, view, "mentioned in #103 Task 3")
+
+	// Select the second link and open it; it's on the board, so its card is used
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("]")})
+	app = model.(AppModel)
+	model, cmd := app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	app = model.(AppModel)
+	require.NotNil(t, cmd)
+	model, _ = app.Update(cmd())
+	app = model.(AppModel)
+	detail = app.currentModel.(DetailModel)
+	assert.Equal(t, "card-3", detail.card.ItemID)
+
+	// A link to an item off the board gets a card of its own
+	offBoard := app.linkedCard(domain.LinkedItem{Repo: "o/r", Number: 12, Title: "Fix it"})
+	assert.Empty(t, offBoard.ItemID)
+	assert.Equal(t, "Fix it", offBoard.Title)
+
+	// Closing goes back to the card it was opened from, then to the board
+	model, cmd = app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	app = model.(AppModel)
+	model, _ = app.Update(cmd())
+	app = model.(AppModel)
+	detail = app.currentModel.(DetailModel)
+	assert.Equal(t, "card-1", detail.card.ItemID)
+	assert.Equal(t, 1, detail.linkedCursor, "The link selection is kept")
+
+	model, cmd = app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	app = model.(AppModel)
+	model, _ = app.Update(cmd())
+	app = model.(AppModel)
+	assert.Equal(t, ScreenBoard, app.currentScreen)
+}
+
+func TestPRStatus(t *testing.T) {
+	s := createTestStore()
+	pr, _ := s.GetCard("card-1")
+	pr.ContentType, pr.ContentID, pr.State = domain.ContentTypePullRequest, "pr-1", "OPEN"
+	pr.PR = &domain.PRStatus{Draft: true, Checks: "FAILURE", ReviewDecision: "APPROVED", Mergeable: "CONFLICTING"}
+	board := NewBoardModel(s, nil, context.Background())
+
+	text := board.formatCardText(pr, 30)
+	assert.Contains(t, text, "draft ✗ rv✓ ⚠ #101")
+	assert.Equal(t, 30, lipgloss.Width(text), "Badges count toward the card width")
+
+	pr.State = "MERGED"
+	assert.NotContains(t, board.formatCardText(pr, 30), "✗", "Finished PRs drop their status")
+
+	pr.State = "OPEN"
+	detail := NewDetailModel(pr, nil, context.Background())
+	assert.False(t, detail.checksLoaded)
+	assert.Contains(t, detail.renderLeftPanel(60, 30), "Review: ✓ approved")
+
+	model, _ := detail.Update(checksLoadedMsg{contentID: "pr-1", checks: []domain.Check{
+		{Name: "build", State: "SUCCESS"},
+		{Name: "lint", State: "FAILURE"},
+		{Name: "test", State: "SUCCESS"},
+		{Name: "deploy", State: "PENDING"},
+	}})
+	detail = model.(DetailModel)
+	panel := detail.renderLeftPanel(60, 30)
+	assert.Contains(t, panel, "Checks: 2 passed, 1 failed, 1 pending")
+	assert.Contains(t, panel, "✗ lint")
+	assert.Contains(t, panel, "conflicts with base")
+
+	issue, _ := s.GetCard("card-2")
+	assert.True(t, NewDetailModel(issue, nil, context.Background()).checksLoaded, "Only PRs load checks")
+}
+
+func TestBoardModel_Scrollbar(t *testing.T) {
+	s := createTestStore()
+	var cards []*domain.Card
+	for i := range 20 {
+		cards = append(cards, &domain.Card{ItemID: fmt.Sprintf("extra-%d", i), Title: fmt.Sprintf("Extra %d", i),
+			ContentType: domain.ContentTypeIssue, Number: 200 + i, GroupOptionID: "opt-todo"})
+	}
+	s.UpsertCards(cards)
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 120, 16
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	assert.Contains(t, board.View(), "↓ ")
+
+	board.scrollbar = true
+	view := board.View()
+	assert.NotContains(t, view, "more")
+	assert.Contains(t, view, "┃")
+
+	// The last card scrolls into view, with the thumb at the bottom of the track
+	(&board).jumpToCard(-1)
+	view = board.View()
+	assert.Contains(t, view, "Extra 19")
+	assert.Equal(t, 0, board.selectedColumn)
+
+	bar := scrollbarRows(22, 0, 5, 10)
+	require.Len(t, bar, 10)
+	assert.Contains(t, bar[0], "┃", "Thumb starts at the top")
+	assert.Contains(t, bar[9], "│")
+	bar = scrollbarRows(22, 10, 5, 10)
+	assert.Contains(t, bar[0], "│", "Thumb leaves the top once scrolled")
+	assert.Contains(t, bar[9], "│", "and reaches the bottom only at the end")
+	bar = scrollbarRows(22, 17, 5, 10)
+	assert.Contains(t, bar[9], "┃")
+}
+
+func TestBoardModel_Paging(t *testing.T) {
+	s := createTestStore()
+	var cards []*domain.Card
+	for i := range 40 {
+		cards = append(cards, &domain.Card{ItemID: fmt.Sprintf("extra-%d", i), Title: fmt.Sprintf("Extra %d", i),
+			ContentType: domain.ContentTypeIssue, Number: 200 + i, GroupOptionID: "opt-todo"})
+	}
+	s.UpsertCards(cards)
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 120, 16
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	page := board.visibleCardCount("opt-todo")
+	require.Greater(t, page, 2)
+
+	press := func(k tea.KeyType) {
+		model, _ := board.Update(tea.KeyMsg{Type: k})
+		board = model.(BoardModel)
+	}
+	press(tea.KeyCtrlD)
+	assert.Equal(t, page/2, board.selectedCard["opt-todo"], "Half a page of the cards that fit")
+	press(tea.KeyCtrlF)
+	assert.Equal(t, page/2+page, board.selectedCard["opt-todo"])
+	press(tea.KeyCtrlB)
+	press(tea.KeyCtrlU)
+	assert.Equal(t, 0, board.selectedCard["opt-todo"])
+
+	// A taller terminal pages further
+	board.height = 40
+	assert.Greater(t, board.visibleCardCount("opt-todo"), page)
+	press(tea.KeyCtrlF)
+	assert.Equal(t, board.visibleCardCount("opt-todo"), board.selectedCard["opt-todo"])
+}
+
+func TestDetailModel_Review(t *testing.T) {
+	pr := &domain.Card{ItemID: "item-1", ContentID: "pr-1", ContentType: domain.ContentTypePullRequest, Repo: "o/r", Number: 12, Title: "Fix it", State: "OPEN"}
+	detail := NewDetailModel(pr, nil, context.Background())
+	model, _ := detail.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	detail = model.(DetailModel)
+	press := func(keys ...tea.KeyMsg) tea.Cmd {
+		var cmd tea.Cmd
+		for _, k := range keys {
+			model, cmd = detail.Update(k)
+			detail = model.(DetailModel)
+		}
+		return cmd
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	require.True(t, detail.commentMode)
+	assert.Equal(t, gh.ReviewRequestChanges, detail.reviewEvent)
+	assert.Contains(t, detail.View(), "Request changes...")
+
+	// Requesting changes needs a comment; nothing is sent without one
+	assert.Nil(t, press(tea.KeyMsg{Type: tea.KeyCtrlS}))
+	assert.Equal(t, "Request changes needs a comment", detail.errorMsg)
+
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Rename the flag")})
+	require.NotNil(t, press(tea.KeyMsg{Type: tea.KeyCtrlS}))
+	assert.True(t, detail.loading)
+	assert.Nil(t, (&detail).submitInput(), "A review already on its way isn't sent twice")
+
+	model, _ = detail.Update(reviewSubmittedMsg{event: gh.ReviewRequestChanges})
+	detail = model.(DetailModel)
+	assert.False(t, detail.commentMode)
+	assert.Empty(t, detail.reviewEvent)
+	assert.Equal(t, "Changes requested", detail.successMsg)
+
+	// Approvals may go without a comment
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	assert.Equal(t, gh.ReviewApprove, detail.reviewEvent)
+	assert.Contains(t, detail.View(), "comment optional")
+
+	// Issues can't be reviewed
+	issue := NewDetailModel(&domain.Card{ContentID: "issue-1", ContentType: domain.ContentTypeIssue}, nil, context.Background())
+	model, _ = issue.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	assert.False(t, model.(DetailModel).commentMode)
+}
+
+func TestBoardModel_SearchHistory(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	board := NewBoardModel(createTestStore(), nil, context.Background())
+	board.width, board.height = 120, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+
+	search := func(query string) {
+		model, _ := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+		board = model.(BoardModel)
+		board.filterInput.SetValue(query)
+		model, cmd := board.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		board = model.(BoardModel)
+		require.NotNil(t, cmd)
+		assert.Nil(t, cmd(), "History is saved")
+	}
+	search("Task 1")
+	search("Task 3")
+
+	saved, err := uistate.Load("test-owner", 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Task 3", "Task 1"}, saved.SearchHistory)
+
+	// A new session cycles through the saved queries with up and down
+	board = NewBoardModel(createTestStore(), nil, context.Background())
+	model, _ := board.Update(uiStateLoadedMsg{state: saved})
+	board = model.(BoardModel)
+	press := func(k tea.KeyMsg) {
+		model, _ := board.Update(k)
+		board = model.(BoardModel)
+	}
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("bu")})
+	press(tea.KeyMsg{Type: tea.KeyUp})
+	assert.Equal(t, "Task 3", board.filterInput.Value())
+	assert.Contains(t, formatHints(board.boardHints()), "up/down:history")
+	press(tea.KeyMsg{Type: tea.KeyUp})
+	press(tea.KeyMsg{Type: tea.KeyUp})
+	assert.Equal(t, "Task 1", board.filterInput.Value(), "Stops at the oldest")
+	press(tea.KeyMsg{Type: tea.KeyDown})
+	press(tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, "bu", board.filterInput.Value(), "Back to what was typed")
+}
+
+// TestBoardModel_GroupByAssignee verifies the assignee columns and that
+// moving a card between them reassigns it, undoably
+func TestBoardModel_GroupByAssignee(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	s := createTestStore()
+	for id, logins := range map[string][]string{"card-1": {"bob"}, "card-3": {"alice", "carol"}} {
+		card, err := s.GetCard(id)
+		require.NoError(t, err)
+		card.Assignees = logins
+	}
+	s.SetGroupField(store.AssigneeField())
+
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 200, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	assert.Equal(t, []string{"alice", "bob", "carol", store.NoStatusKey}, board.columns)
+	assert.Equal(t, "Unassigned", board.columnNames[store.NoStatusKey])
+
+	update := func(msg tea.Msg) {
+		model, _ := board.Update(msg)
+		board = model.(BoardModel)
+	}
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	card, err := s.GetCard("card-3")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bob", "carol"}, card.Assignees)
+	assert.Equal(t, []string{"card-1", "card-3"}, s.GetColumnCardIDs("bob"))
+
+	entries := board.outbox.entries
+	require.NotEmpty(t, entries)
+	clear(board.guard.actions)
+	update(entries[len(entries)-1].done(nil))
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	assert.Equal(t, []string{"alice", "carol"}, card.Assignees, "Undo hands it back")
+}
+
+// TestBoardModel_ToggleGroupField verifies F switches between the grouping
+// field and the alternate one using the values already loaded
+func TestBoardModel_ToggleGroupField(t *testing.T) {
+	s := createTestStore()
+	status := *s.GetGroupField()
+	priority := domain.FieldDef{ID: "field-2", Name: "Priority", Type: domain.FieldTypeSingleSelect, Options: []domain.Option{
+		{ID: "opt-p0", Name: "P0"},
+		{ID: "opt-p1", Name: "P1"},
+	}}
+	s.SetFields([]domain.FieldDef{status, priority})
+	for _, id := range []string{"card-1", "card-3"} {
+		card, err := s.GetCard(id)
+		require.NoError(t, err)
+		card.FieldValues = map[string]string{"Priority": "opt-p1"}
+	}
+
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 200, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	press := func(keys string) {
+		model, _ := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(keys)})
+		board = model.(BoardModel)
+	}
+
+	press("F")
+	assert.Contains(t, board.infoToast, "--alt-group-field")
+
+	board.altGroupField = "priority"
+	press("F")
+	assert.Equal(t, "Grouped by Priority", board.infoToast)
+	assert.Equal(t, []string{"opt-p0", "opt-p1", store.NoStatusKey}, board.columns)
+	assert.Equal(t, []string{"card-1", "card-3"}, board.filteredCards["opt-p1"])
+
+	// A move while grouped by Priority is kept when switching back and forth
+	require.NoError(t, s.MoveCard("card-3", "opt-p0"))
+	press("F")
+	assert.Equal(t, "Grouped by Status", board.infoToast)
+	assert.Equal(t, []string{"card-1", "card-2"}, board.filteredCards["opt-todo"])
+	assert.Equal(t, []string{"card-3"}, board.filteredCards["opt-progress"])
+	press("F")
+	assert.Equal(t, []string{"card-3"}, board.filteredCards["opt-p0"])
+}
+
+// TestBoardModel_BulkConfirm verifies that re-mapping more than a few cards
+// lists them for confirmation, then shows each one's outcome
+func TestBoardModel_BulkConfirm(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	s := createTestStore()
+	var removed []*domain.Card
+	for _, id := range []string{"card-1", "card-3", "card-4", "card-6"} {
+		card, err := s.GetCard(id)
+		require.NoError(t, err)
+		card.GroupOptionID = "opt-deleted"
+		removed = append(removed, card)
+	}
+	s.UpsertCards(removed)
+
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 200, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	update := func(msg tea.Msg) tea.Cmd {
+		model, cmd := board.Update(msg)
+		board = model.(BoardModel)
+		return cmd
+	}
+	board.selectedColumn = len(board.columns) - 1
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("M")})
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	require.NotNil(t, board.bulk)
+	view := board.View()
+	assert.Contains(t, view, "Move 4 cards from (removed option) to Todo")
+	assert.Contains(t, view, "#104 Task 4")
+	assert.Contains(t, view, "y confirm")
+	assert.Equal(t, 4, len(board.filteredCards[removedOptionKey]), "Nothing moves before confirming")
+
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	assert.Nil(t, board.bulk)
+	assert.Equal(t, 4, len(board.filteredCards[removedOptionKey]))
+
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("M")})
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	require.NotNil(t, board.bulk)
+	assert.ElementsMatch(t, []string{"card-1", "card-2", "card-3", "card-4", "card-6"}, board.filteredCards["opt-todo"])
+
+	entries := slices.Clone(board.outbox.entries)
+	require.Len(t, entries, 4)
+	clear(board.guard.actions)
+	for i, e := range entries {
+		var err error
+		if i == 1 {
+			err = errors.New("field is locked")
+		}
+		update(e.done(err))
+	}
+	view = board.View()
+	assert.Contains(t, view, "3 of 4 done, 1 failed")
+	assert.Contains(t, view, "field is locked")
+
+	update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Nil(t, board.bulk)
+	assert.Equal(t, []string{"card-3"}, board.filteredCards[removedOptionKey], "The failed move is put back")
+}
+
+// TestBoardModel_GroupByRepo verifies the repository columns, with drafts
+// in a column of their own, and that cards can't be moved between them
+func TestBoardModel_GroupByRepo(t *testing.T) {
+	s := createTestStore()
+	for id, repo := range map[string]string{"card-1": "o/web", "card-2": "o/api", "card-3": "o/web"} {
+		card, err := s.GetCard(id)
+		require.NoError(t, err)
+		card.Repo = repo
+	}
+	s.SetGroupField(store.RepoField())
+
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 200, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	assert.Equal(t, []string{"o/api", "o/web", store.NoStatusKey}, board.columns)
+	assert.Equal(t, "No Repository", board.columnNames[store.NoStatusKey])
+	assert.Equal(t, []string{"card-1", "card-3"}, board.filteredCards["o/web"])
+
+	model, _ := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	board = model.(BoardModel)
+	assert.False(t, board.moveMode)
+	assert.Equal(t, "Cards can't move between repository columns", board.errorToast)
+	assert.ErrorIs(t, s.MoveCard("card-1", "o/api"), store.ErrFixedGroup)
+}
+
+func TestBoardModel_Presets(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	s := createTestStore()
+	card, err := s.GetCard("card-3")
+	require.NoError(t, err)
+	card.Labels = []string{"Bug"}
+
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 200, 40
+	board.presets = []config.Preset{{Name: "Bugs", Label: "bug"}}
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	press := func(msg tea.KeyMsg) tea.Cmd {
+		model, cmd := board.Update(msg)
+		board = model.(BoardModel)
+		return cmd
+	}
+	runes := func(keys string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(keys)} }
+
+	press(runes("b"))
+	require.True(t, board.presetMenu)
+	assert.Contains(t, board.View(), "label:bug")
+	press(runes("1"))
+	assert.False(t, board.presetMenu)
+	assert.Equal(t, "Preset: Bugs", board.infoToast)
+	assert.Equal(t, []string{"card-3"}, board.filteredCards["opt-progress"])
+	assert.Empty(t, board.filteredCards["opt-todo"], "Labels match ignoring case")
+
+	// Save the current filters under a new name
+	board.filterText = "Task"
+	press(runes("b"))
+	press(runes("s"))
+	require.True(t, board.presetMode)
+	board.presetInput.SetValue("Tasks")
+	cmd := press(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	model, _ := board.Update(cmd())
+	board = model.(BoardModel)
+	assert.Equal(t, "Saved preset 'Tasks'", board.infoToast)
+	assert.Equal(t, config.Preset{Name: "Tasks", Filter: "Task", Label: "bug"}, board.presets[1])
+
+	settings, _, err := config.LoadSettings()
+	require.NoError(t, err)
+	assert.Equal(t, []config.Preset{{Name: "Tasks", Filter: "Task", Label: "bug"}}, settings.Presets)
+}
+
+func TestBoardModel_QuickFilters(t *testing.T) {
+	s := createTestStore()
+	card, err := s.GetCard("card-3")
+	require.NoError(t, err)
+	card.Labels = []string{"bug"}
+
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 200, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	assert.NotContains(t, ansi.Strip(board.View()), "alt+", "No bar without pinned presets")
+
+	board.presets = []config.Preset{
+		{Name: "Tasks", Filter: "Task", Pinned: true},
+		{Name: "Unpinned", Filter: "Other"},
+		{Name: "Bugs", Label: "bug", Pinned: true},
+	}
+	view := ansi.Strip(board.View())
+	assert.Contains(t, view, "alt+ 1 Tasks   2 Bugs")
+	assert.NotContains(t, view, "Unpinned")
+
+	press := func(key string) {
+		model, _ := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key), Alt: true})
+		board = model.(BoardModel)
+	}
+	press("2")
+	assert.Equal(t, "Preset: Bugs", board.infoToast)
+	assert.Equal(t, []string{"card-3"}, board.filteredCards["opt-progress"])
+	assert.True(t, board.presetActive(board.presets[2]))
+
+	// Pressing the applied one again clears its filters
+	press("2")
+	assert.Equal(t, "Cleared Bugs", board.infoToast)
+	assert.Empty(t, board.filterLabel)
+	assert.NotEmpty(t, board.filteredCards["opt-todo"])
+
+	press("9")
+	assert.Empty(t, board.infoToast, "Numbers past the pinned presets do nothing")
+}
+
+func TestBoardModel_ActionLog(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	board := NewBoardModel(createTestStore(), nil, context.Background())
+	board.width, board.height = 200, 40
+	update := func(msg tea.Msg) {
+		model, _ := board.Update(msg)
+		board = model.(BoardModel)
+	}
+
+	for _, sendErr := range []error{nil, errors.New("field is archived")} {
+		cmd := board.outbox.enqueue(context.Background(), &outboxEntry{
+			label: "Move #101 to Done",
+			send:  func(ctx context.Context) error { return sendErr },
+			done: func(err error) tea.Msg {
+				if err != nil {
+					return moveErrorMsg{err: err}
+				}
+				return moveSuccessMsg{}
+			},
+		})
+		update(cmd())
+	}
+	update(itemsErrorMsg{err: errors.New("timeout")})
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+
+	entries := board.actionLog.entries
+	require.Len(t, entries, 3, "Outbox results are logged once, key presses not at all")
+	assert.Equal(t, logSent, entries[0].kind)
+	assert.Equal(t, logFailed, entries[1].kind)
+	assert.EqualError(t, entries[1].err, "field is archived")
+	assert.Equal(t, "Refresh failed: timeout", entries[2].text)
+
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'H'}})
+	view := board.View()
+	assert.Contains(t, view, "Action log")
+	assert.Contains(t, view, "field is archived")
+	assert.Contains(t, view, "Refresh failed: timeout")
+	update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, board.showLog)
+}
+
+func TestBoardModel_CardDensity(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	board := NewBoardModel(createTestStore(), nil, context.Background())
+	board.width, board.height = 200, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	press := func() {
+		model, _ := board.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'z'}})
+		board = model.(BoardModel)
+	}
+
+	assert.Equal(t, 2, board.cardLines(), "Every column fits two-line cards")
+	assert.Contains(t, board.View(), "unassigned")
+
+	board.height = 10
+	assert.Equal(t, 1, board.cardLines(), "Done's three cards don't fit at two lines each")
+	assert.NotContains(t, board.View(), "unassigned")
+
+	press()
+	assert.Equal(t, "Card density: compact (one line)", board.infoToast)
+	press()
+	assert.Equal(t, 2, board.cardLines(), "Two lines when set, even if cards scroll")
+	assert.Equal(t, 2, board.visibleCardCount("opt-done"))
+	assert.Equal(t, densityNormal, board.uiState.Density)
+	press()
+	assert.Equal(t, 3, board.cardLines(), "Expanded cards add labels and age")
+	card, err := board.store.GetCard("card-4")
+	require.NoError(t, err)
+	card.Labels = []string{"bug"}
+	assert.Contains(t, board.View(), "[bug]")
+	press()
+	assert.Equal(t, 1, board.cardLines())
+}
+
+func TestBoardModel_ColumnWidths(t *testing.T) {
+	board := NewBoardModel(createTestStore(), nil, context.Background())
+	(&board).rebuildColumns()
+
+	start, end, colWidth, _ := board.columnLayout(200)
+	assert.Equal(t, []int{0, 4, maxColumnWidth}, []int{start, end, colWidth}, "Defaults")
+
+	board.minColumn = 60
+	start, end, colWidth, _ = board.columnLayout(200)
+	assert.Equal(t, []int{0, 3, 60}, []int{start, end, colWidth}, "A wide minimum shows fewer columns")
+
+	board.minColumn, board.maxColumn = 0, 16
+	_, _, colWidth, innerWidth := board.columnLayout(200)
+	assert.Equal(t, 16, colWidth)
+	assert.Equal(t, 12, innerWidth)
+}
+
+func TestBoardModel_ConvertDraft(t *testing.T) {
+	s := createTestStore()
+	for id, repo := range map[string]string{"card-3": "o/web", "card-4": "o/api"} {
+		card, err := s.GetCard(id)
+		require.NoError(t, err)
+		card.Repo = repo
+	}
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 200, 40
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	press := func(msg tea.KeyMsg) {
+		model, _ := board.Update(msg)
+		board = model.(BoardModel)
+	}
+	runes := func(keys string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(keys)} }
+
+	press(runes("I"))
+	assert.Nil(t, board.convert)
+	assert.Equal(t, "Only draft issues can be converted", board.errorToast)
+
+	draft := board.getSelectedCard()
+	require.NotNil(t, draft)
+	draft.ContentType, draft.Number = domain.ContentTypeDraftIssue, 0
+	press(runes("I"))
+	require.NotNil(t, board.convert)
+	assert.Equal(t, []string{"o/api", "o/web"}, board.convert.repos)
+	press(runes("j"))
+	assert.Contains(t, board.View(), "> o/web")
+	press(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Nil(t, board.convert)
+
+	model, _ := board.Update(draftConvertedMsg{card: draft, repo: "o/web", issue: gh.ConvertedIssue{ID: "I_1", Number: 7, URL: "https://github.com/o/web/issues/7"}})
+	board = model.(BoardModel)
+	assert.Equal(t, domain.ContentTypeIssue, draft.ContentType)
+	assert.Equal(t, "o/web", draft.Repo)
+	assert.Equal(t, 7, draft.Number)
+	assert.Contains(t, board.infoToast, "o/web#7")
+}
+
+func TestBoardModel_CreateFromTemplate(t *testing.T) {
+	s := createTestStore()
+	card, err := s.GetCard("card-3")
+	require.NoError(t, err)
+	card.Repo = "o/web"
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = 120, 40
+	board.templates = []config.Template{{Name: "Bug report", Title: "[bug] ", Body: "Steps:\n"}}
+	board.repoTemplates = map[string][]config.Template{"o/web": {{Name: "Web feature", Body: "Page:\n"}}}
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+	board.selectedColumn = 1
+	press := func(msg tea.KeyMsg) {
+		model, _ := board.Update(msg)
+		board = model.(BoardModel)
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	require.NotNil(t, board.create)
+	assert.Equal(t, "o/web", board.create.repos[board.create.repo], "Defaults to the selected card's repository")
+	require.Len(t, board.create.templates, 2, "Local templates come before the repository's")
+	assert.Contains(t, board.View(), "Template:   ‹ none ›")
+
+	press(tea.KeyMsg{Type: tea.KeyShiftTab})
+	assert.Equal(t, createFieldTemplate, board.create.focus)
+	press(tea.KeyMsg{Type: tea.KeyRight})
+	assert.Equal(t, "[bug] ", board.create.title.Value())
+	assert.Equal(t, "Steps:\n", board.create.body.Value())
+	press(tea.KeyMsg{Type: tea.KeyRight})
+	assert.Equal(t, "", board.create.title.Value())
+	assert.Equal(t, "Page:\n", board.create.body.Value())
+
+	// Edited text isn't replaced
+	board.create.body.SetValue("Page: /settings")
+	press(tea.KeyMsg{Type: tea.KeyLeft})
+	assert.Equal(t, 2, board.create.template)
+	assert.Equal(t, "Page: /settings", board.create.body.Value())
+	assert.Contains(t, board.View(), "Clear the title and body")
+
+	// Another repository only offers the local templates
+	press(tea.KeyMsg{Type: tea.KeyShiftTab})
+	press(tea.KeyMsg{Type: tea.KeyRight})
+	assert.Len(t, board.create.templates, 1)
+	assert.Equal(t, 0, board.create.template)
+}
+
+func TestDetailModel_EditContent(t *testing.T) {
+	card := &domain.Card{ItemID: "card-1", ContentID: "I_1", Title: "Crash on start", ContentType: domain.ContentTypeIssue, Repo: "o/r", Number: 1, Body: "Old body"}
+	detail := NewDetailModel(card, nil, context.Background())
+	model, _ := detail.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	detail = model.(DetailModel)
+	key := func(msg tea.KeyMsg) {
+		model, _ := detail.Update(msg)
+		detail = model.(DetailModel)
+	}
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+
+	key(runes("e"))
+	require.True(t, detail.editContent)
+	assert.Equal(t, "Crash on start", detail.titleInput.Value())
+	assert.Equal(t, "Old body", detail.bodyInput.Value())
+	assert.Contains(t, detail.View(), "Edit title and description")
+
+	key(runes("!"))
+	assert.Equal(t, "Crash on start!", detail.titleInput.Value(), "Typing edits the title first")
+	key(tea.KeyMsg{Type: tea.KeyTab})
+	assert.True(t, detail.bodyInput.Focused())
+
+	// Unsaved edits take a second esc to discard
+	key(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.True(t, detail.editContent)
+	assert.Contains(t, detail.errorMsg, "Unsaved edits")
+	key(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, detail.editContent)
+	assert.Equal(t, "Crash on start", card.Title)
+
+	// A saved edit updates the card and the description
+	key(runes("e"))
+	model, _ = detail.Update(contentSavedMsg{card: card, title: "Crash on startup", body: "New body"})
+	detail = model.(DetailModel)
+	assert.False(t, detail.editContent)
+	assert.Equal(t, "Crash on startup", card.Title)
+	assert.Equal(t, "New body", detail.body)
+	assert.Equal(t, "Saved #1", detail.successMsg)
+
+	// Items without content can't be edited
+	restricted := NewDetailModel(&domain.Card{ItemID: "card-2", ContentType: domain.ContentTypeRestricted}, nil, context.Background())
+	model, _ = restricted.Update(runes("e"))
+	assert.False(t, model.(DetailModel).editContent)
+}
+
+func TestDetailModel_DraftInEditor(t *testing.T) {
+	card := &domain.Card{ItemID: "card-1", ContentID: "I_1", Title: "Task 1", ContentType: domain.ContentTypeIssue, Repo: "o/r", Number: 1}
+	detail := NewDetailModel(card, nil, context.Background())
+	model, _ := detail.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	detail = model.(DetailModel)
+	require.True(t, detail.commentMode)
+
+	cmd := func() tea.Cmd {
+		model, cmd := detail.Update(tea.KeyMsg{Type: tea.KeyCtrlE})
+		detail = model.(DetailModel)
+		return cmd
+	}()
+	require.NotNil(t, cmd, "ctrl+e suspends for the editor")
+
+	path, err := editor.WriteDraft("A longer comment\n\nwith paragraphs\n")
+	require.NoError(t, err)
+	model, _ = detail.Update(draftEditedMsg{target: draftComment, path: path})
+	detail = model.(DetailModel)
+	assert.Equal(t, "A longer comment\n\nwith paragraphs", detail.commentInput.Value())
+	assert.NoFileExists(t, path, "The draft file is removed")
+
+	model, _ = detail.Update(draftEditedMsg{target: draftComment, err: fmt.Errorf("exit status 1")})
+	detail = model.(DetailModel)
+	assert.Equal(t, "Draft not loaded: editor failed: exit status 1", detail.errorMsg)
+	assert.Equal(t, "A longer comment\n\nwith paragraphs", detail.commentInput.Value(), "A failed edit keeps the draft")
+}
+
+func TestCompareModel_RuntimeMessages(t *testing.T) {
+	// The runtime's own messages pass through the pane tagging
+	msg := wrapPane(1, tea.SetWindowTitle("ghp"))()
+	_, tagged := msg.(paneMsg)
+	assert.False(t, tagged, "Window titles reach the runtime")
+	assert.Equal(t, paneMsg{pane: 1, msg: reorderedMsg{}}, wrapPane(1, func() tea.Msg { return reorderedMsg{} })())
+
+	// Replies the runtime sends later, like the editor closing, find their pane
+	left := NewBoardModel(createTestStore(), nil, context.Background())
+	right := NewBoardModel(createTestStore(), nil, context.Background())
+	c := newCompareModel(left, right)
+	assert.Equal(t, editorClosedMsg{}, c.panes[0].reply.tag(editorClosedMsg{}))
+	assert.Equal(t, paneMsg{pane: 1, msg: editorClosedMsg{}}, c.panes[1].reply.tag(editorClosedMsg{}))
+}
+
+func TestAppModel_BoardBehindDetail(t *testing.T) {
+	s := createTestStore()
+	card, err := s.GetCard("card-1")
+	require.NoError(t, err)
+	app := NewAppModel(nil, s, context.Background(), "test-owner", 1, "Status")
+	model, _ := app.Update(boardReadyMsg{})
+	app = model.(AppModel)
+	require.NotNil(t, app.boardModel)
+	app.boardModel.autoRefresh = time.Minute
+	model, _ = app.Update(openDetailMsg{card: card})
+	app = model.(AppModel)
+	require.IsType(t, DetailModel{}, app.currentModel)
+	update := func(msg tea.Msg) tea.Cmd {
+		model, cmd := app.Update(msg)
+		app = model.(AppModel)
+		return cmd
+	}
+
+	// Timers and focus reach the board under the detail view
+	assert.NotNil(t, update(autoRefreshMsg{}), "The next refresh is scheduled")
+	assert.NotNil(t, update(presenceTickMsg{boardID: app.boardModel.boardID}), "The next heartbeat is scheduled")
+	update(tea.BlurMsg{})
+	assert.False(t, app.boardModel.blurredAt.IsZero())
+
+	// The board's changes settle on the board; the detail view's come back to it
+	update(outboxResultMsg{err: assert.AnError, then: moveErrorMsg{err: assert.AnError}})
+	assert.Contains(t, app.boardModel.errorToast, "Move failed")
+	update(outboxResultMsg{detail: true, then: commentPostedMsg{}})
+	assert.Equal(t, "Comment posted!", app.currentModel.(DetailModel).successMsg)
+}
+
+func TestBoardModel_HeaderClickZooms(t *testing.T) {
+	board := NewBoardModel(createTestStore(), nil, context.Background())
+	board.width, board.height = 120, 30
+	(&board).rebuildColumns()
+	(&board).applyFilter()
+
+	// Find the second column's header in the rendered board
+	lines := strings.Split(ansi.Strip(board.View()), "\n")
+	x, y := -1, -1
+	for i, line := range lines {
+		if idx := strings.Index(line, "[2] In Progress"); idx >= 0 {
+			x, y = ansi.StringWidth(line[:idx])+3, i
+			break
+		}
+	}
+	require.GreaterOrEqual(t, y, 0)
+
+	click := tea.MouseMsg{X: x, Y: y, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft}
+	model, _ := board.Update(click)
+	board = model.(BoardModel)
+	assert.True(t, board.zoomed)
+	assert.Equal(t, 1, board.selectedColumn)
+
+	// Clicking the zoomed header shows all columns again
+	lines = strings.Split(ansi.Strip(board.View()), "\n")
+	for i, line := range lines {
+		if idx := strings.Index(line, "[2] In Progress"); idx >= 0 {
+			click.X, click.Y = ansi.StringWidth(line[:idx]), i
+		}
+	}
+	model, _ = board.Update(click)
+	board = model.(BoardModel)
+	assert.False(t, board.zoomed)
+
+	// Clicks elsewhere do nothing
+	click.Y++
+	model, _ = board.Update(click)
+	assert.False(t, model.(BoardModel).zoomed)
+}
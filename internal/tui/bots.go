@@ -0,0 +1,31 @@
+package tui
+
+import (
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	botAuthorStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("110")).
+			Bold(true)
+
+	botBadgeStyle = lipgloss.NewStyle().
+			Background(lipgloss.Color("238")).
+			Foreground(lipgloss.Color("252")).
+			Padding(0, 1)
+)
+
+// renderAuthor renders an author login, styled apart with a badge for bots
+// and GitHub Apps so automated updates don't read like a teammate's
+func renderAuthor(login string, bot bool) string {
+	if !bot {
+		return commentAuthorStyle.Render(login)
+	}
+	return botAuthorStyle.Render(login) + " " + botBadgeStyle.Render("bot")
+}
+
+// toggleHideBots shows or hides items created by bots
+func (m *BoardModel) toggleHideBots() {
+	m.hideBots = !m.hideBots
+	m.applyFilter()
+}
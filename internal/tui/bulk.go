@@ -0,0 +1,133 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// bulkConfirmThreshold is the most cards a bulk action changes without
+// listing them for confirmation first
+const bulkConfirmThreshold = 3
+
+// bulkAction is a change to many cards at once. The cards are listed for
+// confirmation before it runs, then with each one's outcome as GitHub answers.
+type bulkAction struct {
+	title   string // e.g. "Move 5 cards to Done"
+	itemIDs []string
+	lines   map[string]string // Card label and title by item ID, kept for cards leaving the board
+	run     func(m *BoardModel) tea.Cmd
+	started bool
+	results map[string]error // Outcome by item ID, nil for success; missing while pending
+}
+
+// confirmBulk lists the cards a bulk action would change and waits for y
+// before running it
+func (m *BoardModel) confirmBulk(title string, ids []string, run func(m *BoardModel) tea.Cmd) {
+	b := &bulkAction{
+		title:   title,
+		itemIDs: ids,
+		lines:   make(map[string]string, len(ids)),
+		run:     run,
+		results: make(map[string]error, len(ids)),
+	}
+	for _, id := range ids {
+		if card, err := m.store.GetCard(id); err == nil {
+			b.lines[id] = cardLabel(card) + " " + card.Title
+		}
+	}
+	m.bulk = b
+}
+
+// handleBulkKey confirms or cancels a listed bulk action, or once it runs,
+// closes the list of outcomes
+func (m BoardModel) handleBulkKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	b := m.bulk
+	if !b.started {
+		switch msg.String() {
+		case "y", "Y":
+			b.started = true
+			return m, b.run(&m)
+		case "n", "N", "esc", "q":
+			m.bulk = nil
+		}
+		return m, nil
+	}
+	switch msg.String() {
+	case "esc", "q", "enter":
+		m.bulk = nil
+	}
+	return m, nil
+}
+
+// recordBulkResult notes the outcome for a card of the running bulk action
+func (m *BoardModel) recordBulkResult(itemID string, err error) {
+	if m.bulk == nil || !m.bulk.started {
+		return
+	}
+	if _, ok := m.bulk.lines[itemID]; ok {
+		m.bulk.results[itemID] = err
+	}
+}
+
+// failBulkPending marks the running bulk action's unanswered cards failed
+func (m *BoardModel) failBulkPending(err error) {
+	if m.bulk == nil || !m.bulk.started {
+		return
+	}
+	for _, id := range m.bulk.itemIDs {
+		if _, ok := m.bulk.results[id]; !ok {
+			m.bulk.results[id] = err
+		}
+	}
+}
+
+// renderBulk renders the cards of a bulk action, with their outcomes once
+// it runs, as many as fit in height
+func (m BoardModel) renderBulk(width, height int) string {
+	b := m.bulk
+	var s strings.Builder
+	s.WriteString(titleStyle.Render(b.title))
+	s.WriteString("\n\n")
+
+	rows := max(height-8, 1)
+	failed := 0
+	for i, id := range b.itemIDs {
+		err, answered := b.results[id]
+		if answered && err != nil {
+			failed++
+		}
+		if i >= rows {
+			continue
+		}
+		status := "  "
+		switch {
+		case answered && err == nil:
+			status = passStyle.Render("✓ ")
+		case answered:
+			status = failStyle.Render("✗ ")
+		case b.started:
+			status = pendingStyle.Render("● ")
+		}
+		s.WriteString(status + truncateLine(b.lines[id], max(width-10, 10)) + "\n")
+		if answered && err != nil {
+			s.WriteString("    " + dimStyle.Render(truncateLine(err.Error(), max(width-14, 10))) + "\n")
+		}
+	}
+	if more := len(b.itemIDs) - rows; more > 0 {
+		s.WriteString(dimStyle.Render(fmt.Sprintf("… and %d more", more)) + "\n")
+	}
+
+	s.WriteString("\n")
+	if !b.started {
+		s.WriteString(dimStyle.Render(fmt.Sprintf("%d cards · y confirm · n cancel", len(b.itemIDs))))
+		return HelpOverlayStyle.Render(s.String())
+	}
+	summary := fmt.Sprintf("%d of %d done", len(b.results)-failed, len(b.itemIDs))
+	if failed > 0 {
+		summary += fmt.Sprintf(", %d failed", failed)
+	}
+	s.WriteString(dimStyle.Render(summary + " · esc close"))
+	return HelpOverlayStyle.Render(s.String())
+}
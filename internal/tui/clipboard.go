@@ -0,0 +1,64 @@
+package tui
+
+import (
+	"io"
+	"os"
+
+	"github.com/atotto/clipboard"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/muesli/termenv"
+)
+
+// copyToClipboard copies text to the system clipboard. Without a clipboard
+// tool (e.g. over SSH) it reports osc52Msg, and the model falls back to the
+// OSC 52 terminal escape, which most modern terminals honor.
+func copyToClipboard(text string) tea.Cmd {
+	return func() tea.Msg {
+		if err := clipboard.WriteAll(text); err != nil {
+			return osc52Msg{text: text}
+		}
+		return yankedMsg{text: text}
+	}
+}
+
+// copyWithOSC52 writes the OSC 52 escape through the program's output while
+// the renderer is paused, so it never lands in the middle of a frame. The
+// terminal doesn't answer, so the copy is reported as unconfirmed.
+func copyWithOSC52(text string) tea.Cmd {
+	return tea.Exec(&osc52Copy{text: text}, func(err error) tea.Msg {
+		if err != nil {
+			return yankErrorMsg{err: err}
+		}
+		return yankedMsg{text: text, unconfirmed: true}
+	})
+}
+
+// osc52Copy is a tea.ExecCommand that writes the OSC 52 escape for text
+type osc52Copy struct {
+	text string
+	out  io.Writer
+}
+
+func (c *osc52Copy) Run() error {
+	if c.out == nil {
+		c.out = os.Stdout
+	}
+	termenv.NewOutput(c.out).Copy(c.text)
+	return nil
+}
+
+func (c *osc52Copy) SetStdin(io.Reader)    {}
+func (c *osc52Copy) SetStdout(w io.Writer) { c.out = w }
+func (c *osc52Copy) SetStderr(io.Writer)   {}
+
+// Message types for copying to the clipboard
+type (
+	// yankedMsg reports text copied to the clipboard. An unconfirmed copy
+	// was handed to the terminal, which may have ignored it.
+	yankedMsg struct {
+		text        string
+		unconfirmed bool
+	}
+	osc52Msg     struct{ text string } // No clipboard tool; try the terminal
+	yankErrorMsg struct{ err error }
+)
@@ -0,0 +1,113 @@
+package tui
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// linkPattern matches http(s) URLs in markdown text, bare or inside a link
+var linkPattern = regexp.MustCompile(`https?://[^\s<>()\[\]"'` + "`" + `]+`)
+
+// extractLinks returns the distinct URLs in text in order of appearance,
+// without trailing sentence punctuation
+func extractLinks(text string) []string {
+	var links []string
+	seen := make(map[string]bool)
+	for _, url := range linkPattern.FindAllString(text, -1) {
+		url = strings.TrimRight(url, ".,;:!?*_~")
+		if !seen[url] {
+			seen[url] = true
+			links = append(links, url)
+		}
+	}
+	return links
+}
+
+// visibleLinks returns the links in the focused pane's text on screen: the
+// description, or the comments the comments pane shows at least a line of
+func (m DetailModel) visibleLinks() []string {
+	if m.focus == bodyPane {
+		return extractLinks(m.body)
+	}
+	top, bottom := m.viewport.YOffset, m.viewport.YOffset+m.viewport.Height
+	var text strings.Builder
+	for i, c := range m.comments {
+		if i >= len(m.commentOffsets) {
+			break
+		}
+		end := m.viewport.TotalLineCount()
+		if m.threadsOffset >= 0 {
+			end = m.threadsOffset
+		}
+		if i+1 < len(m.commentOffsets) {
+			end = m.commentOffsets[i+1]
+		}
+		if m.commentOffsets[i] < bottom && end > top {
+			text.WriteString(c.Body + "\n")
+		}
+	}
+	return extractLinks(text.String())
+}
+
+// startLinkPick numbers the links on screen and waits for one's number
+func (m *DetailModel) startLinkPick() {
+	m.links = m.visibleLinks()
+	m.linkDigits = ""
+	if len(m.links) == 0 {
+		m.errorMsg, m.successMsg = "No links on screen", ""
+		m.links = nil
+		return
+	}
+	m.errorMsg, m.successMsg = "", ""
+}
+
+// handleLinkPickKey reads a link number, opening the link once no longer
+// number could follow (or on enter)
+func (m DetailModel) handleLinkPickKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch key := msg.String(); {
+	case key == "esc" || key == "q":
+		m.links = nil
+	case key == "backspace":
+		if m.linkDigits != "" {
+			m.linkDigits = m.linkDigits[:len(m.linkDigits)-1]
+		}
+	case key == "enter":
+		return m, (&m).openPickedLink()
+	case len(key) == 1 && key >= "0" && key <= "9":
+		m.linkDigits += key
+		if n, _ := strconv.Atoi(m.linkDigits); n*10 > len(m.links) {
+			return m, (&m).openPickedLink()
+		}
+	}
+	return m, nil
+}
+
+// openPickedLink opens the link whose number was typed and leaves link picking
+func (m *DetailModel) openPickedLink() tea.Cmd {
+	links, digits := m.links, m.linkDigits
+	m.links, m.linkDigits = nil, ""
+	if digits == "" {
+		return nil
+	}
+	n, _ := strconv.Atoi(digits)
+	if n < 1 || n > len(links) {
+		m.errorMsg, m.successMsg = "No link "+digits, ""
+		return nil
+	}
+	m.errorMsg, m.successMsg = "", "Opened "+links[n-1]
+	return openURLs([]string{links[n-1]})
+}
+
+// renderLinkPick renders the numbered links in one line for the footer
+func (m DetailModel) renderLinkPick(width int) string {
+	prompt := "open link: " + m.linkDigits
+	parts := make([]string, len(m.links))
+	for i, url := range m.links {
+		parts[i] = fmt.Sprintf("%d %s", i+1, strings.TrimPrefix(strings.TrimPrefix(url, "https://"), "http://"))
+	}
+	return truncateLine(prompt+"  "+strings.Join(parts, " · "), max(width-2, 10))
+}
@@ -0,0 +1,305 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/gh"
+	"github.com/h0rv/ghp/internal/store"
+)
+
+// compareModeStyle is the banner style for the comparison view
+var compareModeStyle = lipgloss.NewStyle().
+	Background(lipgloss.Color("62")).
+	Foreground(lipgloss.Color("15")).
+	Padding(0, 1)
+
+// CompareModel shows two project boards side by side. Each pane keeps its
+// own selection and filters; tab moves the keyboard between them.
+type CompareModel struct {
+	panes  [2]BoardModel
+	focus  int
+	width  int
+	height int
+	toast  string
+}
+
+// newCompareModel pairs the current board with a second project's board
+func newCompareModel(left, right BoardModel) CompareModel {
+	// Untagged replies go to the first board, so only the second needs tagging
+	right.reply = func(msg tea.Msg) tea.Msg { return paneMsg{pane: 1, msg: msg} }
+	return CompareModel{panes: [2]BoardModel{left, right}}
+}
+
+// Init starts loading the second board. The first is already loaded.
+func (c CompareModel) Init() tea.Cmd {
+	return tea.Batch(wrapPane(1, c.panes[1].Init()), tea.WindowSize())
+}
+
+// Update routes messages to the pane they belong to
+func (c CompareModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		c.width, c.height = msg.Width, msg.Height
+		left := (msg.Width - 1) / 2
+		sizes := [2]int{left, msg.Width - 1 - left}
+		var cmds []tea.Cmd
+		for i := range c.panes {
+			cmds = append(cmds, c.updatePane(i, tea.WindowSizeMsg{Width: sizes[i], Height: msg.Height - 1}))
+		}
+		return c, tea.Batch(cmds...)
+
+	case paneMsg:
+		switch msg.msg.(type) {
+		case openDetailMsg, switchProjectMsg, switchOwnerMsg, changeGroupFieldMsg:
+			c.toast = "Close the comparison with d first"
+			return c, nil
+		}
+		return c, c.updatePane(msg.pane, msg.msg)
+
+	case tea.MouseMsg:
+		// Clicks focus the pane under them and go to it in its own
+		// coordinates below the banner
+		msg.Y--
+		left := c.panes[0].width
+		if msg.X < left {
+			c.focus = 0
+			return c, c.updatePane(0, msg)
+		}
+		if msg.X > left {
+			msg.X -= left + 1
+			c.focus = 1
+			return c, c.updatePane(1, msg)
+		}
+		return c, nil
+
+	case tea.KeyMsg:
+		c.toast = ""
+		if c.panes[c.focus].modal() {
+			return c, c.updatePane(c.focus, msg)
+		}
+		switch msg.String() {
+		case "tab":
+			c.focus = 1 - c.focus
+			return c, nil
+		case "d":
+			return c, func() tea.Msg { return closeCompareMsg{left: c.panes[0], right: c.panes[1]} }
+		case "c":
+			cmd, refusal := copyItem(c.panes[c.focus], c.panes[1-c.focus])
+			c.toast = refusal
+			return c, wrapPane(1-c.focus, cmd)
+		}
+		return c, c.updatePane(c.focus, msg)
+	}
+
+	// Replies to commands started before the comparison opened belong to
+	// the first board
+	return c, c.updatePane(0, msg)
+}
+
+// updatePane updates one pane and tags the commands it returns
+func (c *CompareModel) updatePane(pane int, msg tea.Msg) tea.Cmd {
+	model, cmd := c.panes[pane].Update(msg)
+	if board, ok := model.(BoardModel); ok {
+		c.panes[pane] = board
+	}
+	return wrapPane(pane, cmd)
+}
+
+// View renders the banner above both boards
+func (c CompareModel) View() string {
+	titles := [2]string{}
+	for i := range c.panes {
+		titles[i] = "(loading)"
+		if project := c.panes[i].store.GetProject(); project != nil {
+			titles[i] = project.Title
+		}
+	}
+	banner := compareModeStyle.Render("COMPARE") +
+		fmt.Sprintf(" ▸ %s · tab:switch pane c:copy item to %s d:close", titles[c.focus], titles[1-c.focus])
+	if c.toast != "" {
+		banner += "  " + errorStyle.Render(c.toast)
+	}
+
+	divider := strings.TrimSuffix(strings.Repeat("│\n", max(c.height-1, 1)), "\n")
+	return lipgloss.JoinVertical(lipgloss.Left,
+		truncateLine(banner, c.width),
+		lipgloss.JoinHorizontal(lipgloss.Top, c.panes[0].View(), dimStyle.Render(divider), c.panes[1].View()),
+	)
+}
+
+// modal reports whether a prompt, overlay, or mode has the board's keys, so
+// the comparison view passes them through untouched
+func (m BoardModel) modal() bool {
+	return m.showHelp || m.showStats || m.showInfo || m.qrCard != nil || m.showOutbox || m.showLog ||
+		m.create != nil || m.convert != nil || m.filterMode || m.teamMode || m.workspaceMode || m.presetMenu || m.presetMode ||
+		m.linkSource != nil || m.assigneeCard != nil || m.archiveIDs != nil || m.openURLs != nil ||
+		m.moveMode || m.triageMode || m.sweepMode || m.bulk != nil
+}
+
+// copyItem adds the selected card of from to to's project, in the column
+// with the same name when there is one. Drafts are copied as new drafts.
+// refusal says why the card can't be copied.
+func copyItem(from, to BoardModel) (cmd tea.Cmd, refusal string) {
+	card := from.getSelectedCard()
+	project := to.store.GetProject()
+	groupField := to.store.GetGroupField()
+	if card == nil || project == nil || groupField == nil {
+		return nil, ""
+	}
+	switch card.ContentType {
+	case domain.ContentTypeIssue, domain.ContentTypePullRequest, domain.ContentTypeDraftIssue:
+	default:
+		return nil, "Only issues, pull requests, and drafts can be copied"
+	}
+	if card.ContentType != domain.ContentTypeDraftIssue && card.ContentID != "" {
+		for _, other := range to.store.GetAllCards() {
+			if other.ContentID == card.ContentID {
+				return nil, cardLabel(card) + " is already in " + project.Title
+			}
+		}
+	}
+
+	column := from.columnNames[from.columns[from.selectedColumn]]
+	// Grouped by a virtual field, the copy's column follows from its data
+	optionID := ""
+	for _, opt := range groupField.Options {
+		if strings.EqualFold(opt.Name, column) && !store.IsVirtualField(groupField) {
+			optionID = opt.ID
+			break
+		}
+	}
+	body, _ := from.store.GetBody(card.ItemID)
+
+	if to.client == nil {
+		return nil, ""
+	}
+	key := "copy:" + project.ID + ":" + card.ItemID
+	ctx, ok := to.guard.begin(to.ctx, key)
+	if !ok {
+		return nil, ""
+	}
+	client := to.client
+	return func() tea.Msg {
+		defer to.guard.end(key)
+
+		itemID, err := addCopy(ctx, client, project.ID, card, body)
+		if err != nil {
+			return itemCopyErrorMsg{err: err}
+		}
+		copied := itemCopiedMsg{title: card.Title, column: column}
+		if optionID == "" {
+			copied.column = "No " + groupField.Name
+		} else {
+			copied.err = client.UpdateItemField(ctx, project.ID, itemID, groupField.ID, gh.SingleSelectValue(optionID))
+		}
+		return copied
+	}, ""
+}
+
+// addCopy adds a card's issue or PR to a project, or a new draft with the
+// same title and body, and returns the new project item ID
+func addCopy(ctx context.Context, client *gh.Client, projectID string, card *domain.Card, body string) (string, error) {
+	if card.ContentType == domain.ContentTypeDraftIssue {
+		return client.AddDraftIssue(ctx, projectID, card.Title, body)
+	}
+	return client.AddItem(ctx, projectID, card.ContentID)
+}
+
+// wrapPane tags the messages cmd produces with the pane they belong to.
+// Bubble Tea's own messages, such as quitting, running the editor, or
+// setting the window title, pass through so the runtime still handles them;
+// batches are unpacked so their commands are tagged too. Replies the runtime
+// sends later are tagged by the board's reply.
+func wrapPane(pane int, cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		switch msg := cmd().(type) {
+		case nil:
+			return nil
+		case tea.BatchMsg:
+			cmds := make([]tea.Cmd, len(msg))
+			for i, c := range msg {
+				cmds[i] = wrapPane(pane, c)
+			}
+			return tea.BatchMsg(cmds)
+		default:
+			if runtimeMsg(msg) {
+				return msg
+			}
+			return paneMsg{pane: pane, msg: msg}
+		}
+	}
+}
+
+// runtimeMsg reports whether msg is one of Bubble Tea's, most of which
+// (like tea.ExecProcess's) have unexported types only the runtime handles
+func runtimeMsg(msg tea.Msg) bool {
+	return reflect.TypeOf(msg).PkgPath() == reflect.TypeOf(tea.QuitMsg{}).PkgPath()
+}
+
+// replyFunc tags a message the runtime delivers on a board's behalf, such as
+// an editor closing, so it reaches the pane that started it
+type replyFunc func(tea.Msg) tea.Msg
+
+// tag returns msg tagged by r, or msg itself when r is nil
+func (r replyFunc) tag(msg tea.Msg) tea.Msg {
+	if r == nil {
+		return msg
+	}
+	return r(msg)
+}
+
+// compareGroupField picks how to group the second board: by the field the
+// first board uses when the project has one with that name
+func compareGroupField(fields []domain.FieldDef, name string) *domain.FieldDef {
+	ptrs := make([]*domain.FieldDef, 0, len(fields))
+	for i := range fields {
+		if fields[i].Type == domain.FieldTypeSingleSelect && fields[i].Name == name {
+			return &fields[i]
+		}
+		ptrs = append(ptrs, &fields[i])
+	}
+	selected, candidates, err := store.SelectGroupField(ptrs)
+	switch {
+	case err != nil:
+		return nil
+	case selected != nil:
+		return selected
+	case len(candidates) > 0:
+		return candidates[0]
+	}
+	return nil
+}
+
+// Message types for the comparison view
+type (
+	compareMsg struct{} // Pick a project to show beside the board
+
+	// compareReadyMsg carries the second project, with fields and grouping set
+	compareReadyMsg struct{ store *store.Store }
+	compareErrorMsg struct{ err error }
+	closeCompareMsg struct{ left, right BoardModel }
+
+	// paneMsg is a message for one pane of the comparison view
+	paneMsg struct {
+		pane int
+		msg  tea.Msg
+	}
+
+	// itemCopiedMsg reports an item copied into this board's project; err is
+	// set when it could not be placed in its column
+	itemCopiedMsg struct {
+		title  string
+		column string
+		err    error
+	}
+	itemCopyErrorMsg struct{ err error }
+)
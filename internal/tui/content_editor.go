@@ -0,0 +1,151 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/editor"
+)
+
+// openContentEditor shows the title and description for editing in place of
+// the description pane
+func (m *DetailModel) openContentEditor() tea.Cmd {
+	if !editor.Editable(m.card) {
+		m.errorMsg, m.successMsg = "This item can't be edited", ""
+		return nil
+	}
+	if !m.bodyLoaded {
+		m.errorMsg, m.successMsg = "The description is still loading", ""
+		return nil
+	}
+	m.editContent, m.discardArmed = true, false
+	m.errorMsg, m.successMsg = "", ""
+	m.titleInput.SetValue(m.card.Title)
+	m.titleInput.CursorEnd()
+	m.bodyInput.SetValue(m.body)
+	m.bodyInput.Blur()
+	return m.titleInput.Focus()
+}
+
+// contentEdited reports whether the editor's text differs from the item's
+func (m DetailModel) contentEdited() bool {
+	return m.titleInput.Value() != m.card.Title || m.bodyInput.Value() != m.body
+}
+
+// handleContentEditorKey handles keys while editing the title and description
+func (m DetailModel) handleContentEditorKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+	if key != "esc" {
+		m.discardArmed = false
+	}
+	switch key {
+	case "esc":
+		if m.contentEdited() && !m.discardArmed {
+			m.discardArmed = true
+			m.errorMsg = "Unsaved edits: esc again to discard, ctrl+s to save"
+			return m, nil
+		}
+		m.closeContentEditor()
+		m.errorMsg = ""
+		return m, nil
+	case "ctrl+s":
+		return m, (&m).saveContent()
+	case "ctrl+e":
+		return m, editDraft(draftBody, m.bodyInput.Value(), nil)
+	case "tab", "shift+tab":
+		if m.titleInput.Focused() {
+			m.titleInput.Blur()
+			return m, m.bodyInput.Focus()
+		}
+		m.bodyInput.Blur()
+		return m, m.titleInput.Focus()
+	}
+
+	var cmd tea.Cmd
+	if m.titleInput.Focused() {
+		if key == "enter" {
+			// The title is one line; enter moves on to the description
+			m.titleInput.Blur()
+			return m, m.bodyInput.Focus()
+		}
+		m.titleInput, cmd = m.titleInput.Update(msg)
+	} else {
+		m.bodyInput, cmd = m.bodyInput.Update(msg)
+	}
+	return m, cmd
+}
+
+// closeContentEditor leaves the editor without saving
+func (m *DetailModel) closeContentEditor() {
+	m.editContent, m.discardArmed = false, false
+	m.titleInput.Blur()
+	m.bodyInput.Blur()
+}
+
+// saveContent sends the edited title and description to GitHub. The editor
+// stays open until they are saved, so a failure loses nothing.
+func (m *DetailModel) saveContent() tea.Cmd {
+	title := strings.TrimSpace(m.titleInput.Value())
+	if title == "" {
+		m.errorMsg = "A title is required"
+		return nil
+	}
+	body := m.bodyInput.Value()
+	if title == m.card.Title && body == m.body {
+		m.closeContentEditor()
+		return nil
+	}
+	if m.client == nil {
+		return nil
+	}
+
+	card := m.card
+	key := "edit:" + card.ContentID
+	ctx, ok := m.guard.begin(m.ctx, key)
+	if !ok {
+		return nil
+	}
+	m.loading, m.loadingAction = true, "Saving..."
+	client := m.client
+	return func() tea.Msg {
+		defer m.guard.end(key)
+		var err error
+		switch card.ContentType {
+		case domain.ContentTypeIssue:
+			err = client.UpdateIssue(ctx, card.ContentID, title, body)
+		case domain.ContentTypePullRequest:
+			err = client.UpdatePullRequest(ctx, card.ContentID, title, body)
+		default:
+			err = client.UpdateDraftIssue(ctx, card.ContentID, title, body)
+		}
+		if err != nil {
+			return contentErrorMsg{err: err}
+		}
+		return contentSavedMsg{card: card, title: title, body: body}
+	}
+}
+
+// renderContentEditor renders the title and description inputs
+func (m DetailModel) renderContentEditor(width int) string {
+	var b strings.Builder
+	b.WriteString(detailLabelStyle.Render("Edit title and description"))
+	b.WriteString("\n")
+	m.titleInput.Width = max(width-len(m.titleInput.Prompt)-2, 10)
+	b.WriteString(m.titleInput.View())
+	b.WriteString("\n")
+	b.WriteString(m.bodyInput.View())
+	return b.String()
+}
+
+// newBodyInput returns the description editor's textarea
+func newBodyInput() textarea.Model {
+	ta := textarea.New()
+	ta.Placeholder = "Description (markdown)"
+	ta.CharLimit = 65535
+	ta.ShowLineNumbers = false
+	ta.FocusedStyle.CursorLine = lipgloss.NewStyle()
+	return ta
+}
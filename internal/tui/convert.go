@@ -0,0 +1,117 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/gh"
+)
+
+// convertPicker chooses the repository a draft issue becomes an issue in
+type convertPicker struct {
+	card   *domain.Card
+	repos  []string
+	cursor int
+}
+
+// startConvert opens the repository picker for the selected draft issue
+func (m *BoardModel) startConvert() {
+	card := m.getSelectedCard()
+	if card == nil || card.ContentType != domain.ContentTypeDraftIssue {
+		m.errorToast = "Only draft issues can be converted"
+		return
+	}
+	repos := m.boardRepos()
+	if len(repos) == 0 {
+		m.errorToast = "No repositories on the board to convert into"
+		return
+	}
+	m.convert = &convertPicker{card: card, repos: repos}
+}
+
+// handleConvertKey handles keys while choosing a repository to convert into
+func (m BoardModel) handleConvertKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	p := m.convert
+	switch msg.String() {
+	case "esc", "q", "I":
+		m.convert = nil
+	case "j", "down":
+		if p.cursor < len(p.repos)-1 {
+			p.cursor++
+		}
+	case "k", "up":
+		if p.cursor > 0 {
+			p.cursor--
+		}
+	case "enter":
+		m.convert = nil
+		return m, m.convertDraft(p.card, p.repos[p.cursor])
+	}
+	return m, nil
+}
+
+// convertDraft turns a draft issue into an issue in repo. The item keeps its
+// place and fields on the board.
+func (m BoardModel) convertDraft(card *domain.Card, repo string) tea.Cmd {
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok || m.client == nil {
+		return nil
+	}
+	key := "convert:" + card.ItemID
+	ctx, ok := m.guard.begin(m.ctx, key)
+	if !ok {
+		return nil
+	}
+	client := m.client
+	return func() tea.Msg {
+		defer m.guard.end(key)
+		issue, err := client.ConvertDraftIssue(ctx, card.ItemID, owner, name)
+		if err != nil {
+			return draftConvertErrorMsg{err: err}
+		}
+		return draftConvertedMsg{card: card, repo: repo, issue: issue}
+	}
+}
+
+// renderConvert renders the repository picker for converting a draft issue
+func (m BoardModel) renderConvert(width int) string {
+	p := m.convert
+	var s strings.Builder
+	s.WriteString(titleStyle.Render(truncateLine("Convert to issue: "+p.card.Title, max(width-10, 20))))
+	s.WriteString("\n\n")
+	for i, repo := range p.repos {
+		cursor := "  "
+		if i == p.cursor {
+			cursor = "> "
+		}
+		s.WriteString(cursor + repo + "\n")
+	}
+	s.WriteString("\n")
+	s.WriteString(dimStyle.Render("j/k choose repository · enter convert · esc cancel"))
+	return HelpOverlayStyle.Render(s.String())
+}
+
+// applyConversion turns the board's card into the issue its draft became
+func (m *BoardModel) applyConversion(msg draftConvertedMsg) {
+	card := msg.card
+	card.ContentType = domain.ContentTypeIssue
+	card.ContentID = msg.issue.ID
+	card.Number = msg.issue.Number
+	card.URL = msg.issue.URL
+	card.Repo = msg.repo
+	card.State = "OPEN"
+	m.infoToast = fmt.Sprintf("Converted %q to %s#%d", card.Title, msg.repo, msg.issue.Number)
+	m.applyFilter()
+}
+
+// Message types for draft conversion
+type (
+	draftConvertedMsg struct {
+		card  *domain.Card
+		repo  string
+		issue gh.ConvertedIssue
+	}
+	draftConvertErrorMsg struct{ err error }
+)
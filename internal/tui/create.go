@@ -0,0 +1,367 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/h0rv/ghp/internal/config"
+	"github.com/h0rv/ghp/internal/gh"
+	"github.com/h0rv/ghp/internal/store"
+)
+
+// Fields of the item creation form, in tab order
+const (
+	createFieldRepo = iota
+	createFieldTemplate
+	createFieldTitle
+	createFieldBody
+	createFieldCount
+)
+
+// createForm is the form for a new item in one column. Repository choice 0
+// creates a draft issue; the others open an issue in that repository.
+type createForm struct {
+	columnID   string
+	columnName string
+	repos      []string // "" (draft) followed by repositories seen on the board
+	repo       int
+	templates  []config.Template // Local templates, then the repository's
+	template   int               // 0 for none, else templates[template-1]
+	applied    config.Template   // Title and body last filled in from a template
+	title      textinput.Model
+	body       textarea.Model
+	focus      int
+	err        string
+}
+
+// startCreate opens the creation form for the selected column
+func (m *BoardModel) startCreate() tea.Cmd {
+	if len(m.columns) == 0 {
+		return nil
+	}
+	colID := m.columns[m.selectedColumn]
+
+	ti := textinput.New()
+	ti.Placeholder = "title"
+	ti.Prompt = "Title: "
+	ti.CharLimit = 256
+
+	ta := textarea.New()
+	ta.Placeholder = "Body (optional, markdown)"
+	ta.ShowLineNumbers = false
+	ta.SetHeight(6)
+	ta.FocusedStyle.CursorLine = lipgloss.NewStyle()
+
+	f := &createForm{
+		columnID:   colID,
+		columnName: m.columnNames[colID],
+		repos:      append([]string{""}, m.boardRepos()...),
+		title:      ti,
+		body:       ta,
+	}
+	// Default to the repository of the selected card, so new items land
+	// next to the work they relate to
+	if card := m.getSelectedCard(); card != nil {
+		for i, repo := range f.repos {
+			if repo != "" && repo == card.Repo {
+				f.repo = i
+			}
+		}
+	}
+	m.create = f
+	m.refreshTemplates()
+	return tea.Batch(f.setFocus(createFieldTitle), m.loadRepoTemplates(f.repos[f.repo]))
+}
+
+// refreshTemplates lists the templates for the form's repository: the local
+// ones, then the repository's issue templates. A choice among another
+// repository's templates goes back to none, leaving the text it filled in.
+func (m *BoardModel) refreshTemplates() {
+	f := m.create
+	f.templates = append(slices.Clone(m.templates), m.repoTemplates[f.repos[f.repo]]...)
+	if f.template > len(m.templates) {
+		f.template = 0
+	}
+}
+
+// loadRepoTemplates fetches a repository's issue templates, once per board
+func (m *BoardModel) loadRepoTemplates(repo string) tea.Cmd {
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok || m.client == nil {
+		return nil
+	}
+	if _, requested := m.repoTemplates[repo]; requested {
+		return nil
+	}
+	if m.repoTemplates == nil {
+		m.repoTemplates = make(map[string][]config.Template)
+	}
+	m.repoTemplates[repo] = nil
+
+	client, ctx := m.client, m.ctx
+	return func() tea.Msg {
+		found, err := client.GetIssueTemplates(ctx, owner, name)
+		if err != nil {
+			return repoTemplatesMsg{repo: repo, err: err}
+		}
+		templates := make([]config.Template, len(found))
+		for i, t := range found {
+			templates[i] = config.Template{Name: t.Name, Title: t.Title, Body: t.Body}
+		}
+		return repoTemplatesMsg{repo: repo, templates: templates}
+	}
+}
+
+// chooseTemplate moves to the next template (delta 1) or the previous
+// (delta -1) and fills in the title and body from it. Once they have been
+// edited, the choice stays put so nothing typed is lost.
+func (f *createForm) chooseTemplate(delta int) {
+	if f.title.Value() != f.applied.Title || f.body.Value() != f.applied.Body {
+		f.err = "Clear the title and body to fill them from a template"
+		return
+	}
+	n := len(f.templates) + 1
+	f.template = (f.template + delta + n) % n
+	var t config.Template
+	if f.template > 0 {
+		t = f.templates[f.template-1]
+	}
+	f.err = ""
+	f.title.SetValue(t.Title)
+	f.body.SetValue(t.Body)
+	f.applied = config.Template{Title: f.title.Value(), Body: f.body.Value()}
+}
+
+// nextField returns the field delta steps from the focused one, skipping
+// the template choice when there are none
+func (f *createForm) nextField(delta int) int {
+	field := (f.focus + delta + createFieldCount) % createFieldCount
+	if field == createFieldTemplate && len(f.templates) == 0 {
+		field = (field + delta + createFieldCount) % createFieldCount
+	}
+	return field
+}
+
+// boardRepos returns the repositories of the board's issues and PRs, sorted
+func (m BoardModel) boardRepos() []string {
+	seen := make(map[string]bool)
+	var repos []string
+	for _, card := range m.store.GetAllCards() {
+		if card.Repo != "" && !seen[card.Repo] {
+			seen[card.Repo] = true
+			repos = append(repos, card.Repo)
+		}
+	}
+	sort.Strings(repos)
+	return repos
+}
+
+// setFocus moves the cursor to a form field
+func (f *createForm) setFocus(field int) tea.Cmd {
+	f.focus = field
+	f.title.Blur()
+	f.body.Blur()
+	switch field {
+	case createFieldTitle:
+		return f.title.Focus()
+	case createFieldBody:
+		return f.body.Focus()
+	}
+	return nil
+}
+
+// handleCreateKey handles keys while the creation form is open
+func (m BoardModel) handleCreateKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	f := m.create
+	switch msg.String() {
+	case "esc":
+		m.create = nil
+		return m, nil
+	case "tab":
+		return m, f.setFocus(f.nextField(1))
+	case "shift+tab":
+		return m, f.setFocus(f.nextField(-1))
+	case "ctrl+s":
+		return m.submitCreate()
+	case "ctrl+e":
+		return m, editDraft(draftCreateBody, f.body.Value(), m.reply)
+	case "enter":
+		// Newlines belong to the body; elsewhere enter submits
+		if f.focus != createFieldBody {
+			return m.submitCreate()
+		}
+	}
+
+	var cmd tea.Cmd
+	switch f.focus {
+	case createFieldRepo:
+		switch msg.String() {
+		case "left", "h", "k", "up":
+			f.repo = (f.repo + len(f.repos) - 1) % len(f.repos)
+		case "right", "l", "j", "down", " ":
+			f.repo = (f.repo + 1) % len(f.repos)
+		default:
+			return m, nil
+		}
+		(&m).refreshTemplates()
+		cmd = (&m).loadRepoTemplates(f.repos[f.repo])
+	case createFieldTemplate:
+		switch msg.String() {
+		case "left", "h", "k", "up":
+			f.chooseTemplate(-1)
+		case "right", "l", "j", "down", " ":
+			f.chooseTemplate(1)
+		}
+	case createFieldTitle:
+		f.title, cmd = f.title.Update(msg)
+	case createFieldBody:
+		f.body, cmd = f.body.Update(msg)
+	}
+	return m, cmd
+}
+
+// submitCreate validates the form and starts creating the item
+func (m BoardModel) submitCreate() (tea.Model, tea.Cmd) {
+	f := m.create
+	title := strings.TrimSpace(f.title.Value())
+	if title == "" {
+		f.err = "A title is required"
+		return m, f.setFocus(createFieldTitle)
+	}
+	m.create = nil
+	return m, m.createItem(f.columnID, f.columnName, f.repos[f.repo], title, f.body.Value())
+}
+
+// createItem creates an issue in repo (a draft issue when repo is empty),
+// adds it to the project, and places it in the column
+func (m BoardModel) createItem(columnID, columnName, repo, title, body string) tea.Cmd {
+	project := m.store.GetProject()
+	groupField := m.store.GetGroupField()
+	if project == nil || groupField == nil || m.client == nil {
+		return nil
+	}
+
+	key := "create:" + repo + ":" + title
+	ctx, ok := m.guard.begin(m.ctx, key)
+	if !ok {
+		return nil
+	}
+
+	optionID := columnID
+	if columnID == store.NoStatusKey {
+		optionID = ""
+	}
+	// Grouped by a virtual field, the new item's column follows from the item,
+	// whichever column it was made in: Unassigned, or its repository's
+	if store.IsVirtualField(groupField) {
+		optionID, columnName = "", store.NoValueName(groupField)
+		if groupField.ID == store.RepoFieldID && repo != "" {
+			columnName = repo
+		}
+	}
+
+	client := m.client
+	return func() tea.Msg {
+		defer m.guard.end(key)
+
+		itemID, err := addNewItem(ctx, client, project.ID, repo, title, body)
+		if err != nil {
+			return itemCreateErrorMsg{err: err}
+		}
+
+		created := itemCreatedMsg{title: title, column: columnName}
+		if optionID != "" {
+			created.err = client.UpdateItemField(ctx, project.ID, itemID, groupField.ID, gh.SingleSelectValue(optionID))
+		}
+		return created
+	}
+}
+
+// addNewItem creates the item's content and returns the new project item ID
+func addNewItem(ctx context.Context, client *gh.Client, projectID, repo, title, body string) (string, error) {
+	if repo == "" {
+		return client.AddDraftIssue(ctx, projectID, title, body)
+	}
+
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok {
+		return "", fmt.Errorf("invalid repository %q", repo)
+	}
+	contentID, err := client.CreateIssue(ctx, owner, name, title, body)
+	if err != nil {
+		return "", err
+	}
+	return client.AddItem(ctx, projectID, contentID)
+}
+
+// renderCreate renders the creation form
+func (m BoardModel) renderCreate(width int) string {
+	f := m.create
+	inner := max(min(width-8, 100), 30)
+	f.title.Width = inner - lipgloss.Width(f.title.Prompt) - 1
+	f.body.SetWidth(inner)
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("New item in " + f.columnName))
+	b.WriteString("\n\n")
+
+	repo := "Draft issue (no repository)"
+	if f.repos[f.repo] != "" {
+		repo = "Issue in " + f.repos[f.repo]
+	}
+	repoLine := fmt.Sprintf("Repository: ‹ %s ›", repo)
+	if f.focus == createFieldRepo {
+		repoLine = SelectedItemStyle.Render(repoLine)
+	}
+	b.WriteString(repoLine)
+	b.WriteString("\n")
+	if len(f.templates) > 0 {
+		name := "none"
+		if f.template > 0 {
+			name = f.templates[f.template-1].Name
+		}
+		templateLine := fmt.Sprintf("Template:   ‹ %s ›", name)
+		if f.focus == createFieldTemplate {
+			templateLine = SelectedItemStyle.Render(templateLine)
+		}
+		b.WriteString(templateLine)
+		b.WriteString("\n")
+	}
+	b.WriteString(f.title.View())
+	b.WriteString("\n\n")
+	b.WriteString(f.body.View())
+	b.WriteString("\n")
+
+	if f.err != "" {
+		b.WriteString(errorStyle.Render(f.err))
+		b.WriteString("\n")
+	}
+	b.WriteString(dimStyle.Render("tab next field · ←/→ repository or template · enter create (ctrl+s in body) · ctrl+e body in $EDITOR · esc cancel"))
+	return HelpOverlayStyle.Render(b.String())
+}
+
+// Message types for item creation
+type (
+	// itemCreatedMsg reports a new item; err is set when it could not be
+	// placed in its column
+	itemCreatedMsg struct {
+		title  string
+		column string
+		err    error
+	}
+	itemCreateErrorMsg struct{ err error }
+
+	// repoTemplatesMsg carries a repository's issue templates
+	repoTemplatesMsg struct {
+		repo      string
+		templates []config.Template
+		err       error
+	}
+)
@@ -0,0 +1,110 @@
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/uistate"
+)
+
+// Card densities z cycles through. Auto picks two-line cards when they fit.
+const (
+	densityAuto     = ""
+	densityCompact  = "compact"  // Title only
+	densityNormal   = "normal"   // Title, then repository and assignees
+	densityExpanded = "expanded" // Normal, then labels and last update
+)
+
+var densities = []string{densityAuto, densityCompact, densityNormal, densityExpanded}
+
+// density returns the card density set for the project
+func (m BoardModel) density() string {
+	if m.uiState == nil {
+		return densityAuto
+	}
+	return m.uiState.Density
+}
+
+// cardLines returns how many lines each card takes. Auto density uses two
+// when every shown column's cards fit that way, so small terminals and long
+// columns fall back to one. Zoomed rows already carry the details.
+func (m BoardModel) cardLines() int {
+	if m.zoomed {
+		return 1
+	}
+	switch m.density() {
+	case densityCompact:
+		return 1
+	case densityNormal:
+		return 2
+	case densityExpanded:
+		return 3
+	}
+	if len(m.columns) == 0 {
+		return 1
+	}
+	start, end, _, _ := m.columnLayout(m.width)
+	for _, colID := range m.columns[start:end] {
+		if 2*len(m.filteredCards[colID]) > m.cardRows(colID) {
+			return 1
+		}
+	}
+	return 2
+}
+
+// cycleDensity switches to the next card density and saves it
+func (m *BoardModel) cycleDensity() tea.Cmd {
+	if m.store.GetProject() == nil {
+		return nil
+	}
+	if m.uiState == nil {
+		m.uiState = &uistate.State{}
+	}
+	m.uiState.Density = nextSortKey(densities, m.uiState.Density)
+	switch m.uiState.Density {
+	case densityAuto:
+		m.infoToast = "Card density: auto"
+	case densityCompact:
+		m.infoToast = "Card density: compact (one line)"
+	case densityNormal:
+		m.infoToast = "Card density: normal (two lines)"
+	default:
+		m.infoToast = "Card density: expanded (three lines)"
+	}
+	if len(m.columns) > 0 {
+		m.adjustScroll(m.columns[m.selectedColumn])
+	}
+	return tea.Batch(m.saveUIState(), m.loadSortDetails())
+}
+
+// formatCardMeta formats the second line of a two-line card: where the item
+// lives and who it's assigned to
+func formatCardMeta(card *domain.Card, maxWidth int) string {
+	var parts []string
+	switch {
+	case card.Repo != "":
+		parts = append(parts, card.Repo)
+	case card.ContentType == domain.ContentTypeDraftIssue:
+		parts = append(parts, "draft")
+	}
+	if len(card.Assignees) > 0 {
+		parts = append(parts, "@"+strings.Join(card.Assignees, " @"))
+	} else if card.ContentType != domain.ContentTypeRestricted && card.ContentType != domain.ContentTypePrivate {
+		parts = append(parts, "unassigned")
+	}
+	return truncateLine(strings.Join(parts, " · "), maxWidth)
+}
+
+// formatCardDetails formats the third line of an expanded card: its labels
+// and when it was last updated, once details have loaded
+func formatCardDetails(card *domain.Card, maxWidth int) string {
+	var parts []string
+	if len(card.Labels) > 0 {
+		parts = append(parts, "["+strings.Join(card.Labels, ", ")+"]")
+	}
+	if card.UpdatedAt != "" {
+		parts = append(parts, formatTimeAgo(card.UpdatedAt))
+	}
+	return truncateLine(strings.Join(parts, " · "), maxWidth)
+}
@@ -8,10 +8,12 @@ import (
 
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/editor"
 	"github.com/h0rv/ghp/internal/gh"
 	"github.com/muesli/reflow/wordwrap"
 	"github.com/pkg/browser"
@@ -25,8 +27,27 @@ const (
 	headerHeight   = 1
 	footerHeight   = 1
 	borderSize     = 2 // Top + bottom border
+
+	// Terminals at least this wide start with body and comments side by side
+	sideBySideMinWidth = 160
+)
+
+// detailPane identifies the scrollable pane that has focus
+type detailPane int
+
+const (
+	bodyPane detailPane = iota
+	commentsPane
 )
 
+// paneRect is the outer size of a bordered panel
+type paneRect struct{ width, height int }
+
+// detailLayout holds the outer sizes of all detail panels
+type detailLayout struct {
+	info, body, comments paneRect
+}
+
 // Detail view styles
 var (
 	detailTitleStyle = lipgloss.NewStyle().
@@ -79,24 +100,108 @@ type DetailModel struct {
 	client *gh.Client
 	ctx    context.Context
 
-	// Card data
-	card     *domain.Card
-	comments []domain.Comment
+	// Card data; the body is loaded separately from the card
+	card       *domain.Card
+	body       string
+	bodyLoaded bool
+	comments   []domain.Comment
+	threads    []domain.ReviewThread // Pull request review threads, below the comments
 
 	// UI components
 	spinner      spinner.Model
 	commentInput textarea.Model
-	viewport     viewport.Model
+	bodyView     viewport.Model // Description
+	viewport     viewport.Model // Comments
 
 	// State
 	commentMode     bool
-	confirmExit     bool // Show "unsaved changes" prompt
+	reviewEvent     string // Review the comment input is the body of, "" for a plain comment
+	confirmExit     bool   // Show "unsaved changes" prompt
 	loading         bool
 	loadingAction   string
 	loadingComments bool
 	commentsError   string
+	olderCursor     string // Cursor for the comments before the loaded ones, "" when all are loaded
+	loadingOlder    bool
 	errorMsg        string
 	successMsg      string
+	reducedMotion   bool               // Static loading text instead of spinners
+	prefetcher      *commentPrefetcher // Comment cache shared with the board, may be nil
+	guard           *mutationGuard     // Drops a comment posted twice
+	outbox          *outbox            // Comments on their way to GitHub, shared with the board
+
+	// Pane layout: body and comments stacked or side by side
+	sideBySide bool
+	layoutSet  bool // User picked a layout; don't re-pick on resize
+	focus      detailPane
+
+	// One-line board context shown above the panes when enabled
+	boardSummary string
+	showSummary  bool
+
+	// Descriptions and comments render as markdown unless rawMarkdown is on;
+	// each pane has its own width
+	rawMarkdown     bool
+	bodyMarkdown    *markdownRenderer
+	commentMarkdown *markdownRenderer
+
+	// Field editor, shown in place of the description; fieldValues maps
+	// field ID to the item's value and is nil until loaded
+	projectID    string
+	fieldDefs    []domain.FieldDef // Editable project fields
+	editFields   bool
+	fieldValues  map[string]domain.FieldValue
+	fieldsError  string
+	fieldCursor  int
+	fieldEditing bool            // Changing the value under the cursor
+	fieldInput   textinput.Model // Typed text, number, and date values
+	fieldChoice  int             // Option or iteration being picked
+
+	// Title and description editor, also shown in place of the description
+	editContent  bool
+	discardArmed bool // esc was pressed once with unsaved edits
+	titleInput   textinput.Model
+	bodyInput    textarea.Model
+
+	// Label picker, also shown in place of the description; labels is nil
+	// until loaded
+	editLabels  bool
+	labels      []gh.Label
+	labelsError string
+	labelCursor int
+
+	// Comment selection for replies; offsets are each comment's first viewport line
+	selectedComment int
+	commentOffsets  []int
+	threadsOffset   int // First viewport line of the review threads, -1 without them
+
+	// Links on screen numbered by gx; links is nil unless one is being picked
+	links      []string
+	linkDigits string
+	pendingG   bool // g was pressed; x next picks a link instead
+	gOffset    int  // Where the focused pane was before g scrolled it to the top
+
+	// Search through the body and comments; hits are matching line numbers
+	searchMode  bool
+	searchInput textinput.Model
+	searchQuery string
+	bodyHits    []int
+	commentHits []int
+	hitIndex    int // Current match among the body's, then the comments'
+
+	// Issues and PRs linked to the card, selected with [ and ]
+	linked       []domain.LinkedItem
+	linkedLoaded bool
+	linkedError  string
+	linkedCursor int
+
+	// CI checks on a PR's head commit
+	checks       []domain.Check
+	checksLoaded bool
+	checksError  string
+
+	// Detail view a linked item was opened from, shown again on close
+	previous *DetailModel
 
 	// View dimensions
 	width  int
@@ -105,10 +210,6 @@ type DetailModel struct {
 
 // NewDetailModel creates a new detail view model
 func NewDetailModel(card *domain.Card, client *gh.Client, ctx context.Context) DetailModel {
-	sp := spinner.New()
-	sp.Spinner = spinner.Dot
-	sp.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
-
 	ta := textarea.New()
 	ta.Placeholder = "Write your comment here..."
 	ta.CharLimit = 65535
@@ -126,27 +227,73 @@ func NewDetailModel(card *domain.Card, client *gh.Client, ctx context.Context) D
 	vp := viewport.New(40, 10) // Will be resized in WindowSizeMsg
 	vp.MouseWheelEnabled = true
 	vp.MouseWheelDelta = 3
+	bodyVP := vp
+
+	si := textinput.New()
+	si.Placeholder = "search body and comments"
+	si.Prompt = "/ "
+
+	ti := textinput.New()
+	ti.Prompt = "Title: "
+	ti.CharLimit = 256
+
+	hasComments := card.ContentType == domain.ContentTypeIssue || card.ContentType == domain.ContentTypePullRequest
 
 	return DetailModel{
-		client:       client,
-		ctx:          ctx,
-		card:         card,
-		spinner:      sp,
-		commentInput: ta,
-		viewport:     vp,
+		client:          client,
+		ctx:             ctx,
+		card:            card,
+		body:            card.Body,
+		bodyLoaded:      card.Body != "",
+		loadingComments: hasComments,
+		linkedLoaded:    !hasComments,
+		checksLoaded:    card.PR == nil || card.ContentID == "",
+		spinner:         newSpinner(),
+		commentInput:    ta,
+		searchInput:     si,
+		titleInput:      ti,
+		bodyInput:       newBodyInput(),
+		bodyMarkdown:    &markdownRenderer{},
+		commentMarkdown: &markdownRenderer{},
+		bodyView:        bodyVP,
+		viewport:        vp,
+		focus:           commentsPane,
+		threadsOffset:   -1,
+		guard:           newMutationGuard(),
+		outbox:          newOutbox(),
 	}
 }
 
 // Init initializes the detail model
 func (m DetailModel) Init() tea.Cmd {
-	cmds := []tea.Cmd{m.spinner.Tick, tea.WindowSize()}
-	if m.card.ContentType == domain.ContentTypeIssue || m.card.ContentType == domain.ContentTypePullRequest {
-		m.loadingComments = true
+	cmds := []tea.Cmd{spinnerTick(m.spinner, m.reducedMotion), tea.WindowSize()}
+	if !m.bodyLoaded && m.card.ContentID != "" {
+		cmds = append(cmds, m.loadDetails())
+	}
+	if m.loadingComments || m.comments != nil {
+		// Prefetched comments show immediately; this refreshes them
 		cmds = append(cmds, m.loadComments())
 	}
+	if !m.linkedLoaded {
+		cmds = append(cmds, m.loadLinked())
+	}
+	if !m.checksLoaded {
+		cmds = append(cmds, m.loadChecks())
+	}
 	return tea.Batch(cmds...)
 }
 
+// usePrefetched shows cached comments for the card, if the prefetcher has them
+func (m *DetailModel) usePrefetched() {
+	if m.prefetcher == nil {
+		return
+	}
+	if page, ok := m.prefetcher.Get(m.card); ok {
+		m.comments, m.threads, m.olderCursor = page.Comments, page.Threads, page.OlderCursor
+		m.loadingComments = false
+	}
+}
+
 // Update handles messages
 func (m DetailModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
@@ -155,14 +302,23 @@ func (m DetailModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		if !m.layoutSet {
+			m.sideBySide = m.width >= sideBySideMinWidth
+		}
 		m.resizeComponents()
 		return m, nil
 
 	case spinner.TickMsg:
+		if m.reducedMotion {
+			return m, nil
+		}
 		var cmd tea.Cmd
 		m.spinner, cmd = m.spinner.Update(msg)
 		return m, cmd
 
+	case outboxResultMsg:
+		return m.Update(m.outbox.resolve(msg))
+
 	case commentPostedMsg:
 		m.loading = false
 		m.commentMode = false
@@ -171,6 +327,82 @@ func (m DetailModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Reload comments to show the new one
 		return m, m.loadComments()
 
+	case yankedMsg:
+		m.errorMsg = ""
+		m.successMsg = "Copied " + msg.text
+		if msg.unconfirmed {
+			m.successMsg = "Sent to the terminal's clipboard (if it supports OSC 52): " + msg.text
+		}
+		return m, nil
+
+	case osc52Msg:
+		return m, copyWithOSC52(msg.text)
+
+	case yankErrorMsg:
+		m.successMsg = ""
+		m.errorMsg = fmt.Sprintf("Copy failed: %v", msg.err)
+		return m, nil
+
+	case detailsLoadedMsg:
+		if msg.itemID == m.card.ItemID {
+			m.body = msg.details.Body
+			m.bodyLoaded = true
+			m.updateBodyContent()
+		}
+		return m, nil
+
+	case draftEditedMsg:
+		text, err := readDraft(msg)
+		if err != nil {
+			m.errorMsg, m.successMsg = fmt.Sprintf("Draft not loaded: %v", err), ""
+			return m, nil
+		}
+		switch {
+		case msg.target == draftComment && m.commentMode:
+			m.commentInput.SetValue(text)
+			return m, m.commentInput.Focus()
+		case msg.target == draftBody && m.editContent:
+			m.bodyInput.SetValue(text)
+			m.titleInput.Blur()
+			return m, m.bodyInput.Focus()
+		}
+		return m, nil
+
+	case contentSavedMsg:
+		m.loading = false
+		msg.card.Title = msg.title
+		if msg.card == m.card {
+			m.body = msg.body
+			m.updateBodyContent()
+			m.closeContentEditor()
+		}
+		m.errorMsg, m.successMsg = "", "Saved "+cardLabel(msg.card)
+		return m, nil
+
+	case contentErrorMsg:
+		m.loading = false
+		m.errorMsg, m.successMsg = fmt.Sprintf("Not saved: %v", msg.err), ""
+		return m, nil
+
+	case detailsErrorMsg:
+		m.bodyLoaded = true
+		m.errorMsg = fmt.Sprintf("Description: %v", msg.err)
+		return m, nil
+
+	case reviewSubmittedMsg:
+		m.loading = false
+		m.commentMode = false
+		m.reviewEvent = ""
+		m.commentInput.Reset()
+		m.commentInput.Blur()
+		m.successMsg = reviewDone(msg.event)
+		return m, nil
+
+	case reviewErrorMsg:
+		m.loading = false
+		m.errorMsg = fmt.Sprintf("Review failed: %v", msg.err)
+		return m, nil
+
 	case commentErrorMsg:
 		m.loading = false
 		m.errorMsg = fmt.Sprintf("Failed: %v", msg.err)
@@ -178,7 +410,13 @@ func (m DetailModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case commentsLoadedMsg:
 		m.loadingComments = false
-		m.comments = msg.comments
+		m.comments, m.threads, m.olderCursor = msg.comments, msg.threads, msg.olderCursor
+		if m.prefetcher != nil {
+			m.prefetcher.Put(m.card, domain.CommentPage{Comments: msg.comments, OlderCursor: msg.olderCursor, Threads: msg.threads})
+		}
+		if m.selectedComment >= len(m.comments) {
+			m.selectedComment = max(len(m.comments)-1, 0)
+		}
 		m.updateViewportContent()
 		return m, nil
 
@@ -187,14 +425,100 @@ func (m DetailModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.commentsError = msg.err.Error()
 		return m, nil
 
+	case olderCommentsLoadedMsg:
+		m.prependComments(msg)
+		return m, nil
+
+	case olderCommentsErrorMsg:
+		m.loadingOlder = false
+		m.errorMsg, m.successMsg = fmt.Sprintf("Failed to load older comments: %v", msg.err), ""
+		return m, nil
+
+	case checksLoadedMsg:
+		if msg.contentID == m.card.ContentID {
+			m.checks, m.checksLoaded = msg.checks, true
+		}
+		return m, nil
+
+	case checksErrorMsg:
+		if msg.contentID == m.card.ContentID {
+			m.checksLoaded = true
+			m.checksError = msg.err.Error()
+		}
+		return m, nil
+
+	case linkedLoadedMsg:
+		if msg.contentID == m.card.ContentID {
+			m.linked, m.linkedLoaded = msg.items, true
+			m.linkedCursor = 0
+		}
+		return m, nil
+
+	case linkedErrorMsg:
+		if msg.contentID == m.card.ContentID {
+			m.linkedLoaded = true
+			m.linkedError = msg.err.Error()
+		}
+		return m, nil
+
+	case fieldValuesLoadedMsg:
+		if msg.itemID == m.card.ItemID {
+			m.fieldValues = make(map[string]domain.FieldValue, len(msg.values))
+			for _, v := range msg.values {
+				m.fieldValues[v.FieldID] = v
+			}
+		}
+		return m, nil
+
+	case fieldValuesErrorMsg:
+		m.fieldsError = fmt.Sprintf("Fields: %v", msg.err)
+		return m, nil
+
+	case fieldValueSavedMsg:
+		m.successMsg = "Saved " + msg.value.Field
+		return m, nil
+
+	case fieldValueErrorMsg:
+		m.restoreFieldValue(msg)
+		m.errorMsg = fmt.Sprintf("%s not saved: %v", msg.field.Name, msg.err)
+		return m, nil
+
+	case stateChangedMsg:
+		m.errorMsg, m.successMsg = "", stateToast(msg.card)
+		return m, nil
+
+	case stateErrorMsg:
+		msg.card.State = msg.previous
+		m.errorMsg = fmt.Sprintf("State not changed: %v", msg.err)
+		return m, nil
+
+	case labelsLoadedMsg:
+		if msg.itemID == m.card.ItemID {
+			m.labels = msg.labels
+		}
+		return m, nil
+
+	case labelsErrorMsg:
+		m.labelsError = fmt.Sprintf("Labels: %v", msg.err)
+		return m, nil
+
+	case labelSavedMsg:
+		return m, nil
+
+	case labelErrorMsg:
+		applyLabel(m.card, msg.name, !msg.added)
+		m.errorMsg = fmt.Sprintf("Label %s not changed: %v", msg.name, msg.err)
+		return m, nil
+
 	case tea.KeyMsg:
 		return m.handleKeyPress(msg)
 
 	case tea.MouseMsg:
-		// Forward mouse events to viewport when not in comment mode
+		// Forward mouse events to the focused pane when not in comment mode
 		if !m.commentMode {
 			var cmd tea.Cmd
-			m.viewport, cmd = m.viewport.Update(msg)
+			vp := m.focusedView()
+			*vp, cmd = vp.Update(msg)
 			cmds = append(cmds, cmd)
 		}
 	}
@@ -205,46 +529,105 @@ func (m DetailModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.commentInput, cmd = m.commentInput.Update(msg)
 		cmds = append(cmds, cmd)
 	}
+	if m.editContent {
+		var cmd tea.Cmd
+		if m.titleInput.Focused() {
+			m.titleInput, cmd = m.titleInput.Update(msg)
+		} else {
+			m.bodyInput, cmd = m.bodyInput.Update(msg)
+		}
+		cmds = append(cmds, cmd)
+	}
 
 	return m, tea.Batch(cmds...)
 }
 
-// resizeComponents calculates and sets component dimensions
-func (m *DetailModel) resizeComponents() {
-	// Calculate panel widths
-	leftWidth := int(float64(m.width) * leftPanelRatio)
+// layout computes panel sizes for the current dimensions and pane arrangement
+func (m DetailModel) layout() detailLayout {
+	width, height := m.width, m.height
+	if width == 0 {
+		width = 100
+	}
+	if height == 0 {
+		height = 30
+	}
+
+	// Info panel takes a share of the width, within bounds
+	leftWidth := int(float64(width) * leftPanelRatio)
 	if leftWidth < minLeftWidth {
 		leftWidth = minLeftWidth
 	}
 	if leftWidth > maxLeftWidth {
 		leftWidth = maxLeftWidth
 	}
-
-	// Right panel gets remaining width minus borders and gap
-	rightWidth := m.width - leftWidth - 3 // 3 = gap between panels
+	rightWidth := width - leftWidth - 1 // 1 char gap
 	if rightWidth < 30 {
 		rightWidth = 30
 	}
 
-	// Content height = total - header - footer - borders
-	contentHeight := m.height - headerHeight - footerHeight - borderSize
+	contentHeight := height - headerHeight - footerHeight
+	if m.summaryVisible() {
+		contentHeight--
+	}
 	if contentHeight < 10 {
 		contentHeight = 10
 	}
 
-	// Set viewport dimensions (account for border in right panel)
-	m.viewport.Width = rightWidth - borderSize - 2     // -2 for padding
-	m.viewport.Height = contentHeight - borderSize - 8 // Reserve space for comment input
+	l := detailLayout{info: paneRect{leftWidth, contentHeight}}
+	if m.sideBySide {
+		bodyWidth := rightWidth / 2
+		l.body = paneRect{bodyWidth, contentHeight}
+		l.comments = paneRect{rightWidth - bodyWidth - 1, contentHeight}
+	} else {
+		bodyHeight := contentHeight * 2 / 5
+		if bodyHeight < 5 {
+			bodyHeight = 5
+		}
+		l.body = paneRect{rightWidth, bodyHeight}
+		l.comments = paneRect{rightWidth, contentHeight - bodyHeight}
+	}
+	return l
+}
+
+// resizeComponents calculates and sets component dimensions
+func (m *DetailModel) resizeComponents() {
+	l := m.layout()
+
+	// Viewports sit inside the border, below a one-line pane title
+	m.bodyView.Width = l.body.width - borderSize - 1
+	m.bodyView.Height = max(l.body.height-borderSize-1, 1)
+	m.viewport.Width = l.comments.width - borderSize - 1
+	m.viewport.Height = max(l.comments.height-borderSize-1, 1)
 
 	// Update comment input width
-	m.commentInput.SetWidth(rightWidth - borderSize - 4)
+	m.commentInput.SetWidth(l.comments.width - borderSize - 4)
 
-	// Re-render viewport content with new width (body + comments)
-	if m.card.Body != "" || len(m.comments) > 0 {
+	// The description editor fills the body pane below its title and a title input
+	m.bodyInput.SetWidth(l.body.width - borderSize - 2)
+	m.bodyInput.SetHeight(max(l.body.height-borderSize-2, 2))
+
+	// Re-render with new widths
+	m.updateBodyContent()
+	if len(m.comments) > 0 || len(m.threads) > 0 {
 		m.updateViewportContent()
 	}
 }
 
+// focusedView returns the viewport of the focused pane
+func (m *DetailModel) focusedView() *viewport.Model {
+	if m.focus == bodyPane {
+		return &m.bodyView
+	}
+	return &m.viewport
+}
+
+// toggleLayout switches between stacked and side-by-side panes
+func (m *DetailModel) toggleLayout() {
+	m.sideBySide = !m.sideBySide
+	m.layoutSet = true
+	m.resizeComponents()
+}
+
 // handleKeyPress processes keyboard input
 func (m DetailModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// Global quit
@@ -259,6 +642,7 @@ func (m DetailModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			// Discard and exit
 			m.confirmExit = false
 			m.commentMode = false
+			m.reviewEvent = ""
 			m.commentInput.Reset()
 			m.commentInput.Blur()
 			return m, func() tea.Msg { return closeDetailMsg{} }
@@ -269,11 +653,10 @@ func (m DetailModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		case "s", "S":
 			// Save and exit
 			m.confirmExit = false
-			comment := strings.TrimSpace(m.commentInput.Value())
-			if comment != "" {
+			if cmd := (&m).submitInput(); cmd != nil {
 				m.loading = true
 				m.loadingAction = "Posting..."
-				return m, m.postComment(comment)
+				return m, cmd
 			}
 			return m, nil
 		}
@@ -290,16 +673,18 @@ func (m DetailModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				return m, nil
 			}
 			m.commentMode = false
+			m.reviewEvent = ""
 			m.commentInput.Blur()
 			return m, nil
 		case "ctrl+s":
-			comment := strings.TrimSpace(m.commentInput.Value())
-			if comment != "" {
+			if cmd := (&m).submitInput(); cmd != nil {
 				m.loading = true
 				m.loadingAction = "Posting..."
-				return m, m.postComment(comment)
+				return m, cmd
 			}
 			return m, nil
+		case "ctrl+e":
+			return m, editDraft(draftComment, m.commentInput.Value(), nil)
 		default:
 			// Forward ALL other keys to textarea
 			var cmd tea.Cmd
@@ -308,10 +693,60 @@ func (m DetailModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 	}
 
-	// Normal mode - viewport scrolling
+	// Title and description editor
+	if m.editContent {
+		return m.handleContentEditorKey(msg)
+	}
+
+	// Field editor
+	if m.editFields {
+		return m.handleFieldEditorKey(msg)
+	}
+
+	// Label picker
+	if m.editLabels {
+		return m.handleLabelPickerKey(msg)
+	}
+
+	// Link number after gx
+	if m.links != nil {
+		return m.handleLinkPickKey(msg)
+	}
+
+	// Search input
+	if m.searchMode {
+		return m.handleSearchKey(msg)
+	}
+
+	// Normal mode - scrolling the focused pane
+	vp := m.focusedView()
+	if m.pendingG {
+		m.pendingG = false
+		if msg.String() == "x" {
+			// gx: number the links that were on screen before g scrolled away
+			vp.SetYOffset(m.gOffset)
+			m.startLinkPick()
+			return m, nil
+		}
+	}
 	switch msg.String() {
-	case "q", "esc":
+	case "esc":
+		// Clear a search before leaving
+		if m.searchQuery != "" {
+			m.clearSearch()
+			return m, nil
+		}
 		return m, func() tea.Msg { return closeDetailMsg{} }
+	case "q":
+		return m, func() tea.Msg { return closeDetailMsg{} }
+	case "/":
+		m.searchMode = true
+		m.searchInput.CursorEnd()
+		return m, m.searchInput.Focus()
+	case "n":
+		m.nextHit(1)
+	case "N":
+		m.nextHit(-1)
 	case "o":
 		if m.card.URL != "" {
 			_ = browser.OpenURL(m.card.URL)
@@ -324,100 +759,264 @@ func (m DetailModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.successMsg = ""
 			return m, textarea.Blink
 		}
+	case "a", "x", "w":
+		return m, (&m).startReview(reviewKeys[msg.String()])
+	case "tab", "shift+tab":
+		if m.focus == bodyPane {
+			m.focus = commentsPane
+		} else {
+			m.focus = bodyPane
+		}
+		m.updateViewportContent()
+	case "O":
+		return m, (&m).loadOlderComments()
+	case "J":
+		m.focus = commentsPane
+		m.selectComment(1)
+	case "K":
+		m.focus = commentsPane
+		m.selectComment(-1)
+	case "r":
+		if m.focus == commentsPane {
+			return m, m.startReply()
+		}
+	case "y":
+		// Copy the permalink of the selected comment, or the item in the body pane
+		if url := m.permalink(); url != "" {
+			return m, copyToClipboard(url)
+		}
+	case "e":
+		return m, (&m).openContentEditor()
+	case "f":
+		return m, m.openFieldEditor()
+	case "L":
+		return m, m.openLabelPicker()
+	case "C", "R":
+		cmd, refusal := changeState(m.ctx, m.guard, m.enqueue, m.client, m.card, msg.String() == "C")
+		if refusal != "" {
+			m.errorMsg, m.successMsg = refusal, ""
+		}
+		return m, cmd
+	case "]":
+		m.selectLinked(1)
+	case "[":
+		m.selectLinked(-1)
+	case "enter":
+		return m, m.openLinked()
+	case "v":
+		m.toggleLayout()
+	case "b":
+		m.showSummary = !m.showSummary
+		m.resizeComponents()
+	case "m":
+		m.rawMarkdown = !m.rawMarkdown
+		m.updateBodyContent()
+		m.updateViewportContent()
+		m.errorMsg, m.successMsg = "", "Showing rendered markdown"
+		if m.rawMarkdown {
+			m.successMsg = "Showing raw text"
+		}
 	case "j", "down":
-		m.viewport.LineDown(1)
+		vp.LineDown(1)
 	case "k", "up":
-		m.viewport.LineUp(1)
+		vp.LineUp(1)
 	case "ctrl+d":
-		m.viewport.HalfViewDown()
+		vp.HalfViewDown()
 	case "ctrl+u":
-		m.viewport.HalfViewUp()
+		vp.HalfViewUp()
+	case "ctrl+f":
+		vp.PageDown()
+	case "ctrl+b":
+		vp.PageUp()
 	case "g":
-		m.viewport.GotoTop()
+		m.pendingG, m.gOffset = true, vp.YOffset
+		vp.GotoTop()
 	case "G":
-		m.viewport.GotoBottom()
+		vp.GotoBottom()
 	}
 
 	return m, nil
 }
 
-// View renders the split-screen detail view
+// View renders the info panel beside the body and comments panes
 func (m DetailModel) View() string {
-	width := m.width
-	height := m.height
-	if width == 0 {
-		width = 100
-	}
-	if height == 0 {
-		height = 30
-	}
-
-	// Calculate panel widths
-	leftWidth := int(float64(width) * leftPanelRatio)
-	if leftWidth < minLeftWidth {
-		leftWidth = minLeftWidth
-	}
-	if leftWidth > maxLeftWidth {
-		leftWidth = maxLeftWidth
-	}
-	rightWidth := width - leftWidth - 1 // 1 char gap
-
-	// Content height
-	contentHeight := height - headerHeight - footerHeight
-	if contentHeight < 10 {
-		contentHeight = 10
+	l := m.layout()
+	width := l.info.width + 1 + l.body.width
+	if m.sideBySide {
+		width += 1 + l.comments.width
 	}
 
 	// === HEADER ===
 	header := m.renderHeader(width)
 
 	// === LEFT PANEL: Issue Info ===
-	leftContent := m.renderLeftPanel(leftWidth-borderSize, contentHeight-borderSize)
+	leftContent := m.renderLeftPanel(l.info.width-borderSize, l.info.height-borderSize)
 	leftPanel := panelBorderStyle.
-		Width(leftWidth - borderSize).
-		Height(contentHeight - borderSize).
+		Width(l.info.width - borderSize).
+		Height(l.info.height - borderSize).
 		Render(leftContent)
 
-	// === RIGHT PANEL: Comments ===
-	rightContent := m.renderRightPanel(rightWidth-borderSize, contentHeight-borderSize)
-	rightBorder := focusedPanelBorderStyle
-	if m.commentMode {
-		rightBorder = panelBorderStyle // Unfocus when typing
+	// === BODY AND COMMENTS PANES ===
+	bodyPanel := m.paneBorder(bodyPane).
+		Width(l.body.width - borderSize).
+		Height(l.body.height - borderSize).
+		Render(m.renderBodyPane(l.body.width - borderSize))
+	commentsPanel := m.paneBorder(commentsPane).
+		Width(l.comments.width - borderSize).
+		Height(l.comments.height - borderSize).
+		Render(m.renderRightPanel(l.comments.width-borderSize, l.comments.height-borderSize))
+
+	var right string
+	if m.sideBySide {
+		right = lipgloss.JoinHorizontal(lipgloss.Top, bodyPanel, " ", commentsPanel)
+	} else {
+		right = lipgloss.JoinVertical(lipgloss.Left, bodyPanel, commentsPanel)
 	}
-	rightPanel := rightBorder.
-		Width(rightWidth - borderSize).
-		Height(contentHeight - borderSize).
-		Render(rightContent)
-
-	// Join panels horizontally
-	panels := lipgloss.JoinHorizontal(lipgloss.Top, leftPanel, " ", rightPanel)
+	panels := lipgloss.JoinHorizontal(lipgloss.Top, leftPanel, " ", right)
 
 	// === FOOTER ===
 	footer := m.renderFooter(width)
 
 	// Join everything vertically
+	if m.summaryVisible() {
+		return lipgloss.JoinVertical(lipgloss.Left, header, m.renderSummary(width), panels, footer)
+	}
 	return lipgloss.JoinVertical(lipgloss.Left, header, panels, footer)
 }
 
+// summaryVisible reports whether the board summary strip is shown
+func (m DetailModel) summaryVisible() bool {
+	return m.showSummary && m.boardSummary != ""
+}
+
+// renderSummary renders the board summary strip, truncated to width
+func (m DetailModel) renderSummary(width int) string {
+	summary := m.boardSummary
+	if runes := []rune(summary); len(runes) > width {
+		summary = string(runes[:max(width-1, 0)]) + "…"
+	}
+	return dimStyle.Render(summary)
+}
+
+// paneBorder returns the border style for a pane, highlighting the focused one
+func (m DetailModel) paneBorder(pane detailPane) lipgloss.Style {
+	if m.focus == pane && !m.commentMode {
+		return focusedPanelBorderStyle
+	}
+	return panelBorderStyle
+}
+
+// scrollHint returns an arrow showing which way a viewport can scroll
+func scrollHint(vp viewport.Model) string {
+	if vp.TotalLineCount() <= vp.Height {
+		return ""
+	}
+	switch {
+	case vp.AtTop():
+		return " ↓"
+	case vp.AtBottom():
+		return " ↑"
+	}
+	return " ↕"
+}
+
+// renderBodyPane renders the description, or the field editor when open
+func (m DetailModel) renderBodyPane(width int) string {
+	if m.editContent {
+		return m.renderContentEditor(width)
+	}
+	if m.editFields {
+		return m.renderFieldEditor(width)
+	}
+	if m.editLabels {
+		return m.renderLabelPicker(width)
+	}
+	return m.renderBodyPanel()
+}
+
+// renderBodyPanel renders the description pane
+func (m DetailModel) renderBodyPanel() string {
+	var b strings.Builder
+	b.WriteString(detailLabelStyle.Render("Description"))
+	if !m.bodyLoaded && m.card.ContentID != "" {
+		b.WriteString("\n")
+		b.WriteString(loadingText(m.spinner, m.reducedMotion, "Loading…"))
+		return b.String()
+	}
+	if m.body == "" {
+		b.WriteString("\n")
+		b.WriteString(dimStyle.Render("No description"))
+		return b.String()
+	}
+	b.WriteString(scrollIndicatorStyle.Render(scrollHint(m.bodyView)))
+	b.WriteString("\n")
+	b.WriteString(m.bodyView.View())
+	return b.String()
+}
+
 // renderHeader renders the top help bar
 func (m DetailModel) renderHeader(width int) string {
 	if m.confirmExit {
 		return warningStyle.Render("Unsaved comment! [Y]discard [N]cancel [S]save and exit")
 	}
 
+	if m.commentMode && m.reviewEvent != "" {
+		header := dimStyle.Render("[Ctrl+S]submit [Ctrl+E]$EDITOR [ESC]cancel") + "  " +
+			commentAuthorStyle.Render(reviewVerb(m.reviewEvent)+"...")
+		if m.reviewEvent == gh.ReviewApprove {
+			header += dimStyle.Render(" (comment optional)")
+		}
+		return header
+	}
 	if m.commentMode {
-		return dimStyle.Render("[Ctrl+S]save [ESC]cancel") + "  " +
+		return dimStyle.Render("[Ctrl+S]save [Ctrl+E]$EDITOR [ESC]cancel") + "  " +
 			commentAuthorStyle.Render("Writing comment...")
 	}
 
+	if m.editContent {
+		return dimStyle.Render("[Ctrl+S]save [tab]title/description [Ctrl+E]$EDITOR [ESC]cancel")
+	}
+	if m.fieldEditing {
+		return dimStyle.Render("[enter]save [ESC]cancel [←/→]choose")
+	}
+	if m.editFields {
+		return dimStyle.Render("[j/k]select [enter]edit [d]clear [f/ESC]done")
+	}
+	if m.editLabels {
+		return dimStyle.Render("[j/k]select [enter/space]toggle [L/ESC]done")
+	}
+
 	var parts []string
 	parts = append(parts, "[q]back")
 	parts = append(parts, "[o]open")
 	parts = append(parts, "[j/k]scroll")
+	parts = append(parts, "[tab]focus")
+	parts = append(parts, "[v]layout")
+	if editor.Editable(m.card) {
+		parts = append(parts, "[e]edit")
+	}
+	if len(m.fieldDefs) > 0 {
+		parts = append(parts, "[f]fields")
+	}
+	parts = append(parts, "[b]board strip")
+	parts = append(parts, "[m]raw/markdown")
 	parts = append(parts, "[g/G]top/bottom")
+	parts = append(parts, "[/]search")
 
 	if m.card.ContentType == domain.ContentTypeIssue || m.card.ContentType == domain.ContentTypePullRequest {
-		parts = append(parts, "[c]comment")
+		parts = append(parts, "[c]comment [L]labels [C/R]close/reopen")
+		if len(m.comments) > 0 {
+			parts = append(parts, "[J/K]select [r]reply [y]yank link [gx]open a link")
+		}
+		if m.olderCursor != "" {
+			parts = append(parts, "[O]older comments")
+		}
+		if m.card.ContentType == domain.ContentTypePullRequest {
+			parts = append(parts, "[a]approve [x]request changes [w]review comment")
+		}
+		if len(m.linked) > 0 {
+			parts = append(parts, "[ [/] ]linked [enter]open linked")
+		}
 	}
 
 	help := strings.Join(parts, " ")
@@ -428,9 +1027,19 @@ func (m DetailModel) renderHeader(width int) string {
 func (m DetailModel) renderFooter(width int) string {
 	var left, right string
 
+	// Numbered links replace the whole footer while one is picked
+	if m.links != nil {
+		return m.renderLinkPick(width)
+	}
+
+	// The search input takes the whole footer while typing
+	if m.searchMode {
+		return m.searchInput.View()
+	}
+
 	// Left: status messages
 	if m.loading {
-		left = m.spinner.View() + " " + m.loadingAction
+		left = loadingText(m.spinner, m.reducedMotion, m.loadingAction)
 	} else if m.successMsg != "" {
 		left = lipgloss.NewStyle().Foreground(lipgloss.Color("34")).Render("✓ " + m.successMsg)
 	} else if m.errorMsg != "" {
@@ -438,14 +1047,20 @@ func (m DetailModel) renderFooter(width int) string {
 	} else if m.commentMode {
 		charCount := len(m.commentInput.Value())
 		left = fmt.Sprintf("%d chars", charCount)
+	} else if m.searchQuery != "" {
+		left = m.searchStatus()
 	}
 
-	// Right: scroll position
-	if len(m.comments) > 0 && !m.commentMode {
-		scrollPct := int(m.viewport.ScrollPercent() * 100)
-		if m.viewport.AtTop() {
+	// Right: scroll position of the focused pane
+	vp := m.viewport
+	if m.focus == bodyPane {
+		vp = m.bodyView
+	}
+	if vp.TotalLineCount() > 0 && !m.commentMode {
+		scrollPct := int(vp.ScrollPercent() * 100)
+		if vp.AtTop() {
 			right = "TOP"
-		} else if m.viewport.AtBottom() {
+		} else if vp.AtBottom() {
 			right = "END"
 		} else {
 			right = fmt.Sprintf("%d%%", scrollPct)
@@ -478,6 +1093,13 @@ func (m DetailModel) renderLeftPanel(width, height int) string {
 	b.WriteString(detailTitleStyle.Render(title))
 	b.WriteString("\n\n")
 
+	// Restricted items have nothing else to show but how to regain access
+	if m.card.AccessHint != "" {
+		b.WriteString(detailLabelStyle.Render("Access: "))
+		b.WriteString(detailValueStyle.Render(wordwrap.String(m.card.AccessHint, width-10)))
+		b.WriteString("\n")
+	}
+
 	// Metadata fields
 	if m.card.Repo != "" {
 		b.WriteString(detailLabelStyle.Render("Repo: "))
@@ -520,66 +1142,39 @@ func (m DetailModel) renderLeftPanel(width, height int) string {
 		b.WriteString("\n")
 	}
 
-	// Body preview
-	if m.card.Body != "" {
-		b.WriteString("\n")
-		b.WriteString(detailLabelStyle.Render("Description:"))
+	if pr := m.renderPRStatus(width); pr != "" {
+		b.WriteString(pr)
+	}
+
+	if linked := m.renderLinked(width); linked != "" {
 		b.WriteString("\n")
-		body := m.card.Body
-		// Limit body to fit in remaining space
-		maxBodyLines := height - strings.Count(b.String(), "\n") - 2
-		if maxBodyLines > 0 {
-			wrapped := wordwrap.String(body, width-2)
-			lines := strings.Split(wrapped, "\n")
-			if len(lines) > maxBodyLines {
-				lines = lines[:maxBodyLines-1]
-				lines = append(lines, "...")
-			}
-			b.WriteString(strings.Join(lines, "\n"))
-		}
+		b.WriteString(linked)
 	}
 
 	return b.String()
 }
 
-// renderRightPanel renders the comments panel with viewport
+// renderRightPanel renders the comments pane with its viewport
 func (m DetailModel) renderRightPanel(width, height int) string {
 	var b strings.Builder
 
-	// Panel title - include description as part of the discussion
-	title := "Discussion"
-	commentCount := len(m.comments)
-	if m.card.Body != "" {
-		// Description counts as the first entry
-		title = fmt.Sprintf("Discussion (%d)", commentCount+1)
-	} else if commentCount > 0 {
-		title = fmt.Sprintf("Discussion (%d)", commentCount)
-	}
-
-	// Show scroll indicator if there's overflow
-	scrollHint := ""
-	if (m.card.Body != "" || len(m.comments) > 0) && !m.commentMode {
-		totalLines := m.viewport.TotalLineCount()
-		visibleLines := m.viewport.Height
-		if totalLines > visibleLines {
-			if m.viewport.AtTop() {
-				scrollHint = " ↓"
-			} else if m.viewport.AtBottom() {
-				scrollHint = " ↑"
-			} else {
-				scrollHint = " ↕"
-			}
-		}
+	title := "Comments"
+	if len(m.comments) > 0 {
+		title = fmt.Sprintf("Comments (%s)", m.commentCountLabel())
+	}
+	hint := ""
+	if len(m.comments) > 0 && !m.commentMode {
+		hint = scrollHint(m.viewport)
 	}
 
 	b.WriteString(detailLabelStyle.Render(title))
-	b.WriteString(scrollIndicatorStyle.Render(scrollHint))
+	b.WriteString(scrollIndicatorStyle.Render(hint))
 	b.WriteString("\n")
 
 	// Loading state
 	if m.loadingComments {
 		b.WriteString("\n")
-		b.WriteString(m.spinner.View() + " Loading comments...")
+		b.WriteString(loadingText(m.spinner, m.reducedMotion, "Loading comments…"))
 		return b.String()
 	}
 
@@ -593,7 +1188,11 @@ func (m DetailModel) renderRightPanel(width, height int) string {
 	// Comment mode - show input prominently
 	if m.commentMode {
 		b.WriteString("\n")
-		b.WriteString(commentAuthorStyle.Render("New Comment"))
+		if m.reviewEvent != "" {
+			b.WriteString(commentAuthorStyle.Render(reviewVerb(m.reviewEvent)))
+		} else {
+			b.WriteString(commentAuthorStyle.Render("New Comment"))
+		}
 		b.WriteString("\n\n")
 		b.WriteString(m.commentInput.View())
 		b.WriteString("\n\n")
@@ -607,10 +1206,10 @@ func (m DetailModel) renderRightPanel(width, height int) string {
 		return b.String()
 	}
 
-	// Empty state (no description AND no comments)
-	if m.card.Body == "" && len(m.comments) == 0 {
+	// Empty state
+	if len(m.comments) == 0 && len(m.threads) == 0 {
 		b.WriteString("\n")
-		b.WriteString(dimStyle.Render("No description or comments"))
+		b.WriteString(dimStyle.Render("No comments"))
 		if m.card.ContentType == domain.ContentTypeIssue || m.card.ContentType == domain.ContentTypePullRequest {
 			b.WriteString("\n\n")
 			b.WriteString(dimStyle.Render("Press 'c' to add a comment"))
@@ -624,84 +1223,133 @@ func (m DetailModel) renderRightPanel(width, height int) string {
 	return b.String()
 }
 
-// updateViewportContent formats description and comments for viewport display
+// updateBodyContent formats the description for the body pane
+func (m *DetailModel) updateBodyContent() {
+	if m.body == "" {
+		m.bodyView.SetContent("")
+		m.bodyHits = nil
+		return
+	}
+	wrapWidth := m.bodyView.Width - 2
+	if wrapWidth < 20 {
+		wrapWidth = 20
+	}
+
+	var b strings.Builder
+	author := m.card.Author
+	if author == "" {
+		author = "Author"
+	}
+
+	// Description header with "OP" indicator
+	b.WriteString(renderAuthor(author, m.card.AuthorIsBot))
+	b.WriteString(" ")
+	b.WriteString(lipgloss.NewStyle().
+		Foreground(lipgloss.Color("34")).
+		Bold(true).
+		Render("OP"))
+	b.WriteString(" ")
+	b.WriteString(commentTimeStyle.Render(formatTimeAgo(m.card.CreatedAt)))
+	b.WriteString("\n")
+	b.WriteString(m.renderText(m.bodyMarkdown, m.body, wrapWidth))
+
+	content, hits := highlightHits(b.String(), m.searchQuery)
+	m.bodyHits = hits
+	m.bodyView.SetContent(content)
+}
+
+// updateViewportContent formats comments for the comments pane
 func (m *DetailModel) updateViewportContent() {
 	var b strings.Builder
-	wrapWidth := m.viewport.Width - 4
-	if wrapWidth < 30 {
-		wrapWidth = 30
-	}
-
-	hasContent := false
-
-	// First: Show the issue/PR description (body) as the opening post
-	if m.card.Body != "" {
-		author := m.card.Author
-		if author == "" {
-			author = "Author"
-		}
-		timeAgo := formatTimeAgo(m.card.CreatedAt)
-
-		// Description header with "OP" indicator
-		b.WriteString(commentAuthorStyle.Render(author))
-		b.WriteString(" ")
-		b.WriteString(lipgloss.NewStyle().
-			Foreground(lipgloss.Color("34")).
-			Bold(true).
-			Render("OP"))
-		b.WriteString(" ")
-		b.WriteString(commentTimeStyle.Render(timeAgo))
-		b.WriteString("\n")
+	wrapWidth := m.viewport.Width - 2
+	if wrapWidth < 20 {
+		wrapWidth = 20
+	}
 
-		// Description body with wrapping
-		wrapped := wordwrap.String(m.card.Body, wrapWidth)
-		b.WriteString(commentBodyStyle.Render(wrapped))
-		hasContent = true
+	if m.olderCursor != "" {
+		notice := "↑ Older comments not shown · O to load them"
+		if m.loadingOlder {
+			notice = "↑ Loading older comments…"
+		}
+		b.WriteString(dimStyle.Render(notice))
+		b.WriteString("\n\n")
 	}
 
-	// Then: Show all comments
+	m.commentOffsets = m.commentOffsets[:0]
 	for i, c := range m.comments {
-		// Add separator before each comment (or after description)
-		if hasContent || i > 0 {
+		if i > 0 {
 			b.WriteString("\n\n")
 			b.WriteString(dimStyle.Render(strings.Repeat("─", min(20, wrapWidth))))
 			b.WriteString("\n\n")
 		}
+		m.commentOffsets = append(m.commentOffsets, strings.Count(b.String(), "\n"))
 
-		author := c.Author
-		if author == "" {
-			author = "(deleted)"
-		}
-		timeAgo := formatTimeAgo(c.CreatedAt)
-
-		// Comment header
-		b.WriteString(commentAuthorStyle.Render(author))
-		b.WriteString(" ")
-		b.WriteString(commentTimeStyle.Render(timeAgo))
+		b.WriteString(m.commentHeader(i, c))
 		b.WriteString("\n")
+		b.WriteString(m.renderText(m.commentMarkdown, c.Body, wrapWidth))
+	}
 
-		// Comment body with wrapping
-		wrapped := wordwrap.String(c.Body, wrapWidth)
-		b.WriteString(commentBodyStyle.Render(wrapped))
-		hasContent = true
+	m.threadsOffset = -1
+	if len(m.threads) > 0 {
+		if len(m.comments) > 0 {
+			b.WriteString("\n\n")
+		}
+		m.threadsOffset = strings.Count(b.String(), "\n")
+		b.WriteString(m.renderReviewThreads(wrapWidth))
 	}
 
-	m.viewport.SetContent(b.String())
+	content, hits := highlightHits(b.String(), m.searchQuery)
+	m.commentHits = hits
+	m.viewport.SetContent(content)
 }
 
-// postComment creates a command to post a comment
+// postComment creates a command to post a comment.
+// Returns nil for an empty body or while the same comment is already being posted.
 func (m DetailModel) postComment(body string) tea.Cmd {
-	return func() tea.Msg {
-		parts := strings.Split(m.card.Repo, "/")
-		if len(parts) != 2 {
-			return commentErrorMsg{err: fmt.Errorf("invalid repository format")}
-		}
+	if body == "" {
+		return nil
+	}
+	key := "comment:" + m.card.ItemID
+	ctx, ok := m.guard.begin(m.ctx, key)
+	if !ok {
+		return nil
+	}
+	card := m.card
+	return m.enqueue(ctx, &outboxEntry{
+		label: fmt.Sprintf("Comment on %s", cardLabel(card)),
+		send: func(ctx context.Context) error {
+			defer m.guard.end(key)
+			parts := strings.Split(card.Repo, "/")
+			if len(parts) != 2 {
+				return fmt.Errorf("invalid repository format")
+			}
+			return m.client.AddComment(ctx, parts[0], parts[1], card.Number, body)
+		},
+		done: func(err error) tea.Msg {
+			if err != nil {
+				return commentErrorMsg{err: err}
+			}
+			return commentPostedMsg{}
+		},
+	})
+}
+
+// enqueue queues one of the detail view's changes in the shared outbox,
+// marked so its result comes back here
+func (m DetailModel) enqueue(ctx context.Context, e *outboxEntry) tea.Cmd {
+	e.detail = true
+	return m.outbox.enqueue(ctx, e)
+}
 
-		err := m.client.AddComment(m.ctx, parts[0], parts[1], m.card.Number, body)
+// loadDetails creates a command to load the card's body, labels, and timestamps
+func (m DetailModel) loadDetails() tea.Cmd {
+	itemID, contentID := m.card.ItemID, m.card.ContentID
+	return func() tea.Msg {
+		details, err := m.client.GetItemDetails(m.ctx, []string{contentID})
 		if err != nil {
-			return commentErrorMsg{err: err}
+			return detailsErrorMsg{err: err}
 		}
-		return commentPostedMsg{}
+		return detailsLoadedMsg{itemID: itemID, details: details[contentID]}
 	}
 }
 
@@ -712,11 +1360,11 @@ func (m DetailModel) loadComments() tea.Cmd {
 		if len(parts) != 2 {
 			return commentsErrorMsg{err: fmt.Errorf("invalid repo format")}
 		}
-		comments, err := m.client.GetComments(m.ctx, parts[0], parts[1], m.card.Number)
+		page, err := m.client.GetComments(m.ctx, parts[0], parts[1], m.card.Number, "")
 		if err != nil {
 			return commentsErrorMsg{err: err}
 		}
-		return commentsLoadedMsg{comments: comments}
+		return commentsLoadedMsg{comments: page.Comments, olderCursor: page.OlderCursor, threads: page.Threads}
 	}
 }
 
@@ -787,6 +1435,15 @@ type (
 	closeDetailMsg    struct{}
 	commentPostedMsg  struct{}
 	commentErrorMsg   struct{ err error }
-	commentsLoadedMsg struct{ comments []domain.Comment }
-	commentsErrorMsg  struct{ err error }
+	commentsLoadedMsg struct {
+		comments    []domain.Comment
+		olderCursor string
+		threads     []domain.ReviewThread
+	}
+	commentsErrorMsg struct{ err error }
+	detailsErrorMsg  struct{ err error }
+	detailsLoadedMsg struct {
+		itemID  string
+		details domain.ItemDetails
+	}
 )
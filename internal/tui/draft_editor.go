@@ -0,0 +1,48 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/editor"
+)
+
+// draftTarget is the text input a draft opened in $EDITOR goes back to
+type draftTarget int
+
+const (
+	draftComment    draftTarget = iota // Detail view comment or review body
+	draftBody                          // Detail view description editor
+	draftCreateBody                    // Board new item form body
+)
+
+// editDraft suspends the TUI and opens text in the user's editor. The edited
+// text comes back in a draftEditedMsg for target, tagged by reply.
+func editDraft(target draftTarget, text string, reply replyFunc) tea.Cmd {
+	path, err := editor.WriteDraft(text)
+	if err != nil {
+		return func() tea.Msg { return draftEditedMsg{target: target, err: err} }
+	}
+	return tea.ExecProcess(editor.Cmd(path), func(err error) tea.Msg {
+		return reply.tag(draftEditedMsg{target: target, path: path, err: err})
+	})
+}
+
+// readDraft reads and removes the file behind a draftEditedMsg
+func readDraft(msg draftEditedMsg) (string, error) {
+	if msg.path != "" {
+		defer os.Remove(msg.path)
+	}
+	if msg.err != nil {
+		return "", fmt.Errorf("editor failed: %w", msg.err)
+	}
+	return editor.ReadDraft(msg.path)
+}
+
+// draftEditedMsg reports the editor closing on a draft
+type draftEditedMsg struct {
+	target draftTarget
+	path   string
+	err    error
+}
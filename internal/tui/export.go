@@ -0,0 +1,63 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/snapshot"
+	"github.com/h0rv/ghp/internal/store"
+)
+
+// RenderBoard renders a loaded store as the board would show it at the given
+// size, with the project's saved sorts and hidden columns applied.
+func RenderBoard(s *store.Store, width, height int) string {
+	board := NewBoardModel(s, nil, context.Background())
+	board.width, board.height = width, height
+	board.loading = false
+	board.rebuildColumns()
+	board.applyFilter()
+	if cmd := board.loadUIState(); cmd != nil {
+		if msg, ok := cmd().(uiStateLoadedMsg); ok {
+			model, _ := board.update(msg)
+			board = model.(BoardModel)
+		}
+	}
+	return board.View()
+}
+
+// exportSnapshot saves the current board view to a text file and a PNG in
+// the working directory
+func (m BoardModel) exportSnapshot() tea.Cmd {
+	name := "ghp-board-" + time.Now().Format("20060102-150405")
+	if project := m.store.GetProject(); project != nil {
+		name = fmt.Sprintf("ghp-%s-%d-%s", project.Owner, project.Number, time.Now().Format("20060102-150405"))
+	}
+	text := snapshot.Text(m.renderBoard(m.width, m.height-headerLines))
+
+	return func() tea.Msg {
+		if err := os.WriteFile(name+".txt", []byte(text), 0o644); err != nil {
+			return snapshotErrorMsg{err: err}
+		}
+		f, err := os.Create(name + ".png")
+		if err != nil {
+			return snapshotErrorMsg{err: err}
+		}
+		err = snapshot.WritePNG(f, text)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return snapshotErrorMsg{err: err}
+		}
+		return snapshotSavedMsg{name: name}
+	}
+}
+
+// Message types for board snapshots
+type (
+	snapshotSavedMsg struct{ name string } // File name without extension
+	snapshotErrorMsg struct{ err error }
+)
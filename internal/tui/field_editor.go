@@ -0,0 +1,366 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/gh"
+	"github.com/h0rv/ghp/internal/store"
+)
+
+// editableFieldTypes are the field types the field editor can change
+var editableFieldTypes = map[string]bool{
+	domain.FieldTypeText:         true,
+	domain.FieldTypeNumber:       true,
+	domain.FieldTypeDate:         true,
+	domain.FieldTypeSingleSelect: true,
+	domain.FieldTypeIteration:    true,
+}
+
+// setProjectFields gives the detail view the project's fields to edit
+func (m *DetailModel) setProjectFields(projectID string, fields []domain.FieldDef) {
+	m.projectID = projectID
+	m.fieldDefs = nil
+	for _, f := range fields {
+		if editableFieldTypes[f.Type] {
+			m.fieldDefs = append(m.fieldDefs, f)
+		}
+	}
+}
+
+// openFieldEditor shows the item's field values, fetching them first
+func (m *DetailModel) openFieldEditor() tea.Cmd {
+	if len(m.fieldDefs) == 0 || m.card.ItemID == "" {
+		m.errorMsg = "No editable project fields"
+		return nil
+	}
+	m.editFields = true
+	m.fieldCursor = 0
+	m.fieldsError = ""
+	m.errorMsg, m.successMsg = "", ""
+	if m.client == nil {
+		return nil
+	}
+	itemID := m.card.ItemID
+	client, ctx := m.client, m.ctx
+	return func() tea.Msg {
+		values, err := client.GetItemFieldValues(ctx, itemID)
+		if err != nil {
+			return fieldValuesErrorMsg{err: err}
+		}
+		return fieldValuesLoadedMsg{itemID: itemID, values: values}
+	}
+}
+
+// fieldChoices returns what a single-select or iteration field can be set to
+func fieldChoices(f domain.FieldDef) []domain.Option {
+	if f.Type == domain.FieldTypeIteration {
+		return f.Iterations
+	}
+	return f.Options
+}
+
+// handleFieldEditorKey handles keys while the field editor is open
+func (m DetailModel) handleFieldEditorKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.fieldEditing {
+		return m.handleFieldValueKey(msg)
+	}
+
+	switch msg.String() {
+	case "f", "q", "esc":
+		m.editFields = false
+	case "j", "down":
+		m.fieldCursor = min(m.fieldCursor+1, len(m.fieldDefs)-1)
+	case "k", "up":
+		m.fieldCursor = max(m.fieldCursor-1, 0)
+	case "enter", "e":
+		return m, m.startFieldEdit()
+	case "d", "backspace":
+		// Clear the value
+		f := m.fieldDefs[m.fieldCursor]
+		if _, ok := m.fieldValues[f.ID]; ok {
+			return m, m.saveFieldValue(f, domain.FieldValue{}, true)
+		}
+	}
+	return m, nil
+}
+
+// startFieldEdit starts changing the value under the cursor
+func (m *DetailModel) startFieldEdit() tea.Cmd {
+	if m.fieldValues == nil {
+		return nil // Still loading
+	}
+	f := m.fieldDefs[m.fieldCursor]
+	current := m.fieldValues[f.ID]
+	m.fieldEditing = true
+
+	if choices := fieldChoices(f); f.Type == domain.FieldTypeSingleSelect || f.Type == domain.FieldTypeIteration {
+		m.fieldChoice = 0
+		for i, c := range choices {
+			if c.ID == current.ID {
+				m.fieldChoice = i
+			}
+		}
+		if len(choices) == 0 {
+			m.fieldEditing = false
+			m.errorMsg = "No options to choose from for " + f.Name
+		}
+		return nil
+	}
+
+	ti := textinput.New()
+	ti.Prompt = f.Name + ": "
+	ti.SetValue(current.Text)
+	ti.CursorEnd()
+	switch f.Type {
+	case domain.FieldTypeDate:
+		ti.Placeholder = "YYYY-MM-DD"
+	case domain.FieldTypeNumber:
+		ti.Placeholder = "number"
+	}
+	m.fieldInput = ti
+	return m.fieldInput.Focus()
+}
+
+// handleFieldValueKey handles keys while changing one field's value
+func (m DetailModel) handleFieldValueKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	f := m.fieldDefs[m.fieldCursor]
+	choices := fieldChoices(f)
+	picking := f.Type == domain.FieldTypeSingleSelect || f.Type == domain.FieldTypeIteration
+
+	switch msg.String() {
+	case "esc":
+		m.fieldEditing = false
+		return m, nil
+	case "enter":
+		if picking {
+			choice := choices[m.fieldChoice]
+			m.fieldEditing = false
+			return m, m.saveFieldValue(f, domain.FieldValue{Text: choice.Name, ID: choice.ID}, false)
+		}
+		text := strings.TrimSpace(m.fieldInput.Value())
+		if err := validateFieldText(f.Type, text); err != nil {
+			m.errorMsg = err.Error()
+			return m, nil
+		}
+		m.fieldEditing = false
+		return m, m.saveFieldValue(f, domain.FieldValue{Text: text}, text == "")
+	}
+
+	if picking {
+		switch msg.String() {
+		case "j", "down", "l", "right", "tab":
+			m.fieldChoice = (m.fieldChoice + 1) % len(choices)
+		case "k", "up", "h", "left", "shift+tab":
+			m.fieldChoice = (m.fieldChoice + len(choices) - 1) % len(choices)
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.fieldInput, cmd = m.fieldInput.Update(msg)
+	return m, cmd
+}
+
+// validateFieldText checks a typed value for a number or date field
+func validateFieldText(fieldType, text string) error {
+	if text == "" {
+		return nil
+	}
+	switch fieldType {
+	case domain.FieldTypeNumber:
+		if _, err := strconv.ParseFloat(text, 64); err != nil {
+			return fmt.Errorf("%q is not a number", text)
+		}
+	case domain.FieldTypeDate:
+		if _, err := time.Parse(time.DateOnly, text); err != nil {
+			return fmt.Errorf("%q is not a date (YYYY-MM-DD)", text)
+		}
+	}
+	return nil
+}
+
+// toFieldInput converts an edited value to the mutation input for its field
+func toFieldInput(f domain.FieldDef, v domain.FieldValue) gh.FieldInput {
+	switch f.Type {
+	case domain.FieldTypeNumber:
+		n, _ := strconv.ParseFloat(v.Text, 64) // Validated when typed
+		return gh.NumberValue(n)
+	case domain.FieldTypeDate:
+		return gh.DateValue(v.Text)
+	case domain.FieldTypeSingleSelect:
+		return gh.SingleSelectValue(v.ID)
+	case domain.FieldTypeIteration:
+		return gh.IterationValue(v.ID)
+	}
+	return gh.TextValue(v.Text)
+}
+
+// saveFieldValue sets (or with remove, clears) a field value, showing the new
+// value right away and restoring the old one if GitHub refuses it
+func (m DetailModel) saveFieldValue(f domain.FieldDef, v domain.FieldValue, remove bool) tea.Cmd {
+	key := "field:" + m.card.ItemID + ":" + f.ID
+	ctx, ok := m.guard.begin(m.ctx, key)
+	if !ok {
+		return nil
+	}
+
+	v.FieldID, v.Field, v.Type = f.ID, f.Name, f.Type
+	values := m.fieldValues
+	failed := fieldValueErrorMsg{field: f}
+	if old, ok := values[f.ID]; ok {
+		failed.previous = &old
+	}
+	apply := func() error {
+		if remove {
+			delete(values, f.ID)
+		} else {
+			values[f.ID] = v
+		}
+		return nil
+	}
+	_ = apply()
+
+	card, projectID, client := m.card, m.projectID, m.client
+	return m.enqueue(ctx, &outboxEntry{
+		label: fmt.Sprintf("Set %s of %s", f.Name, cardLabel(card)),
+		apply: apply,
+		send: func(ctx context.Context) error {
+			defer m.guard.end(key)
+			if remove {
+				return client.ClearItemField(ctx, projectID, card.ItemID, f.ID)
+			}
+			return client.UpdateItemField(ctx, projectID, card.ItemID, f.ID, toFieldInput(f, v))
+		},
+		done: func(err error) tea.Msg {
+			if err != nil {
+				failed.err = err
+				return failed
+			}
+			saved := fieldValueSavedMsg{itemID: card.ItemID, field: f, value: v, previous: failed.previous}
+			if remove {
+				saved.value = domain.FieldValue{FieldID: f.ID, Field: f.Name, Type: f.Type}
+			}
+			return saved
+		},
+	})
+}
+
+// renderFieldEditor renders the item's field values in place of the description
+func (m DetailModel) renderFieldEditor(width int) string {
+	var b strings.Builder
+	b.WriteString(detailLabelStyle.Render("Fields"))
+	b.WriteString("\n")
+
+	switch {
+	case m.fieldsError != "":
+		b.WriteString(errorStyle.Render(m.fieldsError))
+		return b.String()
+	case m.fieldValues == nil:
+		b.WriteString(loadingText(m.spinner, m.reducedMotion, "Loading fields…"))
+		return b.String()
+	}
+
+	nameWidth := 0
+	for _, f := range m.fieldDefs {
+		nameWidth = max(nameWidth, len([]rune(f.Name)))
+	}
+	nameWidth = min(nameWidth, width/3)
+
+	for i, f := range m.fieldDefs {
+		value := dimStyle.Render("—")
+		if v, ok := m.fieldValues[f.ID]; ok {
+			value = detailValueStyle.Render(v.Text)
+		}
+		if i == m.fieldCursor && m.fieldEditing {
+			if f.Type == domain.FieldTypeSingleSelect || f.Type == domain.FieldTypeIteration {
+				value = SelectedItemStyle.Render("‹ " + fieldChoices(f)[m.fieldChoice].Name + " ›")
+			} else {
+				b.WriteString("▸ " + m.fieldInput.View() + "\n")
+				continue
+			}
+		}
+
+		cursor := "  "
+		if i == m.fieldCursor {
+			cursor = selectedCommentStyle.Render("▸ ")
+		}
+		name := truncateLine(f.Name, nameWidth)
+		b.WriteString(fmt.Sprintf("%s%s%s  %s\n", cursor, detailLabelStyle.Render(name),
+			strings.Repeat(" ", max(nameWidth-len([]rune(name)), 0)), value))
+	}
+	return b.String()
+}
+
+// Message types for the field editor
+type (
+	fieldValuesLoadedMsg struct {
+		itemID string
+		values []domain.FieldValue
+	}
+	fieldValuesErrorMsg struct{ err error }
+	// fieldValueSavedMsg is also seen by the app, which moves the card when
+	// the grouping field changed and records the change for undo; a cleared
+	// value has an empty ID and Text
+	fieldValueSavedMsg struct {
+		itemID   string
+		field    domain.FieldDef
+		value    domain.FieldValue
+		previous *domain.FieldValue // nil if the field was empty
+	}
+	// fieldValueErrorMsg carries the value to restore, nil if there was none
+	fieldValueErrorMsg struct {
+		field    domain.FieldDef
+		previous *domain.FieldValue
+		err      error
+	}
+)
+
+// restoreFieldValue puts back a value GitHub refused to change
+func (m *DetailModel) restoreFieldValue(msg fieldValueErrorMsg) {
+	if m.fieldValues == nil {
+		return
+	}
+	if msg.previous != nil {
+		m.fieldValues[msg.field.ID] = *msg.previous
+	} else {
+		delete(m.fieldValues, msg.field.ID)
+	}
+}
+
+// applyFieldValue copies a saved single-select value to the stored card, so
+// sorting and the board's columns match what the detail view shows, and
+// records the edit for undo
+func (m AppModel) applyFieldValue(msg fieldValueSavedMsg) {
+	after := &msg.value
+	if msg.value.ID == "" && msg.value.Text == "" {
+		after = nil
+	}
+	label := msg.field.Name
+	if card, err := m.store.GetCard(msg.itemID); err == nil {
+		label = fmt.Sprintf("Set %s of %s", msg.field.Name, cardLabel(card))
+	}
+	m.store.RecordAction(store.Action{
+		Kind:   store.ActionSetField,
+		Label:  label,
+		ItemID: msg.itemID,
+		Field:  msg.field,
+		Before: msg.previous,
+		After:  after,
+	})
+
+	if msg.value.Type != domain.FieldTypeSingleSelect {
+		return
+	}
+	m.store.SetFieldValue(msg.itemID, msg.field, msg.value)
+	if groupField := m.store.GetGroupField(); groupField != nil && groupField.ID == msg.field.ID && m.boardModel != nil {
+		m.boardModel.rebuildColumns()
+		m.boardModel.applyFilter()
+	}
+}
@@ -8,6 +8,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/store"
 )
 
 // fieldItem wraps a domain.FieldDef for use in bubbles/list.
@@ -24,6 +25,12 @@ func (i fieldItem) Title() string {
 }
 
 func (i fieldItem) Description() string {
+	switch i.field.ID {
+	case store.AssigneeFieldID:
+		return "A column per assignee"
+	case store.RepoFieldID:
+		return "A column per repository"
+	}
 	return fmt.Sprintf("Type: %s, Options: %d", i.field.Type, len(i.field.Options))
 }
 
@@ -93,10 +100,17 @@ func (m GroupFieldPickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case tea.KeyMsg:
 		switch msg.String() {
-		case "q", "esc", "ctrl+c":
+		case "q", "ctrl+c":
 			return m, func() tea.Msg {
 				return QuitMsg{}
 			}
+		case "esc":
+			// Let esc clear an in-progress filter first
+			if !m.list.SettingFilter() && !m.list.IsFiltered() {
+				return m, func() tea.Msg {
+					return PickerCancelledMsg{}
+				}
+			}
 		case "enter":
 			// Get selected field
 			if item, ok := m.list.SelectedItem().(fieldItem); ok {
@@ -0,0 +1,80 @@
+package tui
+
+import (
+	"context"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/gh"
+)
+
+// firstPage is the board's first page of items, fetched at startup alongside
+// the project and its fields rather than after them. The board takes it in
+// place of fetching the page itself when it opens the same project grouped
+// by the same field.
+type firstPage struct {
+	mu      sync.Mutex
+	started bool
+	taken   bool
+	done    chan struct{}
+
+	// Set before the fetch starts
+	owner     string
+	number    int
+	fieldName string
+
+	// Set when done closes
+	cards   []domain.Card
+	cursor  string
+	hasMore bool
+	err     error
+}
+
+func newFirstPage() *firstPage {
+	return &firstPage{done: make(chan struct{})}
+}
+
+// fetch starts fetching the first page of owner's project number, grouped by
+// fieldName. The command reports nothing; the board takes the page.
+func (p *firstPage) fetch(ctx context.Context, client *gh.Client, owner string, number int, fieldName string, pageSize int) tea.Cmd {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.started || client == nil {
+		return nil
+	}
+	p.started = true
+	p.owner, p.number, p.fieldName = owner, number, fieldName
+	return func() tea.Msg {
+		p.cards, p.cursor, p.hasMore, p.err = client.GetFirstItemsByNumber(ctx, owner, number, fieldName, pageSize)
+		close(p.done)
+		return nil
+	}
+}
+
+// take waits for the prefetched page and returns it when it was fetched for
+// project grouped by fieldName. Only the first load may take it; later ones
+// want fresh items. ok is false when the caller must fetch the page itself.
+func (p *firstPage) take(ctx context.Context, project *domain.Project, fieldName string) (cards []domain.Card, cursor string, hasMore bool, ok bool) {
+	if p == nil {
+		return nil, "", false, false
+	}
+	p.mu.Lock()
+	match := p.started && !p.taken && p.fieldName == fieldName &&
+		project != nil && project.Owner == p.owner && project.Number == p.number
+	p.taken = true
+	p.mu.Unlock()
+	if !match {
+		return nil, "", false, false
+	}
+
+	select {
+	case <-p.done:
+	case <-ctx.Done():
+		return nil, "", false, false
+	}
+	if p.err != nil {
+		return nil, "", false, false
+	}
+	return p.cards, p.cursor, p.hasMore, true
+}
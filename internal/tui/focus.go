@@ -0,0 +1,30 @@
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// focusRefreshIdle is how long the terminal must lose focus before regaining
+// it refreshes the board. Shorter trips, like a glance at another window,
+// don't cost a fetch.
+const focusRefreshIdle = 2 * time.Minute
+
+// handleFocus refreshes the board when the terminal regains focus after
+// being away for a while. Terminals that don't report focus never send these.
+func (m BoardModel) handleFocus(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg.(type) {
+	case tea.BlurMsg:
+		m.blurredAt = time.Now()
+	case tea.FocusMsg:
+		blurredAt := m.blurredAt
+		m.blurredAt = time.Time{}
+		if blurredAt.IsZero() || time.Since(blurredAt) < focusRefreshIdle || m.loading || m.loadingMore {
+			return m, nil
+		}
+		m.loading = true
+		return m, m.loadAllItems()
+	}
+	return m, nil
+}
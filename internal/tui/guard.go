@@ -0,0 +1,62 @@
+package tui
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/h0rv/ghp/internal/gh"
+)
+
+// mutationDebounce is how long a finished mutation keeps blocking the same
+// action, to absorb key repeat and double presses
+const mutationDebounce = 500 * time.Millisecond
+
+// mutationGuard drops repeated triggers of the same mutation while it is in
+// flight and shortly after it started. It is shared by pointer, so copies of a
+// model see the same state.
+type mutationGuard struct {
+	mu      sync.Mutex
+	actions map[string]guardedAction
+	now     func() time.Time
+}
+
+// guardedAction is one mutation the guard knows about
+type guardedAction struct {
+	started time.Time
+	done    bool
+}
+
+func newMutationGuard() *mutationGuard {
+	return &mutationGuard{actions: make(map[string]guardedAction), now: time.Now}
+}
+
+// begin reports whether the action identified by key may start. If so, it is
+// marked in flight and ctx is returned carrying a fresh client mutation ID.
+func (g *mutationGuard) begin(ctx context.Context, key string) (context.Context, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now()
+	for k, a := range g.actions {
+		if a.done && now.Sub(a.started) >= mutationDebounce {
+			delete(g.actions, k)
+		}
+	}
+	if a, ok := g.actions[key]; ok && (!a.done || now.Sub(a.started) < mutationDebounce) {
+		return ctx, false
+	}
+	g.actions[key] = guardedAction{started: now}
+	return gh.WithMutationID(ctx, gh.NewMutationID()), true
+}
+
+// end marks the action finished; it stays blocked until the debounce window passes
+func (g *mutationGuard) end(key string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if a, ok := g.actions[key]; ok {
+		a.done = true
+		g.actions[key] = a
+	}
+}
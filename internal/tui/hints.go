@@ -0,0 +1,117 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/h0rv/ghp/internal/store"
+)
+
+// hint is one "key:label" entry of a mode's hint line. The keys come from
+// the KeyMap, so the line stays accurate when bindings change.
+type hint struct {
+	bindings []key.Binding // Shown joined with "/", e.g. "h/l"
+	label    string
+}
+
+// boardHints returns the hint line for the board's current mode
+func (m BoardModel) boardHints() []hint {
+	k := m.keymap
+	switch {
+	case m.filterMode:
+		hints := []hint{
+			{[]key.Binding{k.ApplyFilter}, "apply"},
+			{[]key.Binding{k.CancelFilter}, "cancel"},
+		}
+		if m.uiState != nil && len(m.uiState.SearchHistory) > 0 {
+			hints = append(hints, hint{[]key.Binding{k.OlderFilter, k.NewerFilter}, "history"})
+		}
+		return hints
+	case m.moveMode && m.moveQuery != "":
+		return []hint{
+			{[]key.Binding{k.MoveByName}, "move"},
+			{[]key.Binding{k.CancelMove}, "cancel"},
+		}
+	case m.moveMode:
+		return []hint{
+			{[]key.Binding{k.MoveTarget}, "target column"},
+			{[]key.Binding{k.CancelMove}, "cancel"},
+		}
+	case len(m.marked) > 0:
+		return []hint{
+			{[]key.Binding{k.Down, k.Up}, "card"},
+			{[]key.Binding{k.Mark}, "toggle"},
+			{[]key.Binding{k.Open}, fmt.Sprintf("open %d", len(m.marked))},
+			{[]key.Binding{k.ClearMarks}, "clear"},
+		}
+	}
+	return []hint{
+		{[]key.Binding{k.Left, k.Right}, "col"},
+		{[]key.Binding{k.Down, k.Up}, "card"},
+		{[]key.Binding{k.Move}, "move"},
+		{[]key.Binding{k.Open}, "open"},
+		{[]key.Binding{k.Edit}, "edit"},
+		{[]key.Binding{k.View}, "view"},
+	}
+}
+
+// formatHints renders hints as "key:label" pairs separated by spaces
+func formatHints(hints []hint) string {
+	parts := make([]string, 0, len(hints))
+	for _, h := range hints {
+		keys := make([]string, 0, len(h.bindings))
+		for _, b := range h.bindings {
+			if b.Enabled() {
+				keys = append(keys, hintKey(b))
+			}
+		}
+		if len(keys) > 0 {
+			parts = append(parts, strings.Join(keys, "/")+":"+h.label)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// hintKey returns the shortest name for a binding's key: its letter when it
+// has one ("h" rather than "left"), or its help key for ranges like 1-9
+func hintKey(b key.Binding) string {
+	keys := b.Keys()
+	if len(keys) > 2 && b.Help().Key != "" {
+		return b.Help().Key
+	}
+	for _, k := range keys {
+		if k == " " {
+			return "space"
+		}
+		if len([]rune(k)) == 1 {
+			return k
+		}
+	}
+	return keys[0]
+}
+
+// renderMoveBanner renders the move mode line, naming the column behind
+// each number
+func (m BoardModel) renderMoveBanner() string {
+	targets := make([]string, 0, min(len(m.columns), 9))
+	for i, colID := range m.columns {
+		if i == 9 {
+			break
+		}
+		targets = append(targets, fmt.Sprintf("%d:%s", i+1, m.columnNames[colID]))
+	}
+	label := moveModeStyle.Render("MOVE") + " "
+	if m.remapAll {
+		label = moveModeStyle.Render("RE-MAP") + fmt.Sprintf(" %d cards from %s to: ", len(m.filteredCards[removedOptionKey]), removedOptionName)
+	}
+	if m.moveQuery == "" {
+		return label + strings.Join(targets, " ") + dimStyle.Render(" · or type a name")
+	}
+
+	match := "no single match"
+	if opt, err := store.FindOption(m.moveTargets(), m.moveQuery); err == nil {
+		match = "→ " + opt.Name
+	}
+	return label + m.moveQuery + "▏ " + dimStyle.Render(match)
+}
@@ -0,0 +1,48 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/uistate"
+)
+
+// startFilter opens the filter input, ready to browse past queries
+func (m *BoardModel) startFilter() {
+	m.filterMode = true
+	m.filterInput.Focus()
+	m.historyIndex = -1
+}
+
+// browseHistory fills the filter input with an older (delta 1) or newer
+// (delta -1) past query. Going past the newest restores what was typed.
+func (m *BoardModel) browseHistory(delta int) {
+	if m.uiState == nil || len(m.uiState.SearchHistory) == 0 {
+		return
+	}
+	history := m.uiState.SearchHistory
+	if m.historyIndex == -1 {
+		if delta < 0 {
+			return
+		}
+		m.historyDraft = m.filterInput.Value()
+	}
+	m.historyIndex = min(max(m.historyIndex+delta, -1), len(history)-1)
+	if m.historyIndex == -1 {
+		m.filterInput.SetValue(m.historyDraft)
+	} else {
+		m.filterInput.SetValue(history[m.historyIndex])
+	}
+	m.filterInput.CursorEnd()
+}
+
+// recordSearch adds an applied filter to the project's search history and
+// saves it
+func (m *BoardModel) recordSearch(query string) tea.Cmd {
+	if m.store.GetProject() == nil || query == "" {
+		return nil
+	}
+	if m.uiState == nil {
+		m.uiState = &uistate.State{}
+	}
+	m.uiState.AddSearch(query)
+	return m.saveUIState()
+}
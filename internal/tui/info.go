@@ -0,0 +1,161 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/pkg/browser"
+)
+
+// toggleInfo opens or closes the project info screen, fetching the readme
+// and workflows the first time it opens
+func (m *BoardModel) toggleInfo() tea.Cmd {
+	m.showInfo = !m.showInfo
+	m.infoScroll = 0
+	if !m.showInfo || m.info != nil || m.infoLoading || m.client == nil {
+		return nil
+	}
+	project := m.store.GetProject()
+	if project == nil {
+		return nil
+	}
+
+	m.infoLoading = true
+	m.infoError = ""
+	client, ctx, projectID := m.client, m.ctx, project.ID
+	return func() tea.Msg {
+		info, err := client.GetProjectInfo(ctx, projectID)
+		if err != nil {
+			return projectInfoErrorMsg{err: err}
+		}
+		return projectInfoLoadedMsg{info: &info}
+	}
+}
+
+// handleInfoKey handles keys on the project info screen
+func (m BoardModel) handleInfoKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "i", "q", "esc":
+		m.showInfo = false
+	case "j", "down":
+		m.infoScroll++
+	case "k", "up":
+		m.infoScroll = max(0, m.infoScroll-1)
+	case "o":
+		if m.info != nil && m.info.URL != "" {
+			_ = browser.OpenURL(m.info.URL)
+		}
+	}
+	return m, nil
+}
+
+// renderInfo renders the project info screen: description, fields,
+// workflows, and readme, scrolled by infoScroll
+func (m BoardModel) renderInfo(width, height int) string {
+	var b strings.Builder
+	project := m.store.GetProject()
+	if project != nil {
+		b.WriteString(titleStyle.Render(project.Title))
+		b.WriteString("\n")
+	}
+
+	switch {
+	case m.infoLoading:
+		b.WriteString(loadingText(m.spinner, m.reducedMotion, "Loading project info…"))
+	case m.infoError != "":
+		b.WriteString(errorStyle.Render("Error: " + m.infoError))
+	case m.info != nil:
+		b.WriteString(m.renderInfoBody(width - 8))
+	}
+
+	// Scroll everything but the title
+	lines := strings.Split(b.String(), "\n")
+	maxLines := max(height-6, 3) // Overlay border, padding, and margin
+	if len(lines) > maxLines {
+		scroll := min(m.infoScroll, len(lines)-maxLines)
+		lines = append(lines[:1], lines[1+scroll:]...)
+		lines = lines[:maxLines-1]
+		lines = append(lines, dimStyle.Render("j/k scroll · o open in browser · esc close"))
+	} else {
+		lines = append(lines, "", dimStyle.Render("o open in browser · esc close"))
+	}
+	return HelpOverlayStyle.Render(strings.Join(lines, "\n"))
+}
+
+// renderInfoBody renders the loaded project info, wrapped to width
+func (m BoardModel) renderInfoBody(width int) string {
+	info := m.info
+	wrap := lipgloss.NewStyle().Width(max(width, 20))
+	var b strings.Builder
+
+	visibility := "private"
+	if info.Public {
+		visibility = "public"
+	}
+	b.WriteString(dimStyle.Render(fmt.Sprintf("%s · %s", info.URL, visibility)))
+	b.WriteString("\n\n")
+	if info.ShortDescription != "" {
+		b.WriteString(wrap.Render(info.ShortDescription))
+		b.WriteString("\n\n")
+	}
+
+	b.WriteString(titleStyle.Render("Fields"))
+	b.WriteString("\n")
+	for _, f := range m.store.GetFields() {
+		line := fmt.Sprintf("  %-24s %s", f.Name, strings.ToLower(strings.ReplaceAll(f.Type, "_", " ")))
+		if len(f.Options) > 0 {
+			names := make([]string, len(f.Options))
+			for i, opt := range f.Options {
+				names[i] = opt.Name
+			}
+			line += dimStyle.Render(": " + strings.Join(names, ", "))
+		}
+		b.WriteString(truncateLine(line, width))
+		b.WriteString("\n")
+	}
+
+	if len(info.Workflows) > 0 {
+		b.WriteString("\n")
+		b.WriteString(titleStyle.Render("Workflows"))
+		b.WriteString("\n")
+		for _, w := range info.Workflows {
+			b.WriteString(workflowLine(w))
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("\n")
+	b.WriteString(titleStyle.Render("README"))
+	b.WriteString("\n")
+	if strings.TrimSpace(info.Readme) == "" {
+		b.WriteString(dimStyle.Render("This project has no README."))
+	} else {
+		b.WriteString(wrap.Render(strings.TrimSpace(info.Readme)))
+	}
+	return b.String()
+}
+
+// workflowLine renders a workflow with whether it is on
+func workflowLine(w domain.Workflow) string {
+	if w.Enabled {
+		return "  ✓ " + w.Name
+	}
+	return dimStyle.Render("  ✗ " + w.Name + " (off)")
+}
+
+// truncateLine cuts a rendered line to width display cells
+func truncateLine(s string, width int) string {
+	if width <= 0 || lipgloss.Width(s) <= width {
+		return s
+	}
+	return lipgloss.NewStyle().MaxWidth(width).Render(s)
+}
+
+// Message types for the project info screen
+type (
+	projectInfoLoadedMsg struct{ info *domain.ProjectInfo }
+	projectInfoErrorMsg  struct{ err error }
+)
@@ -10,19 +10,74 @@ type KeyMap struct {
 	Up    key.Binding
 	Down  key.Binding
 
+	// Jumping to the ends of a column
+	Top    key.Binding
+	Bottom key.Binding
+
+	// Paging through a column by the cards that fit
+	HalfPage key.Binding
+	FullPage key.Binding
+
 	// Actions
 	Move         key.Binding
+	Reorder      key.Binding
+	MoveTarget   key.Binding
+	MoveByName   key.Binding
+	CancelMove   key.Binding
+	View         key.Binding
+	New          key.Binding
 	Open         key.Binding
+	Edit         key.Binding
+	Convert      key.Binding
 	Filter       key.Binding
+	MyItems      key.Binding
 	Refresh      key.Binding
 	LoadMore     key.Binding
 	ChangeGroup  key.Binding
+	ToggleGroup  key.Binding
+	Triage       key.Binding
+	Sweep        key.Binding
+	Remap        key.Binding
+	Stats        key.Binding
+	Info         key.Binding
+	Sort         key.Binding
+	BoardSort    key.Binding
+	Link         key.Binding
+	Assign       key.Binding
+	Parent       key.Binding
+	ShareQR      key.Binding
+	Export       key.Binding
+	Lanes        key.Binding
+	CloseItem    key.Binding
+	ReopenItem   key.Binding
+	ArchiveDone  key.Binding
+	HideColumn   key.Binding
+	ShowColumns  key.Binding
+	Mark         key.Binding
+	ClearMarks   key.Binding
+	Zoom         key.Binding
+	Density      key.Binding
+	Workspace    key.Binding
+	Presets      key.Binding
+	QuickFilter  key.Binding
+	Outbox       key.Binding
+	ActionLog    key.Binding
+	Undo         key.Binding
+	SplitByType  key.Binding
+	Redo         key.Binding
+	Project      key.Binding
+	Compare      key.Binding
+	Owner        key.Binding
+	Team         key.Binding
+	HideBots     key.Binding
 	Help         key.Binding
 	Quit         key.Binding
 	ConfirmQuit  key.Binding
 	CancelQuit   key.Binding
 	ApplyFilter  key.Binding
 	CancelFilter key.Binding
+	OlderFilter  key.Binding
+	NewerFilter  key.Binding
 }
 
 // DefaultKeyMap returns the default key bindings.
@@ -44,29 +99,225 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("down", "j"),
 			key.WithHelp("↓/j", "next card"),
 		),
+		Top: key.NewBinding(
+			key.WithKeys("g"),
+			key.WithHelp("g", "first card"),
+		),
+		Bottom: key.NewBinding(
+			key.WithKeys("G"),
+			key.WithHelp("G", "last card"),
+		),
+		HalfPage: key.NewBinding(
+			key.WithKeys("ctrl+d", "ctrl+u"),
+			key.WithHelp("ctrl+d/u", "half page down/up"),
+		),
+		FullPage: key.NewBinding(
+			key.WithKeys("ctrl+f", "ctrl+b"),
+			key.WithHelp("ctrl+f/b", "page down/up"),
+		),
 		Move: key.NewBinding(
 			key.WithKeys("m"),
 			key.WithHelp("m", "move card"),
 		),
+		Reorder: key.NewBinding(
+			key.WithKeys("J", "K"),
+			key.WithHelp("J/K", "move card down/up in column"),
+		),
+		MoveTarget: key.NewBinding(
+			key.WithKeys("1", "2", "3", "4", "5", "6", "7", "8", "9"),
+			key.WithHelp("1-9", "move to column"),
+		),
+		MoveByName: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "move to the column typed by name"),
+		),
+		CancelMove: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "cancel move"),
+		),
+		View: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "view details"),
+		),
 		Open: key.NewBinding(
 			key.WithKeys("o"),
 			key.WithHelp("o", "open in browser"),
 		),
+		Edit: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "edit in $EDITOR"),
+		),
 		Filter: key.NewBinding(
 			key.WithKeys("/"),
 			key.WithHelp("/", "filter cards"),
 		),
+		MyItems: key.NewBinding(
+			key.WithKeys("a"),
+			key.WithHelp("a", "only items assigned to me"),
+		),
 		Refresh: key.NewBinding(
 			key.WithKeys("r"),
 			key.WithHelp("r", "refresh"),
 		),
 		LoadMore: key.NewBinding(
-			key.WithKeys("L"),
-			key.WithHelp("L", "load more"),
+			key.WithKeys("+"),
+			key.WithHelp("+", "load items past the fetch limit"),
 		),
 		ChangeGroup: key.NewBinding(
-			key.WithKeys("g"),
-			key.WithHelp("g", "change grouping field"),
+			key.WithKeys("f"),
+			key.WithHelp("f", "change grouping field"),
+		),
+		ToggleGroup: key.NewBinding(
+			key.WithKeys("F"),
+			key.WithHelp("F", "toggle alternate grouping field"),
+		),
+		Triage: key.NewBinding(
+			key.WithKeys("t"),
+			key.WithHelp("t", "triage untriaged items"),
+		),
+		Remap: key.NewBinding(
+			key.WithKeys("M"),
+			key.WithHelp("M", "move all cards in (removed option)"),
+		),
+		Sweep: key.NewBinding(
+			key.WithKeys("w"),
+			key.WithHelp("w", "sweep old Done items"),
+		),
+		Stats: key.NewBinding(
+			key.WithKeys("%"),
+			key.WithHelp("%", "PR stats per column"),
+		),
+		ArchiveDone: key.NewBinding(
+			key.WithKeys("D"),
+			key.WithHelp("D", "archive closed items in Done"),
+		),
+		New: key.NewBinding(
+			key.WithKeys("n"),
+			key.WithHelp("n", "new item in column"),
+		),
+		Convert: key.NewBinding(
+			key.WithKeys("I"),
+			key.WithHelp("I", "convert draft to issue"),
+		),
+		Link: key.NewBinding(
+			key.WithKeys("L"),
+			key.WithHelp("L", "link to another item"),
+		),
+		Assign: key.NewBinding(
+			key.WithKeys("A"),
+			key.WithHelp("A", "add/remove assignees"),
+		),
+		Parent: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", "jump to parent issue"),
+		),
+		ShareQR: key.NewBinding(
+			key.WithKeys("U"),
+			key.WithHelp("U", "QR code of item URL"),
+		),
+		Export: key.NewBinding(
+			key.WithKeys("E"),
+			key.WithHelp("E", "save board snapshot"),
+		),
+		CloseItem: key.NewBinding(
+			key.WithKeys("C"),
+			key.WithHelp("C", "close issue/PR"),
+		),
+		ReopenItem: key.NewBinding(
+			key.WithKeys("R"),
+			key.WithHelp("R", "reopen issue/PR"),
+		),
+		Lanes: key.NewBinding(
+			key.WithKeys("s"),
+			key.WithHelp("s", "swimlanes by next field"),
+		),
+		Info: key.NewBinding(
+			key.WithKeys("i"),
+			key.WithHelp("i", "project info and README"),
+		),
+		Sort: key.NewBinding(
+			key.WithKeys("ctrl+s"),
+			key.WithHelp("ctrl+s", "cycle column sort"),
+		),
+		BoardSort: key.NewBinding(
+			key.WithKeys("S"),
+			key.WithHelp("S", "cycle board sort"),
+		),
+		HideColumn: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp("x", "hide column"),
+		),
+		ShowColumns: key.NewBinding(
+			key.WithKeys("X"),
+			key.WithHelp("X", "show hidden columns"),
+		),
+		Mark: key.NewBinding(
+			key.WithKeys(" "),
+			key.WithHelp("space", "select card (o opens all)"),
+		),
+		ClearMarks: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "clear selection"),
+		),
+		Zoom: key.NewBinding(
+			key.WithKeys("Z"),
+			key.WithHelp("Z", "focus column (or click its header)"),
+		),
+		Density: key.NewBinding(
+			key.WithKeys("z"),
+			key.WithHelp("z", "card density (auto/compact/normal/expanded)"),
+		),
+		Workspace: key.NewBinding(
+			key.WithKeys("W"),
+			key.WithHelp("W", "save as workspace"),
+		),
+		Presets: key.NewBinding(
+			key.WithKeys("b"),
+			key.WithHelp("b", "filter presets"),
+		),
+		QuickFilter: key.NewBinding(
+			key.WithKeys("alt+1", "alt+2", "alt+3", "alt+4", "alt+5", "alt+6", "alt+7", "alt+8", "alt+9"),
+			key.WithHelp("alt+1-9", "pinned quick filter"),
+		),
+		Outbox: key.NewBinding(
+			key.WithKeys("Q"),
+			key.WithHelp("Q", "outbox (unsent changes)"),
+		),
+		ActionLog: key.NewBinding(
+			key.WithKeys("H"),
+			key.WithHelp("H", "action log"),
+		),
+		SplitByType: key.NewBinding(
+			key.WithKeys("Y"),
+			key.WithHelp("Y", "split columns by issue/PR/draft"),
+		),
+		Undo: key.NewBinding(
+			key.WithKeys("u"),
+			key.WithHelp("u", "undo move, field edit, or archive"),
+		),
+		Redo: key.NewBinding(
+			key.WithKeys("ctrl+r"),
+			key.WithHelp("ctrl+r", "redo"),
+		),
+		Project: key.NewBinding(
+			key.WithKeys("P"),
+			key.WithHelp("P", "switch project"),
+		),
+		Compare: key.NewBinding(
+			key.WithKeys("d"),
+			key.WithHelp("d", "compare with another project"),
+		),
+		Owner: key.NewBinding(
+			key.WithKeys("O"),
+			key.WithHelp("O", "switch owner"),
+		),
+		Team: key.NewBinding(
+			key.WithKeys("T"),
+			key.WithHelp("T", "filter by team"),
+		),
+		HideBots: key.NewBinding(
+			key.WithKeys("B"),
+			key.WithHelp("B", "hide bot-created items"),
 		),
 		Help: key.NewBinding(
 			key.WithKeys("?"),
@@ -84,9 +335,19 @@ func DefaultKeyMap() KeyMap {
 		),
 		ApplyFilter: key.NewBinding(
 			key.WithKeys("enter"),
+			key.WithHelp("enter", "apply filter"),
 		),
 		CancelFilter: key.NewBinding(
 			key.WithKeys("esc"),
+			key.WithHelp("esc", "cancel filter"),
+		),
+		OlderFilter: key.NewBinding(
+			key.WithKeys("up"),
+			key.WithHelp("↑", "older filter"),
+		),
+		NewerFilter: key.NewBinding(
+			key.WithKeys("down"),
+			key.WithHelp("↓", "newer filter"),
 		),
 	}
 }
@@ -99,8 +360,11 @@ func (k KeyMap) ShortHelp() []key.Binding {
 // FullHelp returns key bindings for the expanded help view.
 func (k KeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.Up, k.Down, k.Left, k.Right},
-		{k.Move, k.Open, k.Filter, k.Refresh},
-		{k.LoadMore, k.ChangeGroup, k.Help, k.Quit},
+		{k.Up, k.Down, k.Top, k.Bottom, k.HalfPage, k.FullPage, k.Left, k.Right},
+		{k.Move, k.Reorder, k.New, k.Mark, k.Open, k.Edit, k.Convert, k.Assign, k.Link, k.Parent, k.CloseItem, k.ReopenItem, k.Filter, k.MyItems, k.Presets, k.QuickFilter, k.Team, k.HideBots, k.Refresh},
+		{k.LoadMore, k.ChangeGroup, k.ToggleGroup, k.Triage, k.Sweep, k.Remap, k.Stats, k.Info, k.ShareQR, k.Export, k.ArchiveDone},
+		{k.Sort, k.BoardSort, k.HideColumn, k.ShowColumns, k.Zoom, k.Density, k.Lanes, k.SplitByType, k.Workspace, k.Outbox, k.ActionLog, k.Undo, k.Redo},
+		{k.Project, k.Compare, k.Owner},
+		{k.Help, k.Quit},
 	}
 }
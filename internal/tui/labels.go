@@ -0,0 +1,192 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/filter"
+	"github.com/h0rv/ghp/internal/gh"
+)
+
+// openLabelPicker shows the repository's labels, fetching them first
+func (m *DetailModel) openLabelPicker() tea.Cmd {
+	owner, repo, ok := strings.Cut(m.card.Repo, "/")
+	if !ok || m.card.ContentID == "" || !linkable(m.card) {
+		m.errorMsg = "Only issues and pull requests have labels"
+		return nil
+	}
+	if !m.bodyLoaded {
+		m.errorMsg = "Labels are still loading"
+		return nil
+	}
+	m.editLabels = true
+	m.labels = nil
+	m.labelsError = ""
+	m.labelCursor = 0
+	m.errorMsg, m.successMsg = "", ""
+	if m.client == nil {
+		return nil
+	}
+	itemID := m.card.ItemID
+	client, ctx := m.client, m.ctx
+	return func() tea.Msg {
+		labels, err := client.ListLabels(ctx, owner, repo)
+		if err != nil {
+			return labelsErrorMsg{err: err}
+		}
+		return labelsLoadedMsg{itemID: itemID, labels: labels}
+	}
+}
+
+// hasLabel reports whether the card carries a label, ignoring case
+func hasLabel(card *domain.Card, name string) bool {
+	return slices.ContainsFunc(card.Labels, func(l string) bool { return strings.EqualFold(l, name) })
+}
+
+// filtersByLabel reports whether the board filters by label, which needs the
+// cards' details
+func (m BoardModel) filtersByLabel() bool {
+	return m.filterLabel != "" || filter.Parse(m.filterText, false).Uses("label")
+}
+
+// handleLabelPickerKey handles keys while the label picker is open
+func (m DetailModel) handleLabelPickerKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "L", "q", "esc":
+		m.editLabels = false
+	case "j", "down":
+		m.labelCursor = min(m.labelCursor+1, max(len(m.labels)-1, 0))
+	case "k", "up":
+		m.labelCursor = max(m.labelCursor-1, 0)
+	case "g":
+		m.labelCursor = 0
+	case "G":
+		m.labelCursor = max(len(m.labels)-1, 0)
+	case "enter", " ":
+		if m.labelCursor < len(m.labels) {
+			label := m.labels[m.labelCursor]
+			return m, m.setLabel(label, !hasLabel(m.card, label.Name))
+		}
+	}
+	return m, nil
+}
+
+// setLabel adds or removes a label, showing the change right away and
+// undoing it if GitHub refuses
+func (m DetailModel) setLabel(label gh.Label, add bool) tea.Cmd {
+	card := m.card
+	key := "label:" + card.ContentID + ":" + label.ID
+	ctx, ok := m.guard.begin(m.ctx, key)
+	if !ok {
+		return nil
+	}
+
+	apply := func() error {
+		applyLabel(card, label.Name, add)
+		return nil
+	}
+	_ = apply()
+
+	action := fmt.Sprintf("Add %s to %s", label.Name, cardLabel(card))
+	if !add {
+		action = fmt.Sprintf("Remove %s from %s", label.Name, cardLabel(card))
+	}
+	client := m.client
+	return m.enqueue(ctx, &outboxEntry{
+		label: action,
+		apply: apply,
+		send: func(ctx context.Context) error {
+			defer m.guard.end(key)
+			change := []gh.LabelChange{{ContentID: card.ContentID, LabelID: label.ID}}
+			if add {
+				return client.AddLabels(ctx, change)
+			}
+			return client.RemoveLabels(ctx, change)
+		},
+		done: func(err error) tea.Msg {
+			if err != nil {
+				return labelErrorMsg{name: label.Name, added: add, err: err}
+			}
+			return labelSavedMsg{}
+		},
+	})
+}
+
+// applyLabel adds or removes a label name on the card
+func applyLabel(card *domain.Card, name string, add bool) {
+	card.Labels = slices.DeleteFunc(card.Labels, func(l string) bool { return strings.EqualFold(l, name) })
+	if add {
+		card.Labels = append(card.Labels, name)
+	}
+}
+
+// labelSwatch renders a dot in the label's color
+func labelSwatch(color string) string {
+	if color == "" {
+		return "●"
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("#" + color)).Render("●")
+}
+
+// renderLabelPicker renders the label picker in place of the description,
+// scrolled to keep the cursor in view
+func (m DetailModel) renderLabelPicker(width int) string {
+	var b strings.Builder
+	b.WriteString(detailLabelStyle.Render("Labels of " + cardLabel(m.card)))
+	b.WriteString("\n")
+
+	switch {
+	case m.labelsError != "":
+		b.WriteString(errorStyle.Render(m.labelsError))
+		return b.String()
+	case m.labels == nil:
+		b.WriteString(loadingText(m.spinner, m.reducedMotion, "Loading labels…"))
+		return b.String()
+	case len(m.labels) == 0:
+		b.WriteString(dimStyle.Render("This repository has no labels"))
+		return b.String()
+	}
+
+	rows := max(m.bodyView.Height, 1)
+	start := max(m.labelCursor-rows+1, 0)
+	end := min(start+rows, len(m.labels))
+	for i := start; i < end; i++ {
+		l := m.labels[i]
+		box := "[ ]"
+		if hasLabel(m.card, l.Name) {
+			box = "[x]"
+		}
+		cursor := "  "
+		if i == m.labelCursor {
+			cursor = selectedCommentStyle.Render("▸ ")
+		}
+		line := box + " " + labelSwatch(l.Color) + " " + l.Name
+		if room := width - lipgloss.Width(line) - 5; l.Description != "" && room > 3 {
+			line += dimStyle.Render("  " + truncateLine(l.Description, room))
+		}
+		b.WriteString(cursor + line + "\n")
+	}
+	return b.String()
+}
+
+// Message types for the label picker
+type (
+	labelsLoadedMsg struct {
+		itemID string
+		labels []gh.Label
+	}
+	labelsErrorMsg struct{ err error }
+	labelSavedMsg  struct{}
+	// labelErrorMsg reports a change GitHub refused; added is what was
+	// attempted
+	labelErrorMsg struct {
+		name  string
+		added bool
+		err   error
+	}
+)
@@ -0,0 +1,215 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/store"
+)
+
+var laneHeaderStyle = lipgloss.NewStyle().
+	Bold(true).
+	Foreground(lipgloss.Color("141"))
+
+// cycleLanes switches swimlanes to the next single-select field other than
+// the grouping field, turning them off after the last one
+func (m *BoardModel) cycleLanes() {
+	groupField := m.store.GetGroupField()
+	var candidates []domain.FieldDef
+	for _, f := range m.store.GetFields() {
+		if f.Type == domain.FieldTypeSingleSelect && (groupField == nil || f.ID != groupField.ID) {
+			candidates = append(candidates, f)
+		}
+	}
+	if len(candidates) == 0 {
+		m.errorToast = "No other single-select field to split columns into lanes"
+		return
+	}
+
+	next := 0
+	if m.laneField != nil {
+		next = slices.IndexFunc(candidates, func(f domain.FieldDef) bool { return f.ID == m.laneField.ID }) + 1
+	}
+	if next >= len(candidates) {
+		m.laneField = nil
+		m.infoToast = "Swimlanes off"
+	} else {
+		m.laneField = &candidates[next]
+		m.infoToast = "Swimlanes by " + m.laneField.Name
+	}
+	m.applyFilter()
+}
+
+// laneKeys returns the lanes of the current lane field in option order,
+// with cards lacking a value last
+func (m BoardModel) laneKeys() []string {
+	keys := make([]string, 0, len(m.laneField.Options)+1)
+	for _, opt := range m.laneField.Options {
+		keys = append(keys, opt.ID)
+	}
+	return append(keys, store.NoStatusKey)
+}
+
+// laneName returns a lane's option name
+func (m BoardModel) laneName(key string) string {
+	for _, opt := range m.laneField.Options {
+		if opt.ID == key {
+			return opt.Name
+		}
+	}
+	return "No " + m.laneField.Name
+}
+
+// groupByLane reorders each filtered column lane by lane, keeping the
+// column's order within a lane, so j/k walk the lanes top to bottom
+func (m *BoardModel) groupByLane() {
+	m.cardLane = nil
+	if m.laneField == nil {
+		return
+	}
+	lanes, err := m.store.GetColumnLanes(m.laneField)
+	if err != nil {
+		return
+	}
+	m.cardLane = make(map[string]string)
+	for _, byLane := range lanes {
+		for lane, ids := range byLane {
+			for _, id := range ids {
+				m.cardLane[id] = lane
+			}
+		}
+	}
+
+	rank := make(map[string]int)
+	for i, key := range m.laneKeys() {
+		rank[key] = i
+	}
+	for _, ids := range m.filteredCards {
+		slices.SortStableFunc(ids, func(a, b string) int {
+			return rank[m.cardLane[a]] - rank[m.cardLane[b]]
+		})
+	}
+}
+
+// renderSwimlanes renders the board as a grid: a row of column headers, then
+// one band per lane with each column's cards for that lane
+func (m BoardModel) renderSwimlanes(totalWidth, totalHeight int) string {
+	startCol, endCol, colWidth, _ := m.columnLayout(totalWidth)
+	cardWidth := colWidth - 4 // Room for the cursor, mark, and a gap
+
+	// Only lanes holding a filtered card in some column get a band
+	var lanes []string
+	for _, key := range m.laneKeys() {
+		for _, ids := range m.filteredCards {
+			if slices.ContainsFunc(ids, func(id string) bool { return m.cardLane[id] == key }) {
+				lanes = append(lanes, key)
+				break
+			}
+		}
+	}
+	if len(lanes) == 0 {
+		return lipgloss.Place(totalWidth, totalHeight, lipgloss.Center, lipgloss.Center, "No matching cards")
+	}
+
+	// Lines per band, after the column headers and each band's title
+	rowsPerLane := max((totalHeight-1-len(lanes))/len(lanes), 1)
+
+	cell := lipgloss.NewStyle().Width(colWidth)
+	var headers []string
+	for i := startCol; i < endCol; i++ {
+		colID := m.columns[i]
+		text := fmt.Sprintf("[%d] %s (%d)", i+1, m.columnNames[colID], len(m.filteredCards[colID]))
+		text = truncateLine(text, colWidth-1)
+		if i == m.selectedColumn {
+			text = selectedCardStyle.Render(text)
+		} else {
+			text = columnHeaderStyle.Render(text)
+		}
+		headers = append(headers, cell.Render(text))
+	}
+	rows := []string{lipgloss.JoinHorizontal(lipgloss.Top, headers...)}
+
+	for _, lane := range lanes {
+		count := 0
+		var cells []string
+		for i := startCol; i < endCol; i++ {
+			lines := m.laneCell(m.columns[i], lane, i == m.selectedColumn, cardWidth, rowsPerLane)
+			count += m.laneCount(m.columns[i], lane)
+			cells = append(cells, cell.Render(strings.Join(lines, "\n")))
+		}
+		name := m.laneField.Name + ": " + m.laneName(lane)
+		if lane == store.NoStatusKey {
+			name = m.laneName(lane)
+		}
+		title := fmt.Sprintf("── %s (%d) ", name, count)
+		title += strings.Repeat("─", max(totalWidth-lipgloss.Width(title), 0))
+		rows = append(rows, laneHeaderStyle.Render(truncateLine(title, totalWidth)))
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, cells...))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+// laneCount returns how many of a column's filtered cards are in a lane
+func (m BoardModel) laneCount(colID, lane string) int {
+	n := 0
+	for _, id := range m.filteredCards[colID] {
+		if m.cardLane[id] == lane {
+			n++
+		}
+	}
+	return n
+}
+
+// laneCell renders a column's cards in one lane, at most maxLines lines,
+// scrolled to keep the selected card in view
+func (m BoardModel) laneCell(colID, lane string, selected bool, width, maxLines int) []string {
+	ids := m.filteredCards[colID]
+	selectedID := ""
+	if idx := m.selectedCard[colID]; selected && idx < len(ids) {
+		selectedID = ids[idx]
+	}
+
+	var inLane []string
+	for _, id := range ids {
+		if m.cardLane[id] == lane {
+			inLane = append(inLane, id)
+		}
+	}
+	if len(inLane) == 0 {
+		return []string{dimStyle.Render("  ·")}
+	}
+
+	// Leave a line for "+N more" when the lane overflows
+	shown := len(inLane)
+	if shown > maxLines {
+		shown = max(maxLines-1, 1)
+	}
+	start := 0
+	if pos := slices.Index(inLane, selectedID); pos >= shown {
+		start = pos - shown + 1
+	}
+
+	var lines []string
+	for _, id := range inLane[start : start+shown] {
+		card, err := m.store.GetCard(id)
+		if err != nil {
+			continue
+		}
+		mark, changed := m.cardMark(id)
+		text := m.formatCardText(card, width)
+		if id == selectedID {
+			lines = append(lines, selectedCardStyle.Render(">"+mark+text))
+		} else if changed {
+			lines = append(lines, changedCardStyle.Render(" "+mark+text))
+		} else {
+			lines = append(lines, cardStyle.Render(" "+mark+text))
+		}
+	}
+	if hidden := len(inLane) - shown; hidden > 0 {
+		lines = append(lines, dimStyle.Render(fmt.Sprintf("  +%d more", hidden)))
+	}
+	return lines
+}
@@ -0,0 +1,158 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/domain"
+)
+
+// linkItem wraps a card as a link target in the picker
+type linkItem struct {
+	card *domain.Card
+}
+
+func (i linkItem) FilterValue() string {
+	return fmt.Sprintf("#%d %s %s", i.card.Number, i.card.Title, i.card.Repo)
+}
+
+// linkDelegate renders link targets one per line
+type linkDelegate struct{}
+
+func (d linkDelegate) Height() int                             { return 1 }
+func (d linkDelegate) Spacing() int                            { return 0 }
+func (d linkDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+func (d linkDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	i, ok := item.(linkItem)
+	if !ok {
+		return
+	}
+
+	str := fmt.Sprintf("%s#%d %s", i.card.Repo, i.card.Number, i.card.Title)
+	if index == m.Index() {
+		fmt.Fprint(w, SelectedItemStyle.Render("> "+str))
+	} else {
+		fmt.Fprint(w, NormalItemStyle.Render("  "+str))
+	}
+}
+
+// linkable reports whether a card can be referenced from a comment
+func linkable(card *domain.Card) bool {
+	return (card.ContentType == domain.ContentTypeIssue || card.ContentType == domain.ContentTypePullRequest) &&
+		card.Repo != "" && card.Number > 0
+}
+
+// linkRef returns how from's comment refers to to: "#N" within a repository,
+// "owner/repo#N" across repositories
+func linkRef(from, to *domain.Card) string {
+	if strings.EqualFold(from.Repo, to.Repo) {
+		return fmt.Sprintf("#%d", to.Number)
+	}
+	return fmt.Sprintf("%s#%d", to.Repo, to.Number)
+}
+
+// startLink opens the target picker for linking the selected card
+func (m *BoardModel) startLink() {
+	source := m.getSelectedCard()
+	if source == nil {
+		return
+	}
+	if !linkable(source) {
+		m.errorToast = "Only issues and pull requests can be linked"
+		return
+	}
+
+	cards := m.store.GetAllCards()
+	sort.Slice(cards, func(i, j int) bool { return m.store.Position(cards[i].ItemID) < m.store.Position(cards[j].ItemID) })
+	var items []list.Item
+	for _, card := range cards {
+		if card.ItemID != source.ItemID && linkable(card) {
+			items = append(items, linkItem{card: card})
+		}
+	}
+	if len(items) == 0 {
+		m.errorToast = "No other issues or pull requests to link to"
+		return
+	}
+
+	l := list.New(items, linkDelegate{}, m.width, m.height)
+	l.Title = fmt.Sprintf("Link %s#%d to… (relates to)", source.Repo, source.Number)
+	l.SetShowStatusBar(false)
+	l.SetShowHelp(false)
+	l.SetFilteringEnabled(true)
+	l.Styles.Title = TitleStyle
+
+	m.linkPicker = l
+	m.linkSource = source
+}
+
+// handleLinkPicker handles key presses while choosing a link target
+func (m BoardModel) handleLinkPicker(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	filtering := m.linkPicker.SettingFilter()
+	switch msg.String() {
+	case "esc":
+		// Let esc clear an in-progress filter first
+		if !filtering && !m.linkPicker.IsFiltered() {
+			m.linkSource = nil
+			return m, nil
+		}
+	case "enter":
+		if filtering {
+			break
+		}
+		item, ok := m.linkPicker.SelectedItem().(linkItem)
+		source := m.linkSource
+		m.linkSource = nil
+		if !ok {
+			return m, nil
+		}
+		return m, m.linkItems(source, item.card)
+	}
+
+	var cmd tea.Cmd
+	m.linkPicker, cmd = m.linkPicker.Update(msg)
+	return m, cmd
+}
+
+// renderLinkPicker renders the target picker in the board area
+func (m BoardModel) renderLinkPicker(width, height int) string {
+	l := m.linkPicker
+	l.SetSize(width, height)
+	return l.View()
+}
+
+// linkItems posts a "Relates to" comment on source referencing target.
+// GitHub turns the reference into a cross-link shown on both items.
+func (m BoardModel) linkItems(source, target *domain.Card) tea.Cmd {
+	ref := linkRef(source, target)
+	key := "link:" + source.ItemID + ">" + target.ItemID
+	ctx, ok := m.guard.begin(m.ctx, key)
+	if !ok {
+		return nil
+	}
+	return m.outbox.enqueue(ctx, &outboxEntry{
+		label: fmt.Sprintf("Link %s to %s", cardLabel(source), ref),
+		send: func(ctx context.Context) error {
+			defer m.guard.end(key)
+			owner, repo, _ := strings.Cut(source.Repo, "/")
+			return m.client.AddComment(ctx, owner, repo, source.Number, fmt.Sprintf("Relates to %s", ref))
+		},
+		done: func(err error) tea.Msg {
+			if err != nil {
+				return linkErrorMsg{err: err}
+			}
+			return itemsLinkedMsg{source: fmt.Sprintf("#%d", source.Number), target: ref}
+		},
+	})
+}
+
+// Message types for item linking
+type (
+	itemsLinkedMsg struct{ source, target string }
+	linkErrorMsg   struct{ err error }
+)
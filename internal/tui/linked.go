@@ -0,0 +1,113 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/domain"
+)
+
+// loadLinked creates a command to load the issues and PRs linked to the card
+func (m DetailModel) loadLinked() tea.Cmd {
+	contentID, repo, number := m.card.ContentID, m.card.Repo, m.card.Number
+	return func() tea.Msg {
+		owner, name, ok := strings.Cut(repo, "/")
+		if !ok {
+			return linkedErrorMsg{contentID: contentID, err: fmt.Errorf("invalid repo format")}
+		}
+		items, err := m.client.GetLinkedItems(m.ctx, owner, name, number)
+		if err != nil {
+			return linkedErrorMsg{contentID: contentID, err: err}
+		}
+		return linkedLoadedMsg{contentID: contentID, items: items}
+	}
+}
+
+// selectLinked moves the linked item selection by delta, wrapping around
+func (m *DetailModel) selectLinked(delta int) {
+	if len(m.linked) == 0 {
+		return
+	}
+	m.linkedCursor = (m.linkedCursor + delta + len(m.linked)) % len(m.linked)
+}
+
+// openLinked asks the app to show the selected linked item's detail view
+func (m DetailModel) openLinked() tea.Cmd {
+	if m.linkedCursor >= len(m.linked) {
+		return nil
+	}
+	item := m.linked[m.linkedCursor]
+	return func() tea.Msg { return openLinkedMsg{item: item} }
+}
+
+// renderLinked renders the linked items section of the info panel, the
+// selected one marked
+func (m DetailModel) renderLinked(width int) string {
+	var b strings.Builder
+	switch {
+	case m.linkedError != "":
+		b.WriteString(detailLabelStyle.Render("Linked: "))
+		b.WriteString(errorStyle.Render(truncateLine(m.linkedError, width-8)))
+		return b.String() + "\n"
+	case !m.linkedLoaded:
+		b.WriteString(detailLabelStyle.Render("Linked: "))
+		b.WriteString(loadingText(m.spinner, m.reducedMotion, "Loading…"))
+		return b.String() + "\n"
+	case len(m.linked) == 0:
+		return ""
+	}
+
+	b.WriteString(detailLabelStyle.Render("Linked [/]:"))
+	b.WriteString("\n")
+	for i, item := range m.linked {
+		kind := "#"
+		if item.ContentType == domain.ContentTypePullRequest {
+			kind = "PR #"
+		}
+		line := fmt.Sprintf("%s %s%d %s", item.Relation, kind, item.Number, item.Title)
+		if item.State != "" && item.State != "OPEN" {
+			line += " (" + strings.ToLower(item.State) + ")"
+		}
+		line = truncateLine(line, width-2)
+		if i == m.linkedCursor {
+			b.WriteString(selectedCommentStyle.Render("▸ " + line))
+		} else {
+			b.WriteString("  " + detailValueStyle.Render(line))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// linkedCard returns the card to show for a linked item: the project's own
+// when it is on the board, otherwise one built from the link
+func (m AppModel) linkedCard(item domain.LinkedItem) *domain.Card {
+	for _, c := range m.store.GetAllCards() {
+		if c.Number == item.Number && strings.EqualFold(c.Repo, item.Repo) {
+			return c
+		}
+	}
+	return &domain.Card{
+		ContentID:   item.ContentID,
+		ContentType: item.ContentType,
+		Repo:        item.Repo,
+		Number:      item.Number,
+		Title:       item.Title,
+		State:       item.State,
+		URL:         item.URL,
+	}
+}
+
+// Message types for linked items
+type (
+	linkedLoadedMsg struct {
+		contentID string
+		items     []domain.LinkedItem
+	}
+	linkedErrorMsg struct {
+		contentID string
+		err       error
+	}
+	openLinkedMsg struct{ item domain.LinkedItem }
+)
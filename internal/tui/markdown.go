@@ -0,0 +1,81 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/glamour"
+	glamouransi "github.com/charmbracelet/glamour/ansi"
+	"github.com/charmbracelet/glamour/styles"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// markdownStyle is glamour's dark style without the document margin; the
+// panes have their own
+var markdownStyle = func() glamouransi.StyleConfig {
+	style := styles.DarkStyleConfig
+	var none uint
+	style.Document.Margin = &none
+	return style
+}()
+
+// markdownRenderer renders descriptions and comments as styled markdown at
+// one width. Results are kept, as panes re-render on every selection change.
+type markdownRenderer struct {
+	width    int
+	renderer *glamour.TermRenderer
+	rendered map[string]string
+}
+
+// render returns body as styled markdown wrapped to width, or wrapped plain
+// text if it can't be rendered
+func (r *markdownRenderer) render(body string, width int) string {
+	if r.renderer == nil || r.width != width {
+		tr, err := glamour.NewTermRenderer(
+			glamour.WithStyles(markdownStyle),
+			glamour.WithWordWrap(width),
+			glamour.WithPreservedNewLines(),                   // Line breaks show as on GitHub
+			glamour.WithColorProfile(lipgloss.ColorProfile()), // Colorless when the rest is
+		)
+		if err != nil {
+			return renderCommentBody(body, width)
+		}
+		r.width, r.renderer, r.rendered = width, tr, make(map[string]string)
+	}
+	if out, ok := r.rendered[body]; ok {
+		return out
+	}
+	out, err := r.renderer.Render(body)
+	if err != nil {
+		return renderCommentBody(body, width)
+	}
+	out = trimRendered(out)
+	r.rendered[body] = out
+	return out
+}
+
+// trimRendered drops the blank lines glamour puts around a document and the
+// spaces it pads each line to the wrap width with
+func trimRendered(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		visible := ansi.StringWidth(strings.TrimRight(ansi.Strip(line), " "))
+		lines[i] = ansi.Truncate(line, visible, "")
+	}
+	for len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && ansi.Strip(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderText renders a description or comment for a pane: as markdown, or
+// as written when raw text is on
+func (m DetailModel) renderText(r *markdownRenderer, body string, width int) string {
+	if m.rawMarkdown {
+		return renderCommentBody(body, width)
+	}
+	return r.render(body, width)
+}
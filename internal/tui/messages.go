@@ -23,6 +23,10 @@ type FieldSelectedMsg struct {
 	Field domain.FieldDef
 }
 
+// PickerCancelledMsg is emitted when the user backs out of a picker.
+// The app returns to the board if one is open, and quits otherwise.
+type PickerCancelledMsg struct{}
+
 // ErrorMsg is emitted when an error occurs.
 type ErrorMsg struct {
 	Err error
@@ -0,0 +1,32 @@
+package tui
+
+import (
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// newSpinner creates the loading spinner used across screens
+func newSpinner() spinner.Model {
+	sp := spinner.New()
+	sp.Spinner = spinner.Dot
+	sp.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	return sp
+}
+
+// spinnerTick starts the spinner animation.
+// Returns nil in reduced-motion mode so no animation frames are ever scheduled.
+func spinnerTick(sp spinner.Model, reducedMotion bool) tea.Cmd {
+	if reducedMotion {
+		return nil
+	}
+	return sp.Tick
+}
+
+// loadingText prefixes label with the spinner, or returns label alone in reduced-motion mode
+func loadingText(sp spinner.Model, reducedMotion bool, label string) string {
+	if reducedMotion {
+		return label
+	}
+	return sp.View() + label
+}
@@ -0,0 +1,146 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/cache"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/store"
+)
+
+// offlineRefusal says why a key can't be used on an offline board, "" when
+// it can. Keys that change the project are refused while GitHub can't be
+// reached.
+func (m BoardModel) offlineRefusal(msg tea.KeyMsg) string {
+	k := m.keymap
+	if !m.offline || !key.Matches(msg, k.Move, k.Reorder, k.New, k.Edit, k.Assign, k.CloseItem, k.ReopenItem,
+		k.Link, k.ArchiveDone, k.Triage, k.Sweep, k.LoadMore, k.Remap, k.Undo, k.Redo) {
+		return ""
+	}
+	return "Offline: the board is read-only until it refreshes (r to retry)"
+}
+
+// cacheLabel describes a board shown from the cache for the header, "" once
+// it has live items
+func (m BoardModel) cacheLabel() string {
+	if m.cachedAt.IsZero() {
+		return ""
+	}
+	age := formatTimeAgo(m.cachedAt.UTC().Format(time.RFC3339))
+	if m.offline {
+		return "offline · cached " + age
+	}
+	return "cached " + age + ", refreshing…"
+}
+
+// handleRevalidated swaps in freshly fetched items, or keeps the cached ones
+// and goes offline when the fetch failed
+func (m BoardModel) handleRevalidated(msg revalidatedMsg) (tea.Model, tea.Cmd) {
+	if msg.auto {
+		// A failed background refresh only matters to a cached board
+		if msg.err != nil && m.cachedAt.IsZero() {
+			return m, m.autoRefreshTick()
+		}
+		if pending, _ := m.outbox.counts(); pending > 0 {
+			return m, m.autoRefreshTick()
+		}
+		if msg.err == nil {
+			if changes := m.diffCards(msg.batch.cards); len(changes) > 0 {
+				m.changes = changes
+				m.infoToast = changeSummary(changes)
+			}
+		}
+		model, cmd := m.handleRevalidated(revalidatedMsg{batch: msg.batch, err: msg.err})
+		return model, tea.Batch(cmd, m.autoRefreshTick())
+	}
+
+	m.loading = false
+	if msg.err != nil {
+		if isSSOError(msg.err) {
+			return m, reportError(msg.err)
+		}
+		if m.cachedAt.IsZero() {
+			m.errorToast = fmt.Sprintf("Refresh failed: %v", msg.err)
+			return m, nil
+		}
+		m.offline = true
+		m.errorToast = fmt.Sprintf("Offline: %v", msg.err)
+		return m, nil
+	}
+
+	m.offline = false
+	m.cachedAt = time.Time{}
+	m.store.Clear()
+	m.store.UpsertCards(msg.batch.cards)
+	m.store.SetPagination(msg.batch.cursor, msg.batch.truncated)
+	return m.update(itemsLoadedMsg{pages: msg.batch.pages})
+}
+
+// loadCachedBoard reads the cached snapshot of a project for showing before
+// the live one arrives. A missing or unreadable cache sends nothing.
+func loadCachedBoard(owner string, number int) tea.Cmd {
+	return func() tea.Msg {
+		entry, err := cache.Load(owner, number)
+		if err != nil || len(entry.Cards) == 0 {
+			return nil
+		}
+		return cachedBoardMsg{entry: entry}
+	}
+}
+
+// fillFromCache loads a cached snapshot into the app's store, returning false
+// when it can't stand in for the board being opened
+func (m *AppModel) fillFromCache(entry *cache.Entry) bool {
+	if m.boardModel != nil || m.currentScreen == ScreenFieldPicker || m.comparing {
+		return false
+	}
+	if m.project != nil && m.project.ID != entry.Project.ID {
+		return false
+	}
+	if m.groupFieldFlag != "" && !store.MatchesField(entry.GroupField, m.groupFieldFlag) {
+		return false
+	}
+	if m.groupField != nil && m.groupField.Name != entry.GroupField.Name {
+		return false
+	}
+
+	fields := entry.Fields
+	if len(fields) == 0 {
+		fields = []domain.FieldDef{entry.GroupField}
+	}
+	m.project = &entry.Project
+	m.fields = fields
+	m.groupField = &entry.GroupField
+	m.store.SetProject(&entry.Project)
+	m.store.SetFields(fields)
+	m.store.SetGroupField(&entry.GroupField)
+	if m.store.GetViewerLogin() == "" {
+		m.store.SetViewerLogin(entry.Viewer)
+	}
+	cards := make([]*domain.Card, len(entry.Cards))
+	for i := range entry.Cards {
+		cards[i] = &entry.Cards[i]
+	}
+	m.store.UpsertCards(cards)
+	return true
+}
+
+// Message types for the offline cache
+type (
+	// cachedBoardMsg carries a project's cached snapshot
+	cachedBoardMsg struct{ entry *cache.Entry }
+
+	// revalidatedMsg carries all items fetched to replace the board's
+	revalidatedMsg struct {
+		batch itemBatch
+		err   error
+		auto  bool // From the auto-refresh ticker
+	}
+
+	// offlineMsg reports that the app couldn't reach GitHub while a cached
+	// board is open
+	offlineMsg struct{ err error }
+)
@@ -0,0 +1,208 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/domain"
+)
+
+// outboxEntry is one mutation on its way to GitHub. Entries leave the outbox
+// when they succeed; failed ones stay until retried or discarded.
+type outboxEntry struct {
+	id      int
+	label   string // e.g. "Move #12 to Done"
+	started time.Time
+	failed  bool
+	err     error
+
+	// apply re-does the optimistic local change before a retry, may be nil
+	apply func() error
+	// send performs the request; it is run again on retry
+	send func(ctx context.Context) error
+	// done turns the result into the message the caller expects
+	done func(err error) tea.Msg
+	// detail is set for the detail view's changes; the board gets the
+	// results of the others, even while the detail view covers it
+	detail bool
+}
+
+// outbox tracks mutations that haven't reached GitHub yet. It is shared by
+// pointer between the board and detail view and only touched from Update.
+type outbox struct {
+	entries []*outboxEntry
+	nextID  int
+	log     *actionLog // Where outcomes are recorded, may be nil
+}
+
+func newOutbox() *outbox {
+	return &outbox{}
+}
+
+// enqueue adds a pending entry and returns the command that sends it
+func (o *outbox) enqueue(ctx context.Context, e *outboxEntry) tea.Cmd {
+	o.nextID++
+	e.id = o.nextID
+	o.entries = append(o.entries, e)
+	return o.run(ctx, e)
+}
+
+// run sends an entry and reports the outcome as an outboxResultMsg
+func (o *outbox) run(ctx context.Context, e *outboxEntry) tea.Cmd {
+	e.started = time.Now()
+	e.failed, e.err = false, nil
+	return func() tea.Msg {
+		err := e.send(ctx)
+		return outboxResultMsg{id: e.id, err: err, then: e.done(err), detail: e.detail}
+	}
+}
+
+// resolve records an entry's outcome and returns the caller's message
+func (o *outbox) resolve(msg outboxResultMsg) tea.Msg {
+	for i, e := range o.entries {
+		if e.id != msg.id {
+			continue
+		}
+		if msg.err == nil {
+			o.entries = append(o.entries[:i], o.entries[i+1:]...)
+			o.log.add(logSent, e.label, nil)
+		} else {
+			e.failed, e.err = true, msg.err
+			o.log.add(logFailed, e.label, msg.err)
+		}
+		break
+	}
+	return msg.then
+}
+
+// retry resends a failed entry, re-applying its local change first
+func (o *outbox) retry(ctx context.Context, id int) tea.Cmd {
+	e := o.find(id)
+	if e == nil || !e.failed {
+		return nil
+	}
+	if e.apply != nil {
+		if err := e.apply(); err != nil {
+			e.err = err
+			return nil
+		}
+	}
+	return o.run(ctx, e)
+}
+
+// discard drops a failed entry; pending ones can't be recalled
+func (o *outbox) discard(id int) {
+	for i, e := range o.entries {
+		if e.id == id && e.failed {
+			o.entries = append(o.entries[:i], o.entries[i+1:]...)
+			return
+		}
+	}
+}
+
+func (o *outbox) find(id int) *outboxEntry {
+	for _, e := range o.entries {
+		if e.id == id {
+			return e
+		}
+	}
+	return nil
+}
+
+// counts returns how many entries are pending and failed
+func (o *outbox) counts() (pending, failed int) {
+	for _, e := range o.entries {
+		if e.failed {
+			failed++
+		} else {
+			pending++
+		}
+	}
+	return pending, failed
+}
+
+// cardLabel names a card in outbox entries
+func cardLabel(card *domain.Card) string {
+	if card.Number > 0 {
+		return fmt.Sprintf("#%d", card.Number)
+	}
+	title := []rune(card.Title)
+	if len(title) > 30 {
+		return fmt.Sprintf("%q", string(title[:29])+"…")
+	}
+	return fmt.Sprintf("%q", card.Title)
+}
+
+// handleOutboxKey handles keys in the outbox viewer
+func (m BoardModel) handleOutboxKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	entries := m.outbox.entries
+	switch msg.String() {
+	case "Q", "q", "esc":
+		m.showOutbox = false
+	case "j", "down":
+		if m.outboxCursor < len(entries)-1 {
+			m.outboxCursor++
+		}
+	case "k", "up":
+		if m.outboxCursor > 0 {
+			m.outboxCursor--
+		}
+	case "r":
+		if m.outboxCursor < len(entries) {
+			return m, m.outbox.retry(m.ctx, entries[m.outboxCursor].id)
+		}
+	case "d":
+		if m.outboxCursor < len(entries) {
+			m.outbox.discard(entries[m.outboxCursor].id)
+			m.outboxCursor = max(0, min(m.outboxCursor, len(m.outbox.entries)-1))
+		}
+	}
+	return m, nil
+}
+
+// renderOutbox renders the outbox viewer
+func (m BoardModel) renderOutbox(width int) string {
+	var b strings.Builder
+
+	b.WriteString(titleStyle.Render("Outbox"))
+	b.WriteString("\n\n")
+
+	if len(m.outbox.entries) == 0 {
+		b.WriteString(dimStyle.Render("Everything has reached GitHub."))
+		return HelpOverlayStyle.Render(b.String())
+	}
+
+	for i, e := range m.outbox.entries {
+		cursor := "  "
+		if i == m.outboxCursor {
+			cursor = "▸ "
+		}
+		status := loadingText(m.spinner, m.reducedMotion, "sending")
+		if e.failed {
+			status = errorStyle.Render("failed")
+		}
+		line := fmt.Sprintf("%s%s  %s  %s", cursor, status, e.label, dimStyle.Render(formatTimeAgo(e.started.Format(time.RFC3339))))
+		b.WriteString(line)
+		b.WriteString("\n")
+		if e.failed && e.err != nil {
+			errText := truncateLine(e.err.Error(), width-12)
+			b.WriteString("    " + dimStyle.Render(errText) + "\n")
+		}
+	}
+
+	b.WriteString("\n")
+	b.WriteString(dimStyle.Render("j/k select · r retry · d discard · esc close"))
+	return HelpOverlayStyle.Render(b.String())
+}
+
+// outboxResultMsg reports the outcome of sending an outbox entry; then is the
+// message the code that queued it expects
+type outboxResultMsg struct {
+	id     int
+	err    error
+	then   tea.Msg
+	detail bool // For the detail view rather than the board
+}
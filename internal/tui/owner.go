@@ -96,12 +96,18 @@ func (m OwnerPickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					}
 				}
 			}
-		case "q", "esc":
+		case "q":
 			if !m.list.SettingFilter() {
 				return m, func() tea.Msg {
 					return QuitMsg{}
 				}
 			}
+		case "esc":
+			if !m.list.SettingFilter() && !m.list.IsFiltered() {
+				return m, func() tea.Msg {
+					return PickerCancelledMsg{}
+				}
+			}
 		}
 
 	case tea.WindowSizeMsg:
@@ -0,0 +1,62 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/store"
+)
+
+// formatParentLine renders the "↳ parent" line shown under a tracked card
+func formatParentLine(parent *domain.IssueRef, maxWidth int) string {
+	text := "↳ " + parent.Title
+	if parent.Title == "" {
+		text = fmt.Sprintf("↳ %s#%d", parent.Repo, parent.Number)
+	}
+	if runes := []rune(text); len(runes) > maxWidth {
+		text = string(runes[:max(maxWidth-1, 0)]) + "…"
+	}
+	return text
+}
+
+// findParent returns the card for a parent issue, or nil if the parent is
+// not in the project
+func (m BoardModel) findParent(ref *domain.IssueRef) *domain.Card {
+	for _, c := range m.store.GetAllCards() {
+		if c.Number == ref.Number && strings.EqualFold(c.Repo, ref.Repo) {
+			return c
+		}
+	}
+	return nil
+}
+
+// jumpToParent selects the parent issue of the selected card
+func (m *BoardModel) jumpToParent() {
+	card := m.getSelectedCard()
+	if card == nil || card.Parent == nil {
+		return
+	}
+	ref := fmt.Sprintf("%s#%d", card.Parent.Repo, card.Parent.Number)
+	parent := m.findParent(card.Parent)
+	if parent == nil {
+		m.errorToast = fmt.Sprintf("Parent %s is not on the board", ref)
+		return
+	}
+
+	colID := parent.GroupOptionID
+	if colID == "" {
+		colID = store.NoStatusKey
+	}
+	col := slices.Index(m.columns, colID)
+	idx := slices.Index(m.filteredCards[colID], parent.ItemID)
+	if col < 0 || idx < 0 {
+		m.errorToast = fmt.Sprintf("Parent %s is hidden by the current filter or columns", ref)
+		return
+	}
+	m.selectedColumn = col
+	m.selectedCard[colID] = idx
+	m.adjustScroll(colID)
+	m.adjustColumnScroll()
+}
@@ -0,0 +1,150 @@
+package tui
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/gh"
+)
+
+const (
+	// prefetchAhead is how many cards below the selection get their comments prefetched
+	prefetchAhead = 3
+	// prefetchCacheSize is the number of items whose comments are kept
+	prefetchCacheSize = 50
+	// prefetchDelay lets rapid navigation settle before fetching
+	prefetchDelay = 150 * time.Millisecond
+)
+
+// commentPrefetcher fetches comments for cards near the selection in the
+// background and keeps the most recently used ones, so the detail view can
+// open without waiting. It is shared by the board and detail views.
+type commentPrefetcher struct {
+	mu       sync.Mutex
+	entries  map[string]*list.Element
+	order    *list.List // Front is most recently used
+	capacity int
+	cancel   context.CancelFunc // Cancels the in-flight prefetch
+}
+
+// commentEntry is a cached newest comment page
+type commentEntry struct {
+	key  string
+	page domain.CommentPage
+}
+
+// newCommentPrefetcher creates a prefetcher keeping up to capacity items
+func newCommentPrefetcher(capacity int) *commentPrefetcher {
+	return &commentPrefetcher{
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+		capacity: capacity,
+	}
+}
+
+// commentKey identifies a card's comment thread ("owner/repo#N")
+func commentKey(card *domain.Card) string {
+	return fmt.Sprintf("%s#%d", card.Repo, card.Number)
+}
+
+// Get returns the cached newest comment page for a card
+func (p *commentPrefetcher) Get(card *domain.Card) (domain.CommentPage, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	el, ok := p.entries[commentKey(card)]
+	if !ok {
+		return domain.CommentPage{}, false
+	}
+	p.order.MoveToFront(el)
+	return el.Value.(*commentEntry).page, true
+}
+
+// Put stores a card's newest comment page, evicting the least recently used
+// entry when full
+func (p *commentPrefetcher) Put(card *domain.Card, page domain.CommentPage) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := commentKey(card)
+	if el, ok := p.entries[key]; ok {
+		el.Value.(*commentEntry).page = page
+		p.order.MoveToFront(el)
+		return
+	}
+	p.entries[key] = p.order.PushFront(&commentEntry{key: key, page: page})
+	if p.order.Len() > p.capacity {
+		oldest := p.order.Back()
+		p.order.Remove(oldest)
+		delete(p.entries, oldest.Value.(*commentEntry).key)
+	}
+}
+
+// Prefetch fetches comments for cards that aren't cached yet, cancelling
+// any prefetch still running for an earlier selection.
+func (p *commentPrefetcher) Prefetch(ctx context.Context, client *gh.Client, cards []*domain.Card) tea.Cmd {
+	p.mu.Lock()
+	if p.cancel != nil {
+		p.cancel()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	p.cancel = cancel
+	p.mu.Unlock()
+
+	return func() tea.Msg {
+		select {
+		case <-time.After(prefetchDelay):
+		case <-ctx.Done():
+			return nil
+		}
+
+		for _, card := range cards {
+			if _, ok := p.Get(card); ok {
+				continue
+			}
+			owner, repo, _ := strings.Cut(card.Repo, "/")
+			page, err := client.GetComments(ctx, owner, repo, card.Number, "")
+			if err != nil {
+				// Cancelled or failed; the detail view fetches on demand
+				return nil
+			}
+			p.Put(card, page)
+		}
+		return nil
+	}
+}
+
+// prefetchComments prefetches comments for the selected card and the few below it
+func (m BoardModel) prefetchComments() tea.Cmd {
+	if m.prefetcher == nil || m.client == nil || len(m.columns) == 0 {
+		return nil
+	}
+
+	colID := m.columns[m.selectedColumn]
+	ids := m.filteredCards[colID]
+	start := m.selectedCard[colID]
+	var cards []*domain.Card
+	for i := start; i < len(ids) && i <= start+prefetchAhead; i++ {
+		if card, err := m.store.GetCard(ids[i]); err == nil && linkable(card) {
+			cards = append(cards, card)
+		}
+	}
+	if len(cards) == 0 {
+		return nil
+	}
+	return m.prefetcher.Prefetch(m.ctx, m.client, cards)
+}
+
+// selectedItemID returns the selected card's item ID, or "" if none
+func (m BoardModel) selectedItemID() string {
+	if card := m.getSelectedCard(); card != nil {
+		return card.ItemID
+	}
+	return ""
+}
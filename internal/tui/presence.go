@@ -0,0 +1,71 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/cache"
+)
+
+// presenceInterval is how often the board refreshes its presence file and
+// looks for other local sessions on the same project
+const presenceInterval = 5 * time.Second
+
+// lastBoardID numbers boards so a replaced board's heartbeat stops with it
+var lastBoardID int
+
+// presenceTick schedules the next presence heartbeat for the board
+func (m BoardModel) presenceTick() tea.Cmd {
+	id := m.boardID
+	return tea.Tick(presenceInterval, func(time.Time) tea.Msg { return presenceTickMsg{boardID: id} })
+}
+
+// syncPresence writes this session's presence and reads the other sessions'
+func (m BoardModel) syncPresence() tea.Cmd {
+	project := m.store.GetProject()
+	if project == nil {
+		return nil
+	}
+	pending, _ := m.outbox.counts()
+	return func() tea.Msg {
+		now := time.Now()
+		pid := os.Getpid()
+		// Presence is advisory: failures leave the indicator empty
+		_ = cache.WritePresence(project.Owner, project.Number, cache.Presence{PID: pid, Pending: pending, UpdatedAt: now})
+		others, _ := cache.OtherPresences(project.Owner, project.Number, pid, now)
+		return presenceMsg{others: others}
+	}
+}
+
+// presenceLabel summarizes other sessions for the header, "" when there are none.
+// Their unsent changes may clash with this board's optimistic moves.
+func (m BoardModel) presenceLabel() string {
+	pending := 0
+	for _, p := range m.otherSessions {
+		pending += p.Pending
+	}
+	switch {
+	case pending > 0:
+		return fmt.Sprintf("⚠ another ghp has %d unsent changes", pending)
+	case len(m.otherSessions) == 1:
+		return "1 other session"
+	case len(m.otherSessions) > 1:
+		return fmt.Sprintf("%d other sessions", len(m.otherSessions))
+	}
+	return ""
+}
+
+// leavePresence removes this session's presence file
+func (m BoardModel) leavePresence() {
+	if project := m.store.GetProject(); project != nil {
+		_ = cache.RemovePresence(project.Owner, project.Number, os.Getpid())
+	}
+}
+
+// Message types for presence
+type (
+	presenceTickMsg struct{ boardID int }
+	presenceMsg     struct{ others []cache.Presence }
+)
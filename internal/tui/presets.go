@@ -0,0 +1,243 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/h0rv/ghp/internal/config"
+	"github.com/h0rv/ghp/internal/uistate"
+)
+
+// Styles for the quick filter bar's chips
+var (
+	quickFilterStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("252")).
+				Background(lipgloss.Color("237")).
+				Padding(0, 1)
+
+	activeQuickFilterStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("0")).
+				Background(lipgloss.Color("205")).
+				Bold(true).
+				Padding(0, 1)
+)
+
+// handlePresetMenu handles key presses while the preset menu is open:
+// a number or enter applies a preset, s saves the current filters as one
+func (m BoardModel) handlePresetMenu(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch key := msg.String(); key {
+	case "esc", "q", "b":
+		m.presetMenu = false
+	case "j", "down":
+		if m.presetCursor < len(m.presets)-1 {
+			m.presetCursor++
+		}
+	case "k", "up":
+		if m.presetCursor > 0 {
+			m.presetCursor--
+		}
+	case "enter":
+		if m.presetCursor < len(m.presets) {
+			return m, (&m).applyPreset(m.presets[m.presetCursor])
+		}
+	case "s":
+		m.presetMenu = false
+		m.presetMode = true
+		m.presetInput.SetValue("")
+		m.presetInput.Focus()
+	default:
+		if len(key) == 1 && key >= "1" && key <= "9" {
+			if i := int(key[0] - '1'); i < len(m.presets) {
+				return m, (&m).applyPreset(m.presets[i])
+			}
+		}
+	}
+	return m, nil
+}
+
+// applyPreset replaces the board's filters and sort with a preset's
+func (m *BoardModel) applyPreset(p config.Preset) tea.Cmd {
+	m.presetMenu = false
+	m.filterText = p.Filter
+	m.filterInput.SetValue(p.Filter)
+	m.filterMyOnly = p.MyOnly
+	m.filterLabel = p.Label
+	m.infoToast = "Preset: " + p.Name
+
+	var save tea.Cmd
+	if project := m.store.GetProject(); project != nil {
+		if m.uiState == nil {
+			m.uiState = &uistate.State{}
+		}
+		if m.uiState.BoardSort != p.Sort {
+			m.uiState.BoardSort = p.Sort
+			save = m.saveUIState()
+		}
+	}
+	m.applyFilter()
+	return tea.Batch(save, m.loadSortDetails())
+}
+
+// handlePresetPrompt handles key presses while naming a preset to save
+func (m BoardModel) handlePresetPrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		m.presetMode = false
+		name := strings.TrimSpace(m.presetInput.Value())
+		if name == "" {
+			return m, nil
+		}
+		return m, (&m).savePreset(name)
+	case "esc":
+		m.presetMode = false
+		return m, nil
+	default:
+		var cmd tea.Cmd
+		m.presetInput, cmd = m.presetInput.Update(msg)
+		return m, cmd
+	}
+}
+
+// savePreset stores the current filters and board sort as a named preset,
+// replacing one of the same name, and writes it to the settings file
+func (m *BoardModel) savePreset(name string) tea.Cmd {
+	p := config.Preset{
+		Name:   name,
+		Filter: m.filterText,
+		MyOnly: m.filterMyOnly,
+		Label:  m.filterLabel,
+	}
+	if m.uiState != nil {
+		p.Sort = m.uiState.BoardSort
+	}
+
+	replaced := false
+	for i := range m.presets {
+		if strings.EqualFold(m.presets[i].Name, name) {
+			// Saving over a pinned preset keeps it in the quick filter bar
+			p.Pinned = m.presets[i].Pinned
+			m.presets[i], replaced = p, true
+		}
+	}
+	if !replaced {
+		m.presets = append(m.presets, p)
+	}
+	return func() tea.Msg {
+		if err := config.SavePreset(p); err != nil {
+			return presetErrorMsg{err: err}
+		}
+		return presetSavedMsg{preset: p}
+	}
+}
+
+// pinnedPresets returns the presets in the quick filter bar, at most the nine
+// that alt+number reaches
+func (m BoardModel) pinnedPresets() []config.Preset {
+	var pinned []config.Preset
+	for _, p := range m.presets {
+		if p.Pinned && len(pinned) < 9 {
+			pinned = append(pinned, p)
+		}
+	}
+	return pinned
+}
+
+// presetActive reports whether the board shows exactly a preset's filters
+// and sort
+func (m BoardModel) presetActive(p config.Preset) bool {
+	sort := ""
+	if m.uiState != nil {
+		sort = m.uiState.BoardSort
+	}
+	return m.filterText == p.Filter && m.filterMyOnly == p.MyOnly &&
+		strings.EqualFold(m.filterLabel, p.Label) && sort == p.Sort
+}
+
+// applyQuickFilter applies the nth pinned preset. Pressing it again while it
+// is applied clears the filters, leaving the sort.
+func (m *BoardModel) applyQuickFilter(n int) tea.Cmd {
+	pinned := m.pinnedPresets()
+	if n >= len(pinned) {
+		return nil
+	}
+	p := pinned[n]
+	if !m.presetActive(p) {
+		return m.applyPreset(p)
+	}
+	m.filterText = ""
+	m.filterInput.SetValue("")
+	m.filterMyOnly = false
+	m.filterLabel = ""
+	m.infoToast = "Cleared " + p.Name
+	m.applyFilter()
+	return nil
+}
+
+// renderQuickFilters renders the pinned presets as numbered chips, the
+// applied one highlighted
+func (m BoardModel) renderQuickFilters(width int) string {
+	chips := make([]string, 0, 9)
+	for i, p := range m.pinnedPresets() {
+		style := quickFilterStyle
+		if m.presetActive(p) {
+			style = activeQuickFilterStyle
+		}
+		chips = append(chips, style.Render(fmt.Sprintf("%d %s", i+1, p.Name)))
+	}
+	return truncateLine(dimStyle.Render("alt+")+strings.Join(chips, " "), width)
+}
+
+// presetSummary describes a preset's filters and sort in one line
+func presetSummary(p config.Preset) string {
+	var parts []string
+	if p.Filter != "" {
+		parts = append(parts, "/"+p.Filter)
+	}
+	if p.MyOnly {
+		parts = append(parts, "@me")
+	}
+	if p.Label != "" {
+		parts = append(parts, "label:"+p.Label)
+	}
+	if label := sortLabel(p.Sort); label != "" {
+		parts = append(parts, "sorted "+label)
+	}
+	if len(parts) == 0 {
+		return "no filters"
+	}
+	return strings.Join(parts, " · ")
+}
+
+// renderPresetMenu renders the saved presets with the selected one marked
+func (m BoardModel) renderPresetMenu(width int) string {
+	var s strings.Builder
+	s.WriteString(titleStyle.Render("Filter presets"))
+	s.WriteString("\n\n")
+	if len(m.presets) == 0 {
+		s.WriteString(dimStyle.Render("No presets yet"))
+		s.WriteString("\n")
+	}
+	for i, p := range m.presets {
+		cursor := "  "
+		if i == m.presetCursor {
+			cursor = "> "
+		}
+		num := " "
+		if i < 9 {
+			num = fmt.Sprint(i + 1)
+		}
+		line := fmt.Sprintf("%s%s %s  ", cursor, num, p.Name)
+		s.WriteString(line + dimStyle.Render(truncateLine(presetSummary(p), max(width-len(line)-10, 10))) + "\n")
+	}
+	s.WriteString("\n")
+	s.WriteString(dimStyle.Render("1-9/enter apply · s save current · esc close"))
+	return HelpOverlayStyle.Render(s.String())
+}
+
+// Message types for presets
+type (
+	presetSavedMsg struct{ preset config.Preset }
+	presetErrorMsg struct{ err error }
+)
@@ -13,6 +13,7 @@ import (
 // projectItem wraps a domain.Project for use in bubbles/list.
 type projectItem struct {
 	project domain.Project
+	repo    string // Repository the project is linked to, "" when not shown first for one
 }
 
 func (i projectItem) FilterValue() string {
@@ -24,6 +25,9 @@ func (i projectItem) Title() string {
 }
 
 func (i projectItem) Description() string {
+	if i.repo != "" {
+		return fmt.Sprintf("Owner: %s · linked to %s", i.project.Owner, i.repo)
+	}
 	return fmt.Sprintf("Owner: %s", i.project.Owner)
 }
 
@@ -61,9 +65,22 @@ type ProjectPickerModel struct {
 
 // NewProjectPickerModel creates a new ProjectPickerModel.
 func NewProjectPickerModel(projects []domain.Project) ProjectPickerModel {
-	items := make([]list.Item, len(projects))
-	for i, p := range projects {
-		items[i] = projectItem{project: p}
+	return newLinkedProjectPicker("", nil, projects)
+}
+
+// newLinkedProjectPicker lists the projects repo is linked to ahead of the
+// owner's other projects
+func newLinkedProjectPicker(repo string, linked, projects []domain.Project) ProjectPickerModel {
+	items := make([]list.Item, 0, len(linked)+len(projects))
+	seen := make(map[string]bool, len(linked))
+	for _, p := range linked {
+		items = append(items, projectItem{project: p, repo: repo})
+		seen[p.ID] = true
+	}
+	for _, p := range projects {
+		if !seen[p.ID] {
+			items = append(items, projectItem{project: p})
+		}
 	}
 
 	l := list.New(items, projectDelegate{}, 80, 20)
@@ -92,10 +109,17 @@ func (m ProjectPickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case tea.KeyMsg:
 		switch msg.String() {
-		case "q", "esc", "ctrl+c":
+		case "q", "ctrl+c":
 			return m, func() tea.Msg {
 				return QuitMsg{}
 			}
+		case "esc":
+			// Let esc clear an in-progress filter first
+			if !m.list.SettingFilter() && !m.list.IsFiltered() {
+				return m, func() tea.Msg {
+					return PickerCancelledMsg{}
+				}
+			}
 		case "enter":
 			// Get selected project
 			if item, ok := m.list.SelectedItem().(projectItem); ok {
@@ -0,0 +1,178 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/h0rv/ghp/internal/domain"
+)
+
+var (
+	passStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("34"))
+	failStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+	pendingStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("228"))
+)
+
+// Outcomes of a check or review
+const (
+	outcomeNone = iota // Neutral or skipped, decides nothing
+	outcomePass
+	outcomeFail
+	outcomePending // Still running, or waiting on someone
+)
+
+// checkOutcome classifies a check conclusion or review decision
+func checkOutcome(state string) int {
+	switch state {
+	case "SUCCESS", "APPROVED":
+		return outcomePass
+	case "FAILURE", "ERROR", "TIMED_OUT", "CANCELLED", "ACTION_REQUIRED", "STARTUP_FAILURE", "CHANGES_REQUESTED":
+		return outcomeFail
+	case "PENDING", "EXPECTED", "REVIEW_REQUIRED":
+		return outcomePending
+	}
+	return outcomeNone
+}
+
+// checkGlyph returns the symbol for a check or review state: ✓ passed,
+// ✗ failed, ● still running or waiting, "" for states that decide nothing
+func checkGlyph(state string) string {
+	switch checkOutcome(state) {
+	case outcomePass:
+		return passStyle.Render("✓")
+	case outcomeFail:
+		return failStyle.Render("✗")
+	case outcomePending:
+		return pendingStyle.Render("●")
+	}
+	return ""
+}
+
+// prBadge returns a PR card's compact status: draft, CI checks, review
+// ("rv" and its state), and ⚠ for merge conflicts. Empty for other cards.
+func prBadge(card *domain.Card) string {
+	pr := card.PR
+	if pr == nil || card.State != "OPEN" {
+		return ""
+	}
+	var parts []string
+	if pr.Draft {
+		parts = append(parts, dimStyle.Render("draft"))
+	}
+	if glyph := checkGlyph(pr.Checks); glyph != "" {
+		parts = append(parts, glyph)
+	}
+	if glyph := checkGlyph(pr.ReviewDecision); glyph != "" {
+		parts = append(parts, dimStyle.Render("rv")+glyph)
+	}
+	if pr.Mergeable == "CONFLICTING" {
+		parts = append(parts, failStyle.Render("⚠"))
+	}
+	return strings.Join(parts, " ")
+}
+
+// loadChecks creates a command to load the CI checks on the card's PR
+func (m DetailModel) loadChecks() tea.Cmd {
+	contentID := m.card.ContentID
+	return func() tea.Msg {
+		checks, err := m.client.GetChecks(m.ctx, contentID)
+		if err != nil {
+			return checksErrorMsg{contentID: contentID, err: err}
+		}
+		return checksLoadedMsg{contentID: contentID, checks: checks}
+	}
+}
+
+// renderPRStatus renders a pull request's review, merge, and check details
+// for the info panel
+func (m DetailModel) renderPRStatus(width int) string {
+	pr := m.card.PR
+	if pr == nil {
+		return ""
+	}
+	var b strings.Builder
+	line := func(label, value string) {
+		b.WriteString(detailLabelStyle.Render(label))
+		b.WriteString(detailValueStyle.Render(value))
+		b.WriteString("\n")
+	}
+
+	if pr.Draft {
+		line("Draft: ", "yes")
+	}
+	if pr.ReviewDecision != "" {
+		line("Review: ", checkGlyph(pr.ReviewDecision)+" "+humanize(pr.ReviewDecision))
+	}
+	switch pr.Mergeable {
+	case "CONFLICTING":
+		line("Merge: ", failStyle.Render("⚠")+" conflicts with base")
+	case "MERGEABLE":
+		line("Merge: ", "no conflicts")
+	}
+
+	switch {
+	case m.checksError != "":
+		b.WriteString(detailLabelStyle.Render("Checks: "))
+		b.WriteString(errorStyle.Render(truncateLine(m.checksError, width-8)))
+		b.WriteString("\n")
+	case !m.checksLoaded:
+		b.WriteString(detailLabelStyle.Render("Checks: "))
+		b.WriteString(loadingText(m.spinner, m.reducedMotion, "Loading…"))
+		b.WriteString("\n")
+	case len(m.checks) == 0:
+		line("Checks: ", "none")
+	default:
+		line("Checks: ", checksSummary(m.checks))
+		for _, check := range m.checks {
+			glyph := checkGlyph(check.State)
+			if glyph == "" {
+				glyph = dimStyle.Render("-")
+			}
+			b.WriteString("  " + glyph + " " + detailValueStyle.Render(truncateLine(check.Name, width-6)))
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// checksSummary counts checks by outcome, e.g. "3 passed, 1 failed"
+func checksSummary(checks []domain.Check) string {
+	counts := make([]int, outcomePending+1)
+	for _, check := range checks {
+		counts[checkOutcome(check.State)]++
+	}
+	var parts []string
+	for _, p := range []struct {
+		n    int
+		noun string
+	}{
+		{counts[outcomePass], "passed"},
+		{counts[outcomeFail], "failed"},
+		{counts[outcomePending], "pending"},
+		{counts[outcomeNone], "skipped"},
+	} {
+		if p.n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", p.n, p.noun))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// humanize turns an API constant like CHANGES_REQUESTED into "changes requested"
+func humanize(s string) string {
+	return strings.ToLower(strings.ReplaceAll(s, "_", " "))
+}
+
+// Message types for PR checks
+type (
+	checksLoadedMsg struct {
+		contentID string
+		checks    []domain.Check
+	}
+	checksErrorMsg struct {
+		contentID string
+		err       error
+	}
+)
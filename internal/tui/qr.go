@@ -0,0 +1,79 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// qrStyle draws the code dark on light whatever the terminal's colors, so
+// phone cameras can read it
+var qrStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("15")).
+	Background(lipgloss.Color("0"))
+
+// toggleQR shows or hides a QR code of the selected card's URL
+func (m *BoardModel) toggleQR() {
+	if m.qrCard != nil {
+		m.qrCard = nil
+		return
+	}
+	card := m.getSelectedCard()
+	if card == nil {
+		return
+	}
+	if card.URL == "" {
+		m.errorToast = "This item has no URL to share"
+		return
+	}
+	m.qrCard = card
+}
+
+// renderQRCode renders text as a QR code, two modules per terminal line
+// using half blocks; light modules are drawn, dark ones left blank
+func renderQRCode(text string) (string, error) {
+	code, err := qrcode.New(text, qrcode.Low)
+	if err != nil {
+		return "", err
+	}
+	bitmap := code.Bitmap() // true is a dark module; includes the quiet zone
+
+	var lines []string
+	for y := 0; y < len(bitmap); y += 2 {
+		var b strings.Builder
+		for x := range bitmap[y] {
+			top := !bitmap[y][x]
+			bottom := y+1 < len(bitmap) && !bitmap[y+1][x]
+			switch {
+			case top && bottom:
+				b.WriteString("█")
+			case top:
+				b.WriteString("▀")
+			case bottom:
+				b.WriteString("▄")
+			default:
+				b.WriteString(" ")
+			}
+		}
+		lines = append(lines, qrStyle.Render(b.String()))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// renderQR renders the QR code of the shared card, centered, with its URL
+func (m BoardModel) renderQR(width, height int) string {
+	card := m.qrCard
+	caption := fmt.Sprintf("%s\n%s\n%s", cardLabel(card), card.URL, dimStyle.Render(hintKey(m.keymap.ShareQR)+"/esc: close"))
+
+	code, err := renderQRCode(card.URL)
+	switch {
+	case err != nil:
+		code = errorStyle.Render(fmt.Sprintf("QR code: %v", err))
+	case lipgloss.Width(code) > width || lipgloss.Height(code)+lipgloss.Height(caption)+1 > height:
+		code = errorStyle.Render("Terminal too small for the QR code; enlarge it or zoom out")
+	}
+	content := lipgloss.JoinVertical(lipgloss.Center, code, "", caption)
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, content)
+}
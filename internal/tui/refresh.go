@@ -0,0 +1,117 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/h0rv/ghp/internal/domain"
+)
+
+// changedCardStyle highlights cards that changed in the last auto-refresh
+var changedCardStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("220"))
+
+// cardChange is how a card differs from the previous refresh
+type cardChange int
+
+const (
+	changeAdded   cardChange = iota + 1 // New to the board
+	changeMoved                         // In another column
+	changeUpdated                       // Title, assignees, labels, or state changed
+)
+
+// marker returns the card gutter mark for a change
+func (c cardChange) marker() string {
+	switch c {
+	case changeAdded:
+		return "+"
+	case changeMoved:
+		return "→"
+	}
+	return "~"
+}
+
+// cardMark returns a card's gutter mark: * when marked, else its change in
+// the last auto-refresh. changed reports whether the card is highlighted.
+func (m BoardModel) cardMark(itemID string) (mark string, changed bool) {
+	change, changed := m.changes[itemID]
+	switch {
+	case m.marked[itemID]:
+		return "*", changed
+	case changed:
+		return change.marker(), true
+	}
+	return " ", false
+}
+
+// autoRefreshTick schedules the next background refresh, nil when
+// auto-refresh is off
+func (m BoardModel) autoRefreshTick() tea.Cmd {
+	if m.autoRefresh <= 0 {
+		return nil
+	}
+	return tea.Tick(m.autoRefresh, func(time.Time) tea.Msg { return autoRefreshMsg{} })
+}
+
+// handleAutoRefresh fetches items in the background unless a load is
+// already running
+func (m BoardModel) handleAutoRefresh() (tea.Model, tea.Cmd) {
+	// Items fetched while local changes are in flight could undo them on screen
+	pending, _ := m.outbox.counts()
+	if m.loading || m.loadingMore || m.client == nil || pending > 0 {
+		return m, m.autoRefreshTick()
+	}
+	return m, func() tea.Msg {
+		batch, err := m.fetchAllItems()
+		return revalidatedMsg{batch: batch, err: err, auto: true}
+	}
+}
+
+// diffCards compares freshly fetched cards with the board's. Changes made in
+// this session are already in the store, so only other people's show up.
+func (m BoardModel) diffCards(fresh []*domain.Card) map[string]cardChange {
+	changes := make(map[string]cardChange)
+	for _, card := range fresh {
+		old, err := m.store.GetCard(card.ItemID)
+		switch {
+		case err != nil:
+			changes[card.ItemID] = changeAdded
+		case old.GroupOptionID != card.GroupOptionID:
+			changes[card.ItemID] = changeMoved
+		case old.Title != card.Title || old.State != card.State ||
+			!slices.Equal(old.Assignees, card.Assignees) ||
+			(card.Labels != nil && !slices.Equal(old.Labels, card.Labels)):
+			changes[card.ItemID] = changeUpdated
+		}
+	}
+	return changes
+}
+
+// changeSummary describes a refresh's changes for a toast
+func changeSummary(changes map[string]cardChange) string {
+	counts := make(map[cardChange]int)
+	for _, c := range changes {
+		counts[c]++
+	}
+	var parts []string
+	for _, k := range []struct {
+		change cardChange
+		label  string
+	}{{changeAdded, "new"}, {changeMoved, "moved"}, {changeUpdated, "updated"}} {
+		if n := counts[k.change]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, k.label))
+		}
+	}
+	noun := "items"
+	if len(changes) == 1 {
+		noun = "item"
+	}
+	return fmt.Sprintf("%d %s changed (%s)", len(changes), noun, strings.Join(parts, ", "))
+}
+
+// Message types for auto-refresh
+type autoRefreshMsg struct{}
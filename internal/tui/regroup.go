@@ -0,0 +1,35 @@
+package tui
+
+import "github.com/h0rv/ghp/internal/store"
+
+// toggleGroupField switches the board between its grouping field and the
+// alternate one, from the values already loaded rather than refetching
+func (m *BoardModel) toggleGroupField() {
+	current := m.store.GetGroupField()
+	if current == nil {
+		return
+	}
+	if m.otherGroupField == nil {
+		if m.altGroupField == "" {
+			m.infoToast = "No alternate grouping field; set --alt-group-field"
+			return
+		}
+		field, err := store.FindGroupField(m.store.GetFields(), m.altGroupField)
+		if err != nil {
+			m.errorToast = err.Error()
+			return
+		}
+		if field.ID == current.ID {
+			m.infoToast = "Already grouped by " + field.Name
+			return
+		}
+		m.otherGroupField = field
+	}
+
+	next := m.otherGroupField
+	m.otherGroupField = current
+	m.store.RegroupBy(next)
+	m.rebuildColumns()
+	m.applyFilter()
+	m.infoToast = "Grouped by " + next.Name
+}
@@ -0,0 +1,85 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/store"
+)
+
+// removedOptionKey is the column of cards whose grouping value is an option
+// the field no longer has, such as one deleted on GitHub
+const removedOptionKey = "_removed_option_"
+
+// removedOptionName is the removed-option column's header
+const removedOptionName = "(removed option)"
+
+// boardColumns returns the store's columns with cards in options the grouping
+// field doesn't have gathered under removedOptionKey, in project order
+func (m BoardModel) boardColumns() map[string][]string {
+	columns, err := m.store.GetColumns()
+	if err != nil {
+		return make(map[string][]string)
+	}
+	groupField := m.store.GetGroupField()
+	options := make(map[string]bool, len(groupField.Options))
+	for _, opt := range groupField.Options {
+		options[opt.ID] = true
+	}
+
+	var removed []string
+	for key, ids := range columns {
+		if key != store.NoStatusKey && !options[key] {
+			removed = append(removed, ids...)
+			delete(columns, key)
+		}
+	}
+	if len(removed) > 0 {
+		sort.Slice(removed, func(i, j int) bool { return m.store.Position(removed[i]) < m.store.Position(removed[j]) })
+		columns[removedOptionKey] = removed
+	}
+	return columns
+}
+
+// startRemap enters move mode for every card in the removed-option column
+func (m *BoardModel) startRemap() {
+	if len(m.columns) == 0 || m.columns[m.selectedColumn] != removedOptionKey {
+		m.errorToast = "M re-maps the cards in the " + removedOptionName + " column"
+		return
+	}
+	if len(m.filteredCards[removedOptionKey]) == 0 {
+		return
+	}
+	m.moveMode, m.remapAll, m.moveQuery = true, true, ""
+}
+
+// remapCards moves every card shown in the removed-option column to another
+// column, listing them for confirmation first when there are many
+func (m *BoardModel) remapCards(targetColID string) tea.Cmd {
+	ids := m.filteredCards[removedOptionKey]
+	if len(ids) > bulkConfirmThreshold {
+		title := fmt.Sprintf("Move %d cards from %s to %s", len(ids), removedOptionName, m.columnNames[targetColID])
+		m.confirmBulk(title, ids, func(m *BoardModel) tea.Cmd { return m.moveCards(ids, targetColID) })
+		return nil
+	}
+	return m.moveCards(ids, targetColID)
+}
+
+// moveCards moves cards to a column
+func (m *BoardModel) moveCards(ids []string, targetColID string) tea.Cmd {
+	cmds := make([]tea.Cmd, 0, len(ids))
+	for _, id := range ids {
+		if card, err := m.store.GetCard(id); err == nil {
+			cmds = append(cmds, m.moveCard(card, targetColID))
+		}
+	}
+	if len(cmds) == 1 {
+		m.infoToast = "Re-mapped 1 card to " + m.columnNames[targetColID]
+	} else {
+		m.infoToast = fmt.Sprintf("Re-mapped %d cards to %s", len(cmds), m.columnNames[targetColID])
+	}
+	m.rebuildColumns()
+	m.applyFilter()
+	return tea.Batch(cmds...)
+}
@@ -0,0 +1,98 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// reorderCard moves the selected card one place up (delta -1) or down (+1)
+// within its column and saves the project's new manual order
+func (m *BoardModel) reorderCard(delta int) tea.Cmd {
+	if len(m.columns) == 0 {
+		return nil
+	}
+	colID := m.columns[m.selectedColumn]
+	if m.ownColumnSortKey(colID) != "" {
+		m.errorToast = fmt.Sprintf("This column is sorted; press %s until it shows project order to reorder", hintKey(m.keymap.Sort))
+		return nil
+	}
+	if m.columnSortKey(colID) != "" {
+		m.errorToast = fmt.Sprintf("The board is sorted; press %s until it shows project order to reorder", hintKey(m.keymap.BoardSort))
+		return nil
+	}
+
+	cards := m.filteredCards[colID]
+	idx := m.selectedCard[colID]
+	target := idx + delta
+	if idx < 0 || idx >= len(cards) || target < 0 || target >= len(cards) {
+		return nil
+	}
+	itemID, otherID := cards[idx], cards[target]
+	card, err := m.store.GetCard(itemID)
+	project := m.store.GetProject()
+	if err != nil || project == nil {
+		return nil
+	}
+
+	// A second press before GitHub has the first position is dropped, so
+	// positions never race each other
+	key := "reorder:" + itemID
+	ctx, ok := m.guard.begin(m.ctx, key)
+	if !ok {
+		return nil
+	}
+
+	// The card goes just past its visible neighbour. With a filter active
+	// the neighbour may not be adjacent in project order, so only this card
+	// moves, the same way GitHub moves it.
+	beforeID := m.store.PrecedingInColumn(itemID)
+	afterID := otherID
+	if delta < 0 {
+		afterID = m.store.PrecedingInColumn(otherID)
+	}
+
+	// Optimistic update; the selection follows the card
+	apply := func() error { return m.store.MoveAfter(itemID, afterID) }
+	if err := apply(); err != nil {
+		m.guard.end(key)
+		return nil
+	}
+	m.rebuildColumns()
+	m.applyFilter()
+	m.selectedCard[colID] = target
+
+	return m.outbox.enqueue(ctx, &outboxEntry{
+		label: "Reorder " + cardLabel(card),
+		apply: apply,
+		send: func(ctx context.Context) error {
+			defer m.guard.end(key)
+			return m.client.UpdateItemPosition(ctx, project.ID, itemID, afterID)
+		},
+		done: func(err error) tea.Msg {
+			if err != nil {
+				return reorderErrorMsg{itemID: itemID, beforeID: beforeID, err: err}
+			}
+			return reorderedMsg{}
+		},
+	})
+}
+
+// Message types for manual reordering
+type (
+	reorderedMsg    struct{}
+	reorderErrorMsg struct {
+		itemID   string
+		beforeID string // The card it followed before the reorder
+		err      error
+	}
+)
+
+// rollbackReorder puts a refused reorder's card back where it was
+func (m *BoardModel) rollbackReorder(msg reorderErrorMsg) {
+	_ = m.store.MoveAfter(msg.itemID, msg.beforeID)
+	m.rebuildColumns()
+	m.applyFilter()
+	m.errorToast = fmt.Sprintf("Reorder failed: %v", msg.err)
+}
@@ -0,0 +1,250 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/session"
+	"github.com/h0rv/ghp/internal/store"
+)
+
+// maxReplayDelay caps the pause between frames so idle stretches don't stall playback
+const maxReplayDelay = 2 * time.Second
+
+// replayBannerStyle is the banner style for the replay status line
+var replayBannerStyle = lipgloss.NewStyle().
+	Background(lipgloss.Color("63")).
+	Foreground(lipgloss.Color("15")).
+	Padding(0, 1)
+
+// recordFrame writes the current board state to the session recorder.
+// Keystrokes are recorded only as "input"; their effect shows in the state.
+func (m BoardModel) recordFrame(msg tea.Msg) {
+	event := fmt.Sprintf("%T", msg)
+	switch msg := msg.(type) {
+	case spinner.TickMsg, cursor.BlinkMsg, tea.WindowSizeMsg, presenceTickMsg, autoRefreshMsg:
+		// Timers fire constantly and never change board state by themselves,
+		// so they skip copying and comparing every card
+		return
+	case tea.MouseMsg:
+		if msg.Action == tea.MouseActionMotion {
+			return
+		}
+		event = "input"
+	case tea.KeyMsg:
+		event = "input"
+	}
+
+	project := m.store.GetProject()
+	groupField := m.store.GetGroupField()
+	if project == nil || groupField == nil {
+		return
+	}
+
+	cards := m.store.GetAllCards()
+	sort.Slice(cards, func(i, j int) bool { return cards[i].ItemID < cards[j].ItemID })
+	frame := session.Frame{
+		At:             time.Now(),
+		Event:          event,
+		Viewer:         m.store.GetViewerLogin(),
+		Project:        *project,
+		GroupField:     *groupField,
+		Cards:          make([]domain.Card, 0, len(cards)),
+		SelectedColumn: m.selectedColumn,
+		Filter:         m.filterText,
+		MyOnly:         m.filterMyOnly,
+		Mode:           m.mode(),
+		Toast:          m.errorToast,
+	}
+	for _, card := range cards {
+		frame.Cards = append(frame.Cards, *card)
+	}
+	if card := m.getSelectedCard(); card != nil {
+		frame.SelectedItemID = card.ItemID
+	}
+
+	// Recording is best-effort and must never interrupt the session
+	_ = m.recorder.Record(frame)
+}
+
+// mode returns the name of the board's active modal state, or "" for normal
+func (m BoardModel) mode() string {
+	switch {
+	case m.showHelp:
+		return "help"
+	case m.moveMode:
+		return "move"
+	case m.filterMode:
+		return "filter"
+	case m.triageMode:
+		return "triage"
+	}
+	return ""
+}
+
+// applyFrame restores the board to the state captured in a recorded frame
+func (m *BoardModel) applyFrame(frame session.Frame) {
+	m.store.Reset()
+	m.store.SetViewerLogin(frame.Viewer)
+	m.store.SetProject(&frame.Project)
+	m.store.SetGroupField(&frame.GroupField)
+	cards := make([]*domain.Card, len(frame.Cards))
+	for i := range frame.Cards {
+		card := frame.Cards[i]
+		cards[i] = &card
+	}
+	m.store.UpsertCards(cards)
+
+	m.filterText = frame.Filter
+	m.filterInput.SetValue(frame.Filter)
+	m.filterMyOnly = frame.MyOnly
+	m.showHelp = frame.Mode == "help"
+	m.moveMode = frame.Mode == "move"
+	m.filterMode = frame.Mode == "filter"
+	m.triageMode = frame.Mode == "triage"
+	m.errorToast = frame.Toast
+	m.loading = false
+
+	m.rebuildColumns()
+	m.applyFilter()
+
+	if frame.SelectedColumn < len(m.columns) {
+		m.selectedColumn = frame.SelectedColumn
+	}
+	if len(m.columns) == 0 {
+		return
+	}
+	colID := m.columns[m.selectedColumn]
+	for i, itemID := range m.filteredCards[colID] {
+		if itemID == frame.SelectedItemID {
+			m.selectedCard[colID] = i
+			break
+		}
+	}
+	m.adjustColumnScroll()
+	m.adjustScroll(colID)
+}
+
+// ReplayModel plays back a recorded session using the regular board view.
+type ReplayModel struct {
+	board  BoardModel
+	frames []session.Frame
+	index  int
+	paused bool
+	speed  float64
+}
+
+// NewReplayModel creates a replay of frames at the given speed multiplier.
+func NewReplayModel(frames []session.Frame, speed float64) ReplayModel {
+	if speed <= 0 {
+		speed = 1
+	}
+	// Replays never talk to GitHub, so the board has no client
+	board := NewBoardModel(store.New(), nil, context.Background())
+	if len(frames) > 0 {
+		board.applyFrame(frames[0])
+	}
+	return ReplayModel{board: board, frames: frames, speed: speed}
+}
+
+// replayTickMsg advances playback to the frame at index
+type replayTickMsg struct{ index int }
+
+// Init starts playback
+func (m ReplayModel) Init() tea.Cmd {
+	return tea.Batch(tea.WindowSize(), m.scheduleNext())
+}
+
+// scheduleNext waits for the recorded gap before the next frame
+func (m ReplayModel) scheduleNext() tea.Cmd {
+	next := m.index + 1
+	if m.paused || next >= len(m.frames) {
+		return nil
+	}
+
+	delay := time.Duration(float64(m.frames[next].At.Sub(m.frames[m.index].At)) / m.speed)
+	if delay > maxReplayDelay {
+		delay = maxReplayDelay
+	}
+	return tea.Tick(delay, func(time.Time) tea.Msg { return replayTickMsg{index: next} })
+}
+
+// seek jumps to the frame at index
+func (m *ReplayModel) seek(index int) {
+	if index < 0 || index >= len(m.frames) {
+		return
+	}
+	m.index = index
+	m.board.applyFrame(m.frames[index])
+}
+
+// Update handles playback controls and timing
+func (m ReplayModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.board.width = msg.Width
+		m.board.height = msg.Height - 1 // Replay banner
+		return m, nil
+
+	case replayTickMsg:
+		// Ignore ticks scheduled before a pause or seek
+		if m.paused || msg.index != m.index+1 {
+			return m, nil
+		}
+		(&m).seek(msg.index)
+		return m, m.scheduleNext()
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "esc", "ctrl+c":
+			return m, tea.Quit
+		case " ":
+			m.paused = !m.paused
+			return m, m.scheduleNext()
+		case "l", "right", "n":
+			m.paused = true
+			(&m).seek(m.index + 1)
+		case "h", "left", "p":
+			m.paused = true
+			(&m).seek(m.index - 1)
+		case "g", "home":
+			m.paused = true
+			(&m).seek(0)
+		case "G", "end":
+			m.paused = true
+			(&m).seek(len(m.frames) - 1)
+		}
+	}
+
+	return m, nil
+}
+
+// View renders the board for the current frame with a playback banner
+func (m ReplayModel) View() string {
+	if len(m.frames) == 0 {
+		return "Empty recording"
+	}
+
+	frame := m.frames[m.index]
+	state := "playing"
+	if m.paused {
+		state = "paused"
+	} else if m.index == len(m.frames)-1 {
+		state = "finished"
+	}
+	banner := fmt.Sprintf("%s %d/%d %s  %s  space:pause h/l:step g/G:start/end q:quit",
+		replayBannerStyle.Render("REPLAY"),
+		m.index+1, len(m.frames),
+		frame.At.Format("15:04:05"),
+		dimStyle.Render(state+" · "+frame.Event),
+	)
+
+	return banner + "\n" + m.board.View()
+}
@@ -0,0 +1,139 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/muesli/reflow/wordwrap"
+)
+
+// maxQuoteLines limits how much of a comment a reply quotes
+const maxQuoteLines = 3
+
+var (
+	quoteStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("245")).
+			Italic(true)
+
+	selectedCommentStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("205")).
+				Bold(true)
+)
+
+// quoteExcerpt returns the start of body as markdown quote lines.
+// Lines that are already quotes are skipped so replies don't nest.
+func quoteExcerpt(body string) string {
+	var lines []string
+	truncated := false
+	for _, line := range strings.Split(strings.TrimSpace(body), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, ">") {
+			continue
+		}
+		if len(lines) == maxQuoteLines {
+			truncated = true
+			break
+		}
+		lines = append(lines, "> "+line)
+	}
+	if truncated {
+		lines[len(lines)-1] += " …"
+	}
+	return strings.Join(lines, "\n")
+}
+
+// replyText returns the composer prefill for a reply to c
+func replyText(c domain.Comment) string {
+	var b strings.Builder
+	if c.Author != "" {
+		b.WriteString("@" + c.Author + "\n")
+	}
+	if quote := quoteExcerpt(c.Body); quote != "" {
+		b.WriteString(quote + "\n")
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// renderCommentBody wraps a comment body, indenting quoted lines behind a bar
+func renderCommentBody(body string, width int) string {
+	var out []string
+	for _, line := range strings.Split(body, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, ">") {
+			out = append(out, commentBodyStyle.Render(wordwrap.String(line, width)))
+			continue
+		}
+
+		// Count nesting depth ("> > text" or ">> text")
+		depth := 0
+		for strings.HasPrefix(trimmed, ">") {
+			depth++
+			trimmed = strings.TrimSpace(strings.TrimPrefix(trimmed, ">"))
+		}
+		bar := strings.Repeat("│ ", depth)
+		for _, wrapped := range strings.Split(wordwrap.String(trimmed, max(width-len(bar), 10)), "\n") {
+			out = append(out, dimStyle.Render(bar)+quoteStyle.Render(wrapped))
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+// selectComment moves the comment selection by delta and scrolls it into view
+func (m *DetailModel) selectComment(delta int) {
+	if len(m.comments) == 0 {
+		return
+	}
+	m.selectedComment = max(0, min(m.selectedComment+delta, len(m.comments)-1))
+	m.updateViewportContent()
+	if m.selectedComment < len(m.commentOffsets) {
+		m.viewport.SetYOffset(m.commentOffsets[m.selectedComment])
+	}
+}
+
+// startReply opens the composer quoting the selected comment
+func (m *DetailModel) startReply() tea.Cmd {
+	if m.selectedComment >= len(m.comments) {
+		return nil
+	}
+	m.commentMode = true
+	m.commentInput.SetValue(replyText(m.comments[m.selectedComment]))
+	m.commentInput.CursorEnd()
+	m.commentInput.Focus()
+	m.errorMsg = ""
+	m.successMsg = ""
+	return textarea.Blink
+}
+
+// commentHeader renders the author line of a comment, marking the selected one
+func (m DetailModel) commentHeader(i int, c domain.Comment) string {
+	author := c.Author
+	if author == "" {
+		author = "(deleted)"
+	}
+	header := renderAuthor(author, c.AuthorIsBot) + " " + commentTimeStyle.Render(formatTimeAgo(c.CreatedAt))
+	if i == m.selectedComment && m.focus == commentsPane {
+		return selectedCommentStyle.Render("▸ ") + header
+	}
+	return header
+}
+
+// permalink returns the URL of the selected comment when the comments pane
+// has focus, and of the item itself otherwise
+func (m DetailModel) permalink() string {
+	if m.focus == commentsPane && m.selectedComment < len(m.comments) {
+		if url := m.comments[m.selectedComment].URL; url != "" {
+			return url
+		}
+	}
+	return m.card.URL
+}
+
+// commentCountLabel describes the selected comment position, e.g. "2/5"
+func (m DetailModel) commentCountLabel() string {
+	return fmt.Sprintf("%d/%d", m.selectedComment+1, len(m.comments))
+}
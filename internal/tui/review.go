@@ -0,0 +1,98 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/gh"
+)
+
+// reviewKeys are the detail view keys that start a review of a pull request
+var reviewKeys = map[string]string{
+	"a": gh.ReviewApprove,
+	"x": gh.ReviewRequestChanges,
+	"w": gh.ReviewComment,
+}
+
+// reviewVerb describes a review event for headers and messages
+func reviewVerb(event string) string {
+	switch event {
+	case gh.ReviewApprove:
+		return "Approve"
+	case gh.ReviewRequestChanges:
+		return "Request changes"
+	}
+	return "Review comment"
+}
+
+// reviewDone reports a submitted review
+func reviewDone(event string) string {
+	switch event {
+	case gh.ReviewApprove:
+		return "Approved"
+	case gh.ReviewRequestChanges:
+		return "Changes requested"
+	}
+	return "Review posted"
+}
+
+// startReview opens the comment input to write the body of a review
+func (m *DetailModel) startReview(event string) tea.Cmd {
+	if m.card.ContentType != domain.ContentTypePullRequest || m.card.ContentID == "" {
+		return nil
+	}
+	m.reviewEvent = event
+	m.commentMode = true
+	m.commentInput.Focus()
+	m.errorMsg = ""
+	m.successMsg = ""
+	return textarea.Blink
+}
+
+// submitInput posts what was written in the comment input, as a comment or,
+// while reviewing, as the review's body
+func (m *DetailModel) submitInput() tea.Cmd {
+	body := strings.TrimSpace(m.commentInput.Value())
+	if m.reviewEvent == "" {
+		return m.postComment(body)
+	}
+	if body == "" && m.reviewEvent != gh.ReviewApprove {
+		m.errorMsg = reviewVerb(m.reviewEvent) + " needs a comment"
+		return nil
+	}
+	return m.submitReview(m.reviewEvent, body)
+}
+
+// submitReview creates a command to submit a review of the card's PR.
+// Returns nil while a review of it is already being submitted.
+func (m DetailModel) submitReview(event, body string) tea.Cmd {
+	key := "review:" + m.card.ContentID
+	ctx, ok := m.guard.begin(m.ctx, key)
+	if !ok {
+		return nil
+	}
+	card := m.card
+	return m.enqueue(ctx, &outboxEntry{
+		label: fmt.Sprintf("%s %s", reviewVerb(event), cardLabel(card)),
+		send: func(ctx context.Context) error {
+			defer m.guard.end(key)
+			return m.client.AddPullRequestReview(ctx, card.ContentID, event, body)
+		},
+		done: func(err error) tea.Msg {
+			if err != nil {
+				return reviewErrorMsg{err: err}
+			}
+			return reviewSubmittedMsg{event: event}
+		},
+	})
+}
+
+// Message types for pull request reviews
+type (
+	reviewSubmittedMsg struct{ event string }
+	reviewErrorMsg     struct{ err error }
+)
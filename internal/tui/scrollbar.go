@@ -0,0 +1,53 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// scrollThumbStyle marks the visible part of a column on its scrollbar
+var scrollThumbStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("252"))
+
+// scrollbarRows returns the scrollbar for a list of total entries showing
+// visible of them from offset, one glyph per row of a track height rows tall.
+// The thumb is at least one row and reaches the ends only at the ends of the list.
+func scrollbarRows(total, offset, visible, height int) []string {
+	if height < 1 {
+		return nil
+	}
+	thumb := min(max(height*visible/max(total, 1), 1), height)
+	pos := 0
+	if hidden := total - visible; hidden > 0 && offset > 0 {
+		pos = max((height-thumb)*offset/hidden, 1)
+		if offset < hidden {
+			pos = min(pos, height-thumb-1)
+		}
+		pos = min(max(pos, 0), height-thumb)
+	}
+
+	rows := make([]string, height)
+	for i := range rows {
+		if i >= pos && i < pos+thumb {
+			rows[i] = scrollThumbStyle.Render("┃")
+		} else {
+			rows[i] = dimStyle.Render("│")
+		}
+	}
+	return rows
+}
+
+// withScrollbar lays a scrollbar along the right edge of a column's lines,
+// cutting or padding each to width-1 and adding rows to fill the track
+func withScrollbar(lines, bar []string, width int) []string {
+	out := make([]string, len(bar))
+	for i, glyph := range bar {
+		line := ""
+		if i < len(lines) {
+			line = ansi.Truncate(lines[i], width-1, "")
+		}
+		out[i] = line + strings.Repeat(" ", max(width-1-lipgloss.Width(line), 0)) + glyph
+	}
+	return out
+}
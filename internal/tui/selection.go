@@ -0,0 +1,80 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pkg/browser"
+)
+
+// openConfirmThreshold is the most browser tabs o opens without asking
+const openConfirmThreshold = 5
+
+// toggleMark adds the selected card to the multi-selection, or removes it
+func (m *BoardModel) toggleMark() {
+	card := m.getSelectedCard()
+	if card == nil {
+		return
+	}
+	if m.marked[card.ItemID] {
+		delete(m.marked, card.ItemID)
+	} else {
+		m.marked[card.ItemID] = true
+	}
+}
+
+// markedURLs returns the URLs of the selected cards in project order.
+// Drafts and other items without a URL are skipped.
+func (m BoardModel) markedURLs() []string {
+	var urls []string
+	for _, card := range m.store.GetAllCards() {
+		if m.marked[card.ItemID] && card.URL != "" {
+			urls = append(urls, card.URL)
+		}
+	}
+	return urls
+}
+
+// openMarked opens every selected card in the browser, asking first when
+// that would open more than openConfirmThreshold tabs
+func (m *BoardModel) openMarked() tea.Cmd {
+	urls := m.markedURLs()
+	if len(urls) == 0 {
+		m.errorToast = "No selected items have a URL"
+		return nil
+	}
+	if len(urls) > openConfirmThreshold {
+		m.openURLs = urls
+		return nil
+	}
+	return openURLs(urls)
+}
+
+// openURLs opens each URL in a browser tab
+func openURLs(urls []string) tea.Cmd {
+	return func() tea.Msg {
+		for _, url := range urls {
+			_ = browser.OpenURL(url)
+		}
+		return nil
+	}
+}
+
+// handleOpenConfirm handles keys while confirming opening many tabs
+func (m BoardModel) handleOpenConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y":
+		urls := m.openURLs
+		m.openURLs = nil
+		return m, openURLs(urls)
+	case "n", "N", "esc", "q":
+		m.openURLs = nil
+	}
+	return m, nil
+}
+
+// renderOpenBanner renders the confirmation line for opening many tabs
+func (m BoardModel) renderOpenBanner() string {
+	return moveModeStyle.Render("OPEN") +
+		fmt.Sprintf(" Open %d browser tabs? y:confirm n:cancel", len(m.openURLs))
+}
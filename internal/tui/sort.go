@@ -0,0 +1,274 @@
+package tui
+
+import (
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/config"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/uistate"
+)
+
+// fieldSortPrefix marks sort keys that order cards by a single-select field's option order
+const fieldSortPrefix = "field:"
+
+// sortKeys returns the sort keys the "s" key cycles through for a column.
+// "" is project order; a "-" prefix means newest first.
+// Every single-select field other than the grouping field is offered too.
+func (m BoardModel) sortKeys() []string {
+	keys := []string{"", "created", "-created", "updated", "-updated", "number", "title", "assignee"}
+	groupField := m.store.GetGroupField()
+	for _, f := range m.store.GetFields() {
+		if f.Type != domain.FieldTypeSingleSelect || (groupField != nil && f.Name == groupField.Name) {
+			continue
+		}
+		keys = append(keys, fieldSortPrefix+f.Name)
+	}
+	return keys
+}
+
+// boardSortKeys are the sort keys ctrl+s cycles through for the whole board
+var boardSortKeys = []string{"", "title", "number", "-updated", "assignee"}
+
+// sortLabel returns the short column header label for a sort key
+func sortLabel(key string) string {
+	switch {
+	case key == "":
+		return ""
+	case strings.HasPrefix(key, fieldSortPrefix):
+		return "by " + strings.TrimPrefix(key, fieldSortPrefix)
+	case strings.HasPrefix(key, "-"):
+		return "↓" + strings.TrimPrefix(key, "-")
+	}
+	return "↑" + key
+}
+
+// columnSortKey returns the sort key for a column, or "" for project order.
+// Columns without their own sort use the board's.
+func (m BoardModel) columnSortKey(colID string) string {
+	if own := m.ownColumnSortKey(colID); own != "" {
+		return own
+	}
+	if m.uiState == nil {
+		return ""
+	}
+	return m.uiState.BoardSort
+}
+
+// ownColumnSortKey returns the sort key set on a column with "s", ignoring
+// the board's
+func (m BoardModel) ownColumnSortKey(colID string) string {
+	groupField := m.store.GetGroupField()
+	if m.uiState == nil || groupField == nil {
+		return ""
+	}
+	return m.uiState.ColumnSort(groupField.Name, m.columnNames[colID])
+}
+
+// cycleColumnSort advances the selected column to its next sort key and saves it
+func (m *BoardModel) cycleColumnSort() tea.Cmd {
+	groupField := m.store.GetGroupField()
+	project := m.store.GetProject()
+	if len(m.columns) == 0 || groupField == nil || project == nil {
+		return nil
+	}
+	if m.uiState == nil {
+		m.uiState = &uistate.State{}
+	}
+
+	colID := m.columns[m.selectedColumn]
+	m.uiState.SetColumnSort(groupField.Name, m.columnNames[colID], nextSortKey(m.sortKeys(), m.ownColumnSortKey(colID)))
+	m.applyFilter()
+	return m.saveUIState()
+}
+
+// cycleBoardSort advances the sort used by columns without their own and saves it
+func (m *BoardModel) cycleBoardSort() tea.Cmd {
+	if m.store.GetProject() == nil {
+		return nil
+	}
+	if m.uiState == nil {
+		m.uiState = &uistate.State{}
+	}
+	m.uiState.BoardSort = nextSortKey(boardSortKeys, m.uiState.BoardSort)
+	if label := sortLabel(m.uiState.BoardSort); label != "" {
+		m.infoToast = "Board sorted " + label
+	} else {
+		m.infoToast = "Board in project order"
+	}
+	m.applyFilter()
+	return m.saveUIState()
+}
+
+// nextSortKey returns the key after current in keys, wrapping around
+func nextSortKey(keys []string, current string) string {
+	for i, k := range keys {
+		if k == current {
+			return keys[(i+1)%len(keys)]
+		}
+	}
+	return keys[0]
+}
+
+// saveUIState writes the board preferences in the background, from a copy
+// the board can keep changing
+func (m BoardModel) saveUIState() tea.Cmd {
+	project := m.store.GetProject()
+	state := m.uiState.Clone()
+	return func() tea.Msg {
+		if err := uistate.Save(project.Owner, project.Number, state); err != nil {
+			return uiStateErrorMsg{err: err}
+		}
+		return nil
+	}
+}
+
+// sortCardIDs orders ids by key. ids must already be in project order, which
+// breaks ties. Cards missing the sort value always go last.
+func (m BoardModel) sortCardIDs(ids []string, key string) {
+	if key == "" {
+		return
+	}
+	desc := strings.HasPrefix(key, "-")
+	key = strings.TrimPrefix(key, "-")
+
+	// Option positions for field sorts
+	var optionIndex map[string]int
+	fieldName := strings.TrimPrefix(key, fieldSortPrefix)
+	if strings.HasPrefix(key, fieldSortPrefix) {
+		optionIndex = make(map[string]int)
+		for _, f := range m.store.GetFields() {
+			if f.Name == fieldName {
+				for i, opt := range f.Options {
+					optionIndex[opt.ID] = i
+				}
+			}
+		}
+	}
+
+	// value returns a comparable rank for a card and whether it has one
+	value := func(card *domain.Card) (string, int, bool) {
+		switch {
+		case key == "created":
+			return card.CreatedAt, 0, card.CreatedAt != ""
+		case key == "updated":
+			return card.UpdatedAt, 0, card.UpdatedAt != ""
+		case key == "number":
+			return "", card.Number, card.Number != 0
+		case key == "title":
+			return strings.ToLower(card.Title), 0, true
+		case key == "assignee":
+			if len(card.Assignees) == 0 {
+				return "", 0, false
+			}
+			return strings.ToLower(card.Assignees[0]), 0, true
+		case optionIndex != nil:
+			idx, ok := optionIndex[card.FieldValues[fieldName]]
+			return "", idx, ok
+		}
+		return "", 0, false
+	}
+
+	cards := make(map[string]*domain.Card, len(ids))
+	for _, id := range ids {
+		if card, err := m.store.GetCard(id); err == nil {
+			cards[id] = card
+		}
+	}
+
+	sort.SliceStable(ids, func(i, j int) bool {
+		ca, cb := cards[ids[i]], cards[ids[j]]
+		if ca == nil || cb == nil {
+			return cb == nil && ca != nil
+		}
+		sa, na, oka := value(ca)
+		sb, nb, okb := value(cb)
+		if !oka || !okb {
+			return oka && !okb
+		}
+		if sa == sb && na == nb {
+			return false
+		}
+		less := sa < sb || (sa == sb && na < nb)
+		if desc {
+			return !less
+		}
+		return less
+	})
+}
+
+// needsDetails reports whether a sort key orders by creation time, which
+// item listings leave out
+func needsDetails(key string) bool {
+	return strings.TrimPrefix(key, "-") == "created"
+}
+
+// loadSortDetails fetches details for cards in columns sorted by creation that
+// don't have them yet, or for every card when stale cards are dimmed, a
+// label filter is set, or expanded cards show labels
+func (m *BoardModel) loadSortDetails() tea.Cmd {
+	if m.detailsLoading || m.client == nil {
+		return nil
+	}
+
+	// ContentID -> ItemID
+	missing := make(map[string]string)
+	for _, colID := range m.columns {
+		if !needsDetails(m.columnSortKey(colID)) && m.staleAfter == 0 && !m.filtersByLabel() && m.density() != densityExpanded {
+			continue
+		}
+		for _, id := range m.store.GetColumnCardIDs(colID) {
+			card, err := m.store.GetCard(id)
+			if err == nil && card.CreatedAt == "" && card.ContentID != "" {
+				missing[card.ContentID] = card.ItemID
+			}
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	m.detailsLoading = true
+	contentIDs := make([]string, 0, len(missing))
+	for contentID := range missing {
+		contentIDs = append(contentIDs, contentID)
+	}
+	return func() tea.Msg {
+		details, err := m.client.GetItemDetails(m.ctx, contentIDs)
+		byItem := make(map[string]domain.ItemDetails, len(details))
+		for contentID, d := range details {
+			byItem[missing[contentID]] = d
+		}
+		return sortDetailsLoadedMsg{details: byItem, err: err}
+	}
+}
+
+// loadUIState reads the saved board preferences for the current project
+func (m BoardModel) loadUIState() tea.Cmd {
+	project := m.store.GetProject()
+	if project == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		state, problems, err := uistate.Read(project.Owner, project.Number)
+		if err != nil {
+			return uiStateErrorMsg{err: err}
+		}
+		return uiStateLoadedMsg{state: state, problems: problems}
+	}
+}
+
+// Message types for UI state
+type (
+	uiStateLoadedMsg struct {
+		state    *uistate.State
+		problems config.Problems
+	}
+	uiStateErrorMsg struct{ err error }
+
+	sortDetailsLoadedMsg struct {
+		details map[string]domain.ItemDetails
+		err     error
+	}
+)
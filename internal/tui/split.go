@@ -0,0 +1,131 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/h0rv/ghp/internal/domain"
+)
+
+// typeSections are the sub-headers a split column lists its cards under
+var typeSections = []string{"Issues", "Pull requests", "Drafts", "Other"}
+
+// typeSection returns the index in typeSections a card is listed under
+func typeSection(card *domain.Card) int {
+	switch card.ContentType {
+	case domain.ContentTypeIssue:
+		return 0
+	case domain.ContentTypePullRequest:
+		return 1
+	case domain.ContentTypeDraftIssue:
+		return 2
+	}
+	return 3
+}
+
+// toggleTypeSplit turns the issue/PR/draft sections within columns on or off
+func (m *BoardModel) toggleTypeSplit() {
+	m.splitByType = !m.splitByType
+	if m.splitByType {
+		m.infoToast = "Columns split into issues, pull requests, and drafts"
+	} else {
+		m.infoToast = "Type split off"
+	}
+	m.applyFilter()
+}
+
+// groupByType reorders each filtered column section by section, keeping the
+// column's order within a section
+func (m *BoardModel) groupByType() {
+	if !m.splitByType {
+		return
+	}
+	section := func(id string) int {
+		if card, err := m.store.GetCard(id); err == nil {
+			return typeSection(card)
+		}
+		return len(typeSections) - 1
+	}
+	for _, ids := range m.filteredCards {
+		slices.SortStableFunc(ids, func(a, b string) int { return section(a) - section(b) })
+	}
+}
+
+// cardSections returns the section of each card in ids, and how many cards
+// each section holds
+func (m BoardModel) cardSections(ids []string) (sections []int, counts []int) {
+	sections = make([]int, len(ids))
+	counts = make([]int, len(typeSections))
+	for i, id := range ids {
+		sections[i] = len(typeSections) - 1
+		if card, err := m.store.GetCard(id); err == nil {
+			sections[i] = typeSection(card)
+		}
+		counts[sections[i]]++
+	}
+	return sections, counts
+}
+
+// startsSection reports whether the card at i, the first shown from start,
+// gets a sub-header above it
+func startsSection(sections []int, start, i int) bool {
+	return i == start || sections[i] != sections[i-1]
+}
+
+// sectionHeaders counts the sub-headers shown with cards start to end
+func sectionHeaders(sections []int, start, end int) int {
+	n := 0
+	for i := start; i < end; i++ {
+		if startsSection(sections, start, i) {
+			n++
+		}
+	}
+	return n
+}
+
+// sectionWindow picks the cards of a split column shown in slots lines,
+// starting at offset but scrolling further when the selected card (-1 for
+// none) would fall below the sub-headers. It returns the cards shown, from
+// start up to end, and the lines left for cards and sub-headers. Without
+// indicators (a scrollbar shows the position instead) "more" lines take none.
+func sectionWindow(sections []int, offset, selected, slots int, indicators bool) (start, end, avail int) {
+	for start = min(offset, max(len(sections)-1, 0)); ; start++ {
+		avail = slots
+		if start > 0 && indicators {
+			avail-- // ↑ more
+		}
+		for end = len(sections); end > start+1; end-- {
+			used := end - start + sectionHeaders(sections, start, end)
+			if end < len(sections) && indicators {
+				used++ // ↓ more
+			}
+			if used <= avail {
+				break
+			}
+		}
+		if end < len(sections) && indicators {
+			avail-- // ↓ more
+		}
+		if selected < end || start >= selected {
+			return start, end, avail
+		}
+	}
+}
+
+// sectionCount returns how many sections a column's filtered cards span,
+// the most sub-headers it can show at once
+func (m BoardModel) sectionCount(colID string) int {
+	_, counts := m.cardSections(m.filteredCards[colID])
+	n := 0
+	for _, c := range counts {
+		if c > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// renderSectionHeader renders a section's sub-header within a column
+func renderSectionHeader(section, count, width int) string {
+	return laneHeaderStyle.Render(truncateLine(fmt.Sprintf("─ %s (%d)", typeSections[section], count), width))
+}
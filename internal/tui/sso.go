@@ -0,0 +1,67 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/gh"
+	"github.com/pkg/browser"
+)
+
+// ssoURL returns the authorization URL when err is a SAML SSO failure
+func (m AppModel) ssoURL(err error) (string, bool) {
+	var sso *gh.SSORequiredError
+	if !errors.As(err, &sso) {
+		return "", false
+	}
+	return sso.AuthorizationURL(m.ownerLogin), true
+}
+
+// isSSOError reports whether err needs the token authorized for SAML SSO
+func isSSOError(err error) bool {
+	var sso *gh.SSORequiredError
+	return errors.As(err, &sso)
+}
+
+// reportError hands err to the app, which replaces the current screen with it
+func reportError(err error) tea.Cmd {
+	return func() tea.Msg { return ErrorMsg{Err: err} }
+}
+
+// handleSSOKey handles keys on the SSO authorization screen
+func (m AppModel) handleSSOKey(msg tea.KeyMsg, url string) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "o":
+		if url != "" {
+			_ = browser.OpenURL(url)
+		}
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+// renderSSOScreen explains how to authorize the token for an organization's SAML SSO
+func renderSSOScreen(url string) string {
+	var b strings.Builder
+	b.WriteString(TitleStyle.Render("SAML SSO authorization required"))
+	b.WriteString("\n")
+	b.WriteString("This organization enforces SAML single sign-on, and your token\n")
+	b.WriteString("has not been authorized for it.\n\n")
+	if url != "" {
+		b.WriteString("Authorize it at:\n\n  ")
+		b.WriteString(SelectedItemStyle.Render(url))
+		b.WriteString("\n\n")
+	} else {
+		b.WriteString("Authorize it from your token settings (Configure SSO).\n\n")
+	}
+	b.WriteString("With the GitHub CLI, 'gh auth refresh' authorizes it too. Then restart ghp.\n")
+
+	help := "[q] quit"
+	if url != "" {
+		help = "[o] open in browser  " + help
+	}
+	b.WriteString(HelpStyle.Render(help))
+	return b.String()
+}
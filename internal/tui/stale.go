@@ -0,0 +1,20 @@
+package tui
+
+import (
+	"time"
+
+	"github.com/h0rv/ghp/internal/domain"
+)
+
+// isStale reports whether a card hasn't been updated within the board's stale
+// threshold. Cards whose details haven't loaded yet are never stale.
+func (m BoardModel) isStale(card *domain.Card) bool {
+	if m.staleAfter <= 0 || card.UpdatedAt == "" {
+		return false
+	}
+	updated, err := time.Parse(time.RFC3339, card.UpdatedAt)
+	if err != nil {
+		return false
+	}
+	return time.Since(updated) > m.staleAfter
+}
@@ -0,0 +1,101 @@
+package tui
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/gh"
+)
+
+// changeState closes or reopens an issue or pull request, setting the card's
+// state right away; refusal says why the card can't change
+func changeState(ctx context.Context, guard *mutationGuard, enqueue func(context.Context, *outboxEntry) tea.Cmd, client *gh.Client, card *domain.Card, closing bool) (cmd tea.Cmd, refusal string) {
+	verb, want := "reopened", "OPEN"
+	if closing {
+		verb, want = "closed", "CLOSED"
+	}
+	switch {
+	case card.ContentID == "" || (card.ContentType != domain.ContentTypeIssue && card.ContentType != domain.ContentTypePullRequest):
+		return nil, "Only issues and pull requests can be " + verb
+	case card.State == "MERGED":
+		return nil, "Merged pull requests can't be " + verb
+	case card.State == want:
+		return nil, cardLabel(card) + " is already " + verb
+	}
+
+	key := "state:" + card.ContentID
+	ctx, ok := guard.begin(ctx, key)
+	if !ok {
+		return nil, ""
+	}
+
+	previous := card.State
+	apply := func() error {
+		card.State = want
+		return nil
+	}
+	_ = apply()
+
+	label := "Reopen " + cardLabel(card)
+	if closing {
+		label = "Close " + cardLabel(card)
+	}
+	pr := card.ContentType == domain.ContentTypePullRequest
+	return enqueue(ctx, &outboxEntry{
+		label: label,
+		apply: apply,
+		send: func(ctx context.Context) error {
+			defer guard.end(key)
+			switch {
+			case closing && pr:
+				return client.ClosePullRequest(ctx, card.ContentID)
+			case closing:
+				return client.CloseIssue(ctx, card.ContentID)
+			case pr:
+				return client.ReopenPullRequest(ctx, card.ContentID)
+			}
+			return client.ReopenIssue(ctx, card.ContentID)
+		},
+		done: func(err error) tea.Msg {
+			if err != nil {
+				return stateErrorMsg{card: card, previous: previous, err: err}
+			}
+			return stateChangedMsg{card: card}
+		},
+	}), ""
+}
+
+// stateToast describes a confirmed state change
+func stateToast(card *domain.Card) string {
+	if card.State == "CLOSED" {
+		return "Closed " + cardLabel(card)
+	}
+	return "Reopened " + cardLabel(card)
+}
+
+// setSelectedState closes or reopens the selected card
+func (m *BoardModel) setSelectedState(closing bool) tea.Cmd {
+	card := m.getSelectedCard()
+	if card == nil {
+		return nil
+	}
+	cmd, refusal := changeState(m.ctx, m.guard, m.outbox.enqueue, m.client, card, closing)
+	if refusal != "" {
+		m.errorToast = refusal
+		return nil
+	}
+	m.applyFilter()
+	return cmd
+}
+
+// Message types for closing and reopening
+type (
+	stateChangedMsg struct{ card *domain.Card }
+	// stateErrorMsg carries the state to restore
+	stateErrorMsg struct {
+		card     *domain.Card
+		previous string
+		err      error
+	}
+)
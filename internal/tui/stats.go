@@ -0,0 +1,235 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/h0rv/ghp/internal/cache"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/stats"
+)
+
+// toggleStats opens or closes the stats overlay.
+// Opening fetches PR metadata for the cards currently on the board
+// and reads the recorded snapshot history for cycle times.
+func (m *BoardModel) toggleStats() tea.Cmd {
+	m.showStats = !m.showStats
+	if !m.showStats {
+		return nil
+	}
+
+	var ids []string
+	for _, col := range m.statsColumns() {
+		for _, card := range col.Cards {
+			if card.ContentType == domain.ContentTypePullRequest && card.ContentID != "" {
+				ids = append(ids, card.ContentID)
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return m.loadHistory()
+	}
+
+	m.statsLoading = true
+	m.statsError = ""
+	return tea.Batch(m.loadPRMeta(ids), m.loadHistory())
+}
+
+// statsColumns returns the visible (filtered) board columns for stats computation
+func (m BoardModel) statsColumns() []stats.Column {
+	columns := make([]stats.Column, 0, len(m.columns))
+	for _, colID := range m.columns {
+		col := stats.Column{ID: colID, Name: m.columnNames[colID]}
+		for _, itemID := range m.filteredCards[colID] {
+			if card, err := m.store.GetCard(itemID); err == nil {
+				col.Cards = append(col.Cards, card)
+			}
+		}
+		columns = append(columns, col)
+	}
+	return columns
+}
+
+// renderStats renders the stats overlay content
+func (m BoardModel) renderStats(width int) string {
+	var b strings.Builder
+
+	b.WriteString(titleStyle.Render("Pull request stats"))
+	b.WriteString("\n\n")
+
+	if m.statsLoading {
+		b.WriteString(loadingText(m.spinner, m.reducedMotion, "Loading PR metadata…"))
+		return HelpOverlayStyle.Render(b.String())
+	}
+	if m.statsError != "" {
+		b.WriteString(errorStyle.Render("Error: " + m.statsError))
+		return HelpOverlayStyle.Render(b.String())
+	}
+
+	prStats := stats.PRStats(m.statsColumns(), m.prMeta, time.Now())
+
+	nameWidth := 12
+	for _, s := range prStats {
+		nameWidth = max(nameWidth, ansi.StringWidth(s.Name))
+	}
+	nameWidth = min(nameWidth, max(12, width-44))
+
+	row := func(name, prs, reviewed, approved, avgOpen string) string {
+		return fmt.Sprintf("%s %5s %9s %9s %9s", fitName(name, nameWidth), prs, reviewed, approved, avgOpen)
+	}
+
+	b.WriteString(dimStyle.Render(row("Column", "PRs", "Reviewed", "Approved", "Avg open")))
+	b.WriteString("\n")
+	total := 0
+	for _, s := range prStats {
+		total += s.PRs
+		if s.PRs == 0 {
+			b.WriteString(dimStyle.Render(row(s.Name, "0", "-", "-", "-")))
+		} else {
+			b.WriteString(row(
+				s.Name,
+				fmt.Sprintf("%d", s.PRs),
+				fmt.Sprintf("%.0f%%", s.ReviewCoverage()*100),
+				fmt.Sprintf("%d", s.Approved),
+				stats.FormatDuration(s.AvgOpen),
+			))
+		}
+		b.WriteString("\n")
+	}
+
+	if total == 0 {
+		b.WriteString("\n" + dimStyle.Render("No pull requests on the board"))
+	}
+
+	b.WriteString("\n\n")
+	b.WriteString(titleStyle.Render("Cycle time"))
+	b.WriteString("\n\n")
+	b.WriteString(m.renderCycleTimes(nameWidth))
+
+	b.WriteString("\n" + dimStyle.Render(hintKey(m.keymap.Stats)+"/esc to close"))
+
+	return HelpOverlayStyle.Render(b.String())
+}
+
+// renderCycleTimes renders the average time cards spend in each column,
+// computed from snapshots recorded on previous loads
+func (m BoardModel) renderCycleTimes(nameWidth int) string {
+	groupField := m.store.GetGroupField()
+	if groupField == nil {
+		return ""
+	}
+	cycle := stats.CycleTimes(m.history, groupField.ID)
+	if len(cycle) == 0 {
+		return dimStyle.Render("Not enough history yet; cycle times appear after cards change columns between loads") + "\n"
+	}
+
+	row := func(name, avg, exits string) string {
+		return fmt.Sprintf("%s %9s %7s", fitName(name, nameWidth), avg, exits)
+	}
+
+	var b strings.Builder
+	b.WriteString(dimStyle.Render(row("Column", "Avg time", "Moves")))
+	b.WriteString("\n")
+
+	// The slowest column is the likely bottleneck, so call it out
+	slowest := ""
+	for id, c := range cycle {
+		if slowest == "" || c.Avg > cycle[slowest].Avg {
+			slowest = id
+		}
+	}
+
+	for _, colID := range m.columns {
+		c, ok := cycle[colID]
+		name := m.columnNames[colID]
+		if !ok {
+			b.WriteString(dimStyle.Render(row(name, "-", "0")))
+		} else {
+			line := row(name, stats.FormatDuration(c.Avg), fmt.Sprintf("%d", c.Exits))
+			if colID == slowest && len(cycle) > 1 {
+				line = errorStyle.Render(line)
+			}
+			b.WriteString(line)
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// fitName truncates or pads a column name to width terminal cells, so names
+// with wide or multi-byte characters keep the table aligned
+func fitName(name string, width int) string {
+	name = ansi.Truncate(name, width, "…")
+	return name + strings.Repeat(" ", max(width-ansi.StringWidth(name), 0))
+}
+
+// recordHistory saves the fully loaded board to the cache and appends a
+// column snapshot to the project's history
+func (m BoardModel) recordHistory() tea.Cmd {
+	project := m.store.GetProject()
+	groupField := m.store.GetGroupField()
+	if project == nil || groupField == nil {
+		return nil
+	}
+
+	cards := m.store.GetAllCards()
+	entry := &cache.Entry{
+		FetchedAt:  time.Now(),
+		Viewer:     m.store.GetViewerLogin(),
+		Project:    *project,
+		GroupField: *groupField,
+		Fields:     m.store.GetFields(),
+		Cards:      make([]domain.Card, 0, len(cards)),
+	}
+	for _, card := range cards {
+		entry.Cards = append(entry.Cards, *card)
+	}
+
+	return func() tea.Msg {
+		// History is best-effort; failures only cost cycle time accuracy
+		_ = cache.Save(entry)
+		_ = cache.AppendHistory(project.Owner, project.Number, cache.NewSnapshot(entry))
+		return nil
+	}
+}
+
+// loadHistory reads the recorded snapshot history for the current project
+func (m BoardModel) loadHistory() tea.Cmd {
+	project := m.store.GetProject()
+	if project == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		history, err := cache.LoadHistory(project.Owner, project.Number)
+		if err != nil {
+			return nil
+		}
+		snapshots := make([]stats.Snapshot, len(history))
+		for i, s := range history {
+			snapshots[i] = stats.Snapshot(s)
+		}
+		return historyLoadedMsg{history: snapshots}
+	}
+}
+
+// loadPRMeta fetches review metadata for the given PR content IDs
+func (m BoardModel) loadPRMeta(ids []string) tea.Cmd {
+	return func() tea.Msg {
+		meta, err := m.client.GetPRMeta(m.ctx, ids)
+		if err != nil {
+			return prMetaErrorMsg{err: err}
+		}
+		return prMetaLoadedMsg{meta: meta}
+	}
+}
+
+// Message types for the stats overlay
+type (
+	prMetaLoadedMsg  struct{ meta map[string]domain.PRMeta }
+	prMetaErrorMsg   struct{ err error }
+	historyLoadedMsg struct{ history []stats.Snapshot }
+)
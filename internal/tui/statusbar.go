@@ -0,0 +1,168 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/h0rv/ghp/internal/domain"
+)
+
+// StatusSegments are the names of the header's status segments, in their
+// default order. ratelimit, synced, and iteration are off by default.
+var StatusSegments = []string{"loading", "items", "filters", "outbox", "presence", "selection", "kinds", "ratelimit", "synced", "iteration", "help"}
+
+// defaultStatusSegments is the header layout without a configured one
+var defaultStatusSegments = []string{"loading", "items", "filters", "outbox", "presence", "selection", "kinds", "help"}
+
+// statusSegment returns the header parts of one segment, none when it has
+// nothing to show
+func (m BoardModel) statusSegment(name string) []string {
+	var parts []string
+	switch name {
+	case "loading":
+		if label := m.cacheLabel(); label != "" {
+			parts = append(parts, label)
+		}
+		if m.loadingMore {
+			parts = append(parts, loadingText(m.spinner, m.reducedMotion, "loading…"))
+		}
+
+	case "items":
+		totalItems := 0
+		for _, cards := range m.filteredCards {
+			totalItems += len(cards)
+		}
+		parts = append(parts, fmt.Sprintf("%d items", totalItems))
+		if m.truncated {
+			parts = append(parts, "fetch limit reached [+]load rest")
+		}
+
+	case "filters":
+		if m.filterMyOnly {
+			parts = append(parts, "@me")
+		}
+		if m.teamSlug != "" {
+			parts = append(parts, "@team:"+m.teamSlug)
+		}
+		if m.hideBots {
+			parts = append(parts, "no bots")
+		}
+		if n := m.store.ExcludedCount(); n > 0 {
+			parts = append(parts, fmt.Sprintf("%d from hidden repos", n))
+		}
+		if m.triageMode {
+			parts = append(parts, "triage")
+		}
+		if m.sweepMode {
+			parts = append(parts, "sweep")
+		}
+		if m.filterText != "" {
+			parts = append(parts, fmt.Sprintf("/%s", m.filterText))
+		}
+		if m.filterLabel != "" {
+			parts = append(parts, "label:"+m.filterLabel)
+		}
+		if len(m.hiddenColumns) > 0 {
+			parts = append(parts, fmt.Sprintf("%d hidden", len(m.hiddenColumns)))
+		}
+
+	case "outbox":
+		if pending, failed := m.outbox.counts(); failed > 0 {
+			parts = append(parts, fmt.Sprintf("%d failed [Q]", failed))
+		} else if pending > 0 {
+			parts = append(parts, fmt.Sprintf("%d sending", pending))
+		}
+
+	case "presence":
+		if label := m.presenceLabel(); label != "" {
+			parts = append(parts, label)
+		}
+
+	case "selection":
+		if len(m.marked) > 0 {
+			parts = append(parts, fmt.Sprintf("%d selected [o]pen", len(m.marked)))
+		}
+
+	case "kinds":
+		kinds := m.countItemKinds()
+		if kinds.drafts > 0 {
+			parts = append(parts, fmt.Sprintf("%d draft", kinds.drafts))
+		}
+		if kinds.private > 0 {
+			parts = append(parts, fmt.Sprintf("%d private", kinds.private))
+		}
+		if kinds.restricted > 0 {
+			part := fmt.Sprintf("%d restricted", kinds.restricted)
+			if kinds.sso {
+				part += " (authorize SSO)"
+			}
+			parts = append(parts, part)
+		}
+
+	case "ratelimit":
+		if m.client == nil {
+			break
+		}
+		if rl, ok := m.client.RateLimit(); ok {
+			part := fmt.Sprintf("API %d/%d", rl.Remaining, rl.Limit)
+			if rl.Remaining < rl.Limit/10 && !rl.Reset.IsZero() {
+				part += " until " + rl.Reset.Format("15:04")
+			}
+			parts = append(parts, part)
+		}
+
+	case "synced":
+		if !m.syncedAt.IsZero() {
+			parts = append(parts, "synced "+formatTimeAgo(m.syncedAt.Format(time.RFC3339)))
+		}
+
+	case "iteration":
+		for _, f := range m.store.GetFields() {
+			if f.Type != domain.FieldTypeIteration {
+				continue
+			}
+			if title, ok := currentIteration(f, time.Now()); ok {
+				parts = append(parts, title)
+				break
+			}
+		}
+
+	case "help":
+		parts = append(parts, "[a]@me [?]help")
+	}
+	return parts
+}
+
+// statusLine joins the configured segments for the header's right side
+func (m BoardModel) statusLine() string {
+	segments := m.statusSegments
+	if len(segments) == 0 {
+		segments = defaultStatusSegments
+	}
+	var parts []string
+	for _, name := range segments {
+		parts = append(parts, m.statusSegment(name)...)
+	}
+	return strings.Join(parts, " | ")
+}
+
+// currentIteration returns the title of the iteration field's latest
+// iteration that has started by now. Iteration names carry their start date
+// as "Title (2006-01-02)".
+func currentIteration(f domain.FieldDef, now time.Time) (string, bool) {
+	title, found := "", false
+	var latest time.Time
+	for _, it := range f.Iterations {
+		open := strings.LastIndex(it.Name, " (")
+		if open < 0 {
+			continue
+		}
+		start, err := time.ParseInLocation(time.DateOnly, strings.TrimSuffix(it.Name[open+2:], ")"), now.Location())
+		if err != nil || start.After(now) || (found && start.Before(latest)) {
+			continue
+		}
+		title, latest, found = it.Name[:open], start, true
+	}
+	return title, found
+}
@@ -1,6 +1,11 @@
 package tui
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
 
 var (
 	// TitleStyle is used for screen titles.
@@ -33,3 +38,29 @@ var (
 			Foreground(lipgloss.Color("241")). // Dark gray
 			MarginTop(1)
 )
+
+// defaultAccent colors the selected column border when the project has no accent
+const defaultAccent = lipgloss.Color("205")
+
+// accent returns the configured color for the board's project
+func (m BoardModel) accent() (lipgloss.Color, bool) {
+	project := m.store.GetProject()
+	if project == nil {
+		return "", false
+	}
+	key := fmt.Sprintf("%s/%d", project.Owner, project.Number)
+	for name, color := range m.accents {
+		if strings.EqualFold(name, key) {
+			return lipgloss.Color(color), true
+		}
+	}
+	return "", false
+}
+
+// accentColor returns the board's accent, or the default one
+func (m BoardModel) accentColor() lipgloss.Color {
+	if color, ok := m.accent(); ok {
+		return color
+	}
+	return defaultAccent
+}
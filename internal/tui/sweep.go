@@ -0,0 +1,128 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/h0rv/ghp/internal/domain"
+)
+
+// defaultSweepAge is how long a Done item must sit untouched before a sweep
+// offers it, when the board has no stale threshold of its own
+const defaultSweepAge = 30 * 24 * time.Hour
+
+// sweepModeStyle is the banner style for sweep mode
+var sweepModeStyle = lipgloss.NewStyle().
+	Background(lipgloss.Color("30")).
+	Foreground(lipgloss.Color("15")).
+	Padding(0, 1)
+
+// sweepAge returns the age at which Done items are swept: the stale
+// threshold when one is set
+func (m BoardModel) sweepAge() time.Duration {
+	if m.staleAfter > 0 {
+		return m.staleAfter
+	}
+	return defaultSweepAge
+}
+
+// needsSweep reports whether a card in a Done column hasn't been updated for
+// the sweep age. Cards without an update time are left alone.
+func (m BoardModel) needsSweep(colID string, card *domain.Card) bool {
+	if !isDoneColumn(m.columnNames[colID]) || card.UpdatedAt == "" {
+		return false
+	}
+	updated, err := time.Parse(time.RFC3339, card.UpdatedAt)
+	return err == nil && time.Since(updated) > m.sweepAge()
+}
+
+// toggleSweep enters or leaves sweep mode. Entering focuses the Done column.
+func (m *BoardModel) toggleSweep() {
+	if m.sweepMode {
+		m.sweepMode = false
+		m.applyFilter()
+		return
+	}
+
+	done := -1
+	for i, colID := range m.columns {
+		if isDoneColumn(m.columnNames[colID]) {
+			done = i
+			break
+		}
+	}
+	if done < 0 {
+		m.errorToast = "Sweep needs a column named Done"
+		return
+	}
+
+	m.sweepMode = true
+	m.applyFilter()
+	if len(m.filteredCards[m.columns[done]]) == 0 {
+		m.sweepMode = false
+		m.applyFilter()
+		m.infoToast = fmt.Sprintf("No Done items older than %d days", int(m.sweepAge().Hours()/24))
+		return
+	}
+	m.selectedColumn = done
+	m.adjustColumnScroll()
+}
+
+// handleSweepMode handles key presses in sweep mode
+func (m BoardModel) handleSweepMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "w":
+		(&m).toggleSweep()
+	case "q":
+		return m, tea.Quit
+	case "j", "down", "s":
+		(&m).skipSweepItem()
+	case "k", "up":
+		(&m).moveCardSelection(-1)
+	case "a":
+		if card := m.getSelectedCard(); card != nil {
+			return m, m.archiveItems([]string{card.ItemID})
+		}
+	case "c":
+		card := m.getSelectedCard()
+		if card == nil {
+			return m, nil
+		}
+		if isFinished(card) {
+			m.errorToast = cardLabel(card) + " is already closed; a to archive, s to skip"
+			return m, nil
+		}
+		cmd := (&m).setSelectedState(true)
+		if cmd != nil {
+			(&m).skipSweepItem()
+		}
+		return m, cmd
+	case "enter":
+		if card := m.getSelectedCard(); card != nil {
+			summary := m.summaryStrip()
+			return m, func() tea.Msg { return openDetailMsg{card: card, summary: summary} }
+		}
+	}
+	return m, nil
+}
+
+// skipSweepItem moves to the next item, saying so when the sweep is through
+func (m *BoardModel) skipSweepItem() {
+	colID := m.columns[m.selectedColumn]
+	if m.selectedCard[colID] >= len(m.filteredCards[colID])-1 {
+		m.infoToast = "End of sweep; esc to leave"
+		return
+	}
+	m.moveCardSelection(1)
+}
+
+// renderSweepBanner renders the sweep mode hint line
+func (m BoardModel) renderSweepBanner() string {
+	colID := m.columns[m.selectedColumn]
+	return sweepModeStyle.Render("SWEEP") +
+		fmt.Sprintf(" %d/%d Done items untouched for %d days · a:archive c:close s:skip enter:view esc:exit",
+			min(m.selectedCard[colID]+1, len(m.filteredCards[colID])), len(m.filteredCards[colID]),
+			int(m.sweepAge().Hours()/24))
+}
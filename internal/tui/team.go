@@ -0,0 +1,93 @@
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// parseTeam splits a team reference into organization and slug.
+// "org/slug" names the organization explicitly; a bare slug uses defaultOrg.
+func parseTeam(team, defaultOrg string) (org, slug string) {
+	if org, slug, ok := strings.Cut(team, "/"); ok {
+		return org, slug
+	}
+	return defaultOrg, team
+}
+
+// assignedToTeam reports whether any assignee is a member of the loaded team
+func (m BoardModel) assignedToTeam(assignees []string) bool {
+	for _, a := range assignees {
+		if m.teamMembers[strings.ToLower(a)] {
+			return true
+		}
+	}
+	return false
+}
+
+// handleTeamPrompt handles key presses while typing a team slug
+func (m BoardModel) handleTeamPrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		m.teamMode = false
+		team := strings.TrimSpace(m.teamInput.Value())
+		if team == "" {
+			// An empty slug clears the team filter
+			m.teamSlug = ""
+			m.teamMembers = nil
+			(&m).applyFilter()
+			return m, nil
+		}
+		return m, m.setTeam(team)
+	case "esc":
+		m.teamMode = false
+		m.teamInput.SetValue(m.teamSlug)
+		return m, nil
+	default:
+		var cmd tea.Cmd
+		m.teamInput, cmd = m.teamInput.Update(msg)
+		return m, cmd
+	}
+}
+
+// setTeam records the team filter and starts loading its members.
+// Cards stay unfiltered by team until the member list arrives.
+func (m *BoardModel) setTeam(team string) tea.Cmd {
+	m.teamSlug = team
+	m.teamMembers = nil
+	m.teamInput.SetValue(team)
+	return m.loadTeamMembers(team)
+}
+
+// loadTeamMembers fetches the members of a team in the project owner's organization
+func (m BoardModel) loadTeamMembers(team string) tea.Cmd {
+	project := m.store.GetProject()
+	if project == nil {
+		return nil
+	}
+	org, slug := parseTeam(team, project.Owner)
+
+	return func() tea.Msg {
+		logins, err := m.client.GetTeamMembers(m.ctx, org, slug)
+		if err != nil {
+			return teamErrorMsg{team: team, err: err}
+		}
+		members := make(map[string]bool, len(logins))
+		for _, login := range logins {
+			members[strings.ToLower(login)] = true
+		}
+		return teamMembersLoadedMsg{team: team, members: members}
+	}
+}
+
+// Message types for the team filter
+type (
+	teamMembersLoadedMsg struct {
+		team    string
+		members map[string]bool // Lowercased logins
+	}
+	teamErrorMsg struct {
+		team string
+		err  error
+	}
+)
@@ -0,0 +1,107 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/domain"
+)
+
+// threadGutter marks the lines of a review thread in the comments pane
+var threadGutter = dimStyle.Render("│ ")
+
+// loadOlderComments fetches the page of comments before the loaded ones
+func (m *DetailModel) loadOlderComments() tea.Cmd {
+	if m.olderCursor == "" {
+		m.errorMsg, m.successMsg = "All comments are loaded", ""
+		return nil
+	}
+	if m.loadingOlder || m.client == nil {
+		return nil
+	}
+	owner, repo, ok := strings.Cut(m.card.Repo, "/")
+	if !ok {
+		return nil
+	}
+	m.loadingOlder = true
+	m.errorMsg, m.successMsg = "", ""
+	client, ctx, number, cursor := m.client, m.ctx, m.card.Number, m.olderCursor
+	return func() tea.Msg {
+		page, err := client.GetComments(ctx, owner, repo, number, cursor)
+		if err != nil {
+			return olderCommentsErrorMsg{err: err}
+		}
+		return olderCommentsLoadedMsg{cursor: cursor, comments: page.Comments, olderCursor: page.OlderCursor}
+	}
+}
+
+// prependComments adds a page of older comments above the loaded ones,
+// keeping the same comment selected
+func (m *DetailModel) prependComments(msg olderCommentsLoadedMsg) {
+	m.loadingOlder = false
+	if msg.cursor != m.olderCursor {
+		// The comments were reloaded while the page was on its way
+		return
+	}
+	m.comments = append(append([]domain.Comment{}, msg.comments...), m.comments...)
+	m.olderCursor = msg.olderCursor
+	m.selectedComment += len(msg.comments)
+	m.successMsg = fmt.Sprintf("Loaded %d older comments", len(msg.comments))
+	m.updateViewportContent()
+}
+
+// renderReviewThreads renders a pull request's review threads below the
+// conversation, each thread's comments nested behind a gutter
+func (m *DetailModel) renderReviewThreads(width int) string {
+	var b strings.Builder
+	b.WriteString(detailLabelStyle.Render(fmt.Sprintf("── Review threads (%d) ──", len(m.threads))))
+	for _, t := range m.threads {
+		b.WriteString("\n\n")
+		location := t.Path
+		if t.Line > 0 {
+			location = fmt.Sprintf("%s:%d", t.Path, t.Line)
+		}
+		b.WriteString(detailValueStyle.Render(location))
+		if tags := threadTags(t); tags != "" {
+			b.WriteString(dimStyle.Render(" · " + tags))
+		}
+		for i, c := range t.Comments {
+			b.WriteString("\n" + threadGutter)
+			if i > 0 {
+				b.WriteString("\n" + threadGutter + dimStyle.Render("↳ "))
+			}
+			author := c.Author
+			if author == "" {
+				author = "(deleted)"
+			}
+			b.WriteString(renderAuthor(author, c.AuthorIsBot) + " " + commentTimeStyle.Render(formatTimeAgo(c.CreatedAt)))
+			for _, line := range strings.Split(m.renderText(m.commentMarkdown, c.Body, max(width-2, 10)), "\n") {
+				b.WriteString("\n" + threadGutter + line)
+			}
+		}
+	}
+	return b.String()
+}
+
+// threadTags describes a review thread's state, e.g. "resolved · outdated"
+func threadTags(t domain.ReviewThread) string {
+	var tags []string
+	if t.IsResolved {
+		tags = append(tags, "resolved")
+	}
+	if t.IsOutdated {
+		tags = append(tags, "outdated")
+	}
+	return strings.Join(tags, " · ")
+}
+
+// Message types for older comment pages
+type (
+	olderCommentsLoadedMsg struct {
+		cursor      string // The cursor the page was fetched before
+		comments    []domain.Comment
+		olderCursor string
+	}
+	olderCommentsErrorMsg struct{ err error }
+)
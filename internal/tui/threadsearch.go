@@ -0,0 +1,170 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// searchHitStyle marks text matching the detail view's search
+var searchHitStyle = lipgloss.NewStyle().Background(lipgloss.Color("220")).Foreground(lipgloss.Color("0"))
+
+// threadHit is a line of the body or comments pane matching the search
+type threadHit struct {
+	pane detailPane
+	line int
+}
+
+// handleSearchKey handles keys while typing a search in the detail view
+func (m DetailModel) handleSearchKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		m.searchMode = false
+		m.searchInput.Blur()
+		m.searchQuery = strings.TrimSpace(m.searchInput.Value())
+		m.updateBodyContent()
+		m.updateViewportContent()
+		if m.searchQuery != "" {
+			m.jumpToFirstHit()
+		}
+		return m, nil
+	case "esc":
+		m.searchMode = false
+		m.searchInput.Blur()
+		m.searchInput.SetValue(m.searchQuery)
+		return m, nil
+	default:
+		var cmd tea.Cmd
+		m.searchInput, cmd = m.searchInput.Update(msg)
+		return m, cmd
+	}
+}
+
+// clearSearch drops the search and its highlights
+func (m *DetailModel) clearSearch() {
+	m.searchQuery = ""
+	m.searchInput.SetValue("")
+	m.updateBodyContent()
+	m.updateViewportContent()
+}
+
+// threadHits returns the matching lines of the body, then the comments
+func (m DetailModel) threadHits() []threadHit {
+	hits := make([]threadHit, 0, len(m.bodyHits)+len(m.commentHits))
+	for _, line := range m.bodyHits {
+		hits = append(hits, threadHit{pane: bodyPane, line: line})
+	}
+	for _, line := range m.commentHits {
+		hits = append(hits, threadHit{pane: commentsPane, line: line})
+	}
+	return hits
+}
+
+// jumpToFirstHit shows the first match at or below the top of the focused
+// pane, or the first match anywhere
+func (m *DetailModel) jumpToFirstHit() {
+	hits := m.threadHits()
+	if len(hits) == 0 {
+		m.errorMsg, m.successMsg = fmt.Sprintf("No matches for %q", m.searchQuery), ""
+		return
+	}
+	m.hitIndex = 0
+	top := m.focusedView().YOffset
+	for i, hit := range hits {
+		if hit.pane == m.focus && hit.line >= top {
+			m.hitIndex = i
+			break
+		}
+	}
+	m.showHit()
+}
+
+// nextHit moves to the next match, or with delta -1 the previous, wrapping
+func (m *DetailModel) nextHit(delta int) {
+	hits := m.threadHits()
+	if len(hits) == 0 {
+		return
+	}
+	m.hitIndex = ((m.hitIndex+delta)%len(hits) + len(hits)) % len(hits)
+	m.showHit()
+}
+
+// showHit focuses the current match's pane and scrolls it into view a third
+// of the way down
+func (m *DetailModel) showHit() {
+	hits := m.threadHits()
+	if m.hitIndex >= len(hits) {
+		return
+	}
+	hit := hits[m.hitIndex]
+	if m.focus != hit.pane {
+		m.focus = hit.pane
+		m.updateViewportContent()
+	}
+	vp := m.focusedView()
+	vp.SetYOffset(max(hit.line-vp.Height/3, 0))
+	m.errorMsg, m.successMsg = "", ""
+}
+
+// searchStatus describes the search and the current match for the footer
+func (m DetailModel) searchStatus() string {
+	hits := len(m.bodyHits) + len(m.commentHits)
+	if hits == 0 {
+		return fmt.Sprintf("/%s: no matches", m.searchQuery)
+	}
+	return fmt.Sprintf("/%s %d/%d [n/N]", m.searchQuery, m.hitIndex+1, hits)
+}
+
+// highlightHits highlights query in content, ignoring case, and returns the
+// numbers of the lines it is on
+func highlightHits(content, query string) (string, []int) {
+	if query == "" {
+		return content, nil
+	}
+	q := []rune(query)
+	for i, r := range q {
+		q[i] = unicode.ToLower(r)
+	}
+	var hits []int
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		if highlighted, ok := highlightLine(line, q); ok {
+			lines[i] = highlighted
+			hits = append(hits, i)
+		}
+	}
+	return strings.Join(lines, "\n"), hits
+}
+
+// highlightLine highlights each occurrence of the lowercased q in a styled
+// line, keeping the styles around it
+func highlightLine(line string, q []rune) (string, bool) {
+	plain := []rune(ansi.Strip(line))
+	lower := make([]rune, len(plain))
+	for i, r := range plain {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	var out strings.Builder
+	cell, found := 0, false
+	for i := 0; i+len(q) <= len(lower); i++ {
+		if string(lower[i:i+len(q)]) != string(q) {
+			continue
+		}
+		start := ansi.StringWidth(string(plain[:i]))
+		out.WriteString(ansi.Cut(line, cell, start))
+		out.WriteString(searchHitStyle.Render(string(plain[i : i+len(q)])))
+		cell = start + ansi.StringWidth(string(plain[i:i+len(q)]))
+		i += len(q) - 1
+		found = true
+	}
+	if !found {
+		return line, false
+	}
+	out.WriteString(ansi.TruncateLeft(line, cell, ""))
+	return out.String(), true
+}
@@ -0,0 +1,31 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+)
+
+// windowTitle returns the terminal title for the board, e.g.
+// "ghp: Roadmap (3 assigned to me)", or "" before a project is loaded
+func (m BoardModel) windowTitle() string {
+	project := m.store.GetProject()
+	if project == nil {
+		return ""
+	}
+	title := "ghp: " + project.Title
+
+	viewer := m.store.GetViewerLogin()
+	if viewer == "" {
+		return title
+	}
+	mine := 0
+	for _, card := range m.store.GetAllCards() {
+		for _, assignee := range card.Assignees {
+			if strings.EqualFold(assignee, viewer) {
+				mine++
+				break
+			}
+		}
+	}
+	return fmt.Sprintf("%s (%d assigned to me)", title, mine)
+}
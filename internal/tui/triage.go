@@ -0,0 +1,165 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/gh"
+	"github.com/h0rv/ghp/internal/store"
+)
+
+// triageModeStyle is the banner style for triage mode
+var triageModeStyle = lipgloss.NewStyle().
+	Background(lipgloss.Color("214")).
+	Foreground(lipgloss.Color("0")).
+	Padding(0, 1)
+
+// needsTriage reports whether a card has neither an assignee nor a status
+func needsTriage(card *domain.Card) bool {
+	return len(card.Assignees) == 0 && card.GroupOptionID == ""
+}
+
+// toggleTriage enters or leaves triage mode.
+// Entering focuses the "No Status" column, where all untriaged cards live.
+func (m *BoardModel) toggleTriage() {
+	m.triageMode = !m.triageMode
+	m.applyFilter()
+
+	if m.triageMode {
+		for i, colID := range m.columns {
+			if colID == store.NoStatusKey {
+				m.selectedColumn = i
+				m.adjustColumnScroll()
+				break
+			}
+		}
+	}
+}
+
+// handleTriageMode handles key presses in triage mode
+func (m BoardModel) handleTriageMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.triageLabelMode {
+		switch msg.String() {
+		case "enter":
+			m.triageLabelMode = false
+			label := strings.TrimSpace(m.triageLabelInput.Value())
+			m.triageLabelInput.SetValue("")
+			card := m.getSelectedCard()
+			if label == "" || card == nil {
+				return m, nil
+			}
+			return m, m.triageLabel(card, label)
+		case "esc":
+			m.triageLabelMode = false
+			m.triageLabelInput.SetValue("")
+			return m, nil
+		default:
+			var cmd tea.Cmd
+			m.triageLabelInput, cmd = m.triageLabelInput.Update(msg)
+			return m, cmd
+		}
+	}
+
+	switch msg.String() {
+	case "esc", "t":
+		(&m).toggleTriage()
+	case "q":
+		return m, tea.Quit
+	case "j", "down", "s":
+		(&m).moveCardSelection(1)
+	case "k", "up":
+		(&m).moveCardSelection(-1)
+	case "a":
+		card := m.getSelectedCard()
+		login := m.store.GetViewerLogin()
+		if card != nil && login != "" && card.ContentID != "" && card.ContentType != domain.ContentTypeDraftIssue {
+			return m, m.triageAssign(card, login)
+		}
+	case "l":
+		card := m.getSelectedCard()
+		if card != nil && card.Repo != "" {
+			m.triageLabelMode = true
+			m.triageLabelInput.Focus()
+		}
+	case "enter":
+		card := m.getSelectedCard()
+		if card != nil {
+			summary := m.summaryStrip()
+			return m, func() tea.Msg { return openDetailMsg{card: card, summary: summary} }
+		}
+	case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+		idx := int(msg.Runes[0] - '1')
+		if idx >= 0 && idx < len(m.columns) {
+			return m, (&m).moveCardToColumn(m.columns[idx])
+		}
+	}
+
+	return m, nil
+}
+
+// renderTriageBanner renders the triage mode hint line
+func (m BoardModel) renderTriageBanner() string {
+	if m.triageLabelMode {
+		return m.triageLabelInput.View()
+	}
+	return triageModeStyle.Render("TRIAGE") + " a:assign me 1-9:status l:label s:skip enter:view esc:exit"
+}
+
+// triageAssign assigns the viewer to the card
+func (m BoardModel) triageAssign(card *domain.Card, login string) tea.Cmd {
+	key := "assign:" + card.ItemID
+	ctx, ok := m.guard.begin(m.ctx, key)
+	if !ok {
+		return nil
+	}
+	return m.outbox.enqueue(ctx, &outboxEntry{
+		label: fmt.Sprintf("Assign %s to %s", login, cardLabel(card)),
+		send: func(ctx context.Context) error {
+			defer m.guard.end(key)
+			return m.client.AddAssignee(ctx, card.ContentID, login)
+		},
+		done: func(err error) tea.Msg {
+			if err != nil {
+				return triageErrorMsg{err: err}
+			}
+			return triageAssignedMsg{card: card, login: login}
+		},
+	})
+}
+
+// triageLabel adds a label to the card
+func (m BoardModel) triageLabel(card *domain.Card, label string) tea.Cmd {
+	return func() tea.Msg {
+		labelIDs, err := m.client.GetLabelIDs(m.ctx, []string{card.Repo}, label)
+		if err != nil {
+			return triageErrorMsg{err: err}
+		}
+		labelID, ok := labelIDs[card.Repo]
+		if !ok {
+			return triageErrorMsg{err: fmt.Errorf("label '%s' not found in %s", label, card.Repo)}
+		}
+
+		err = m.client.AddLabels(m.ctx, []gh.LabelChange{{ContentID: card.ContentID, LabelID: labelID}})
+		if err != nil {
+			return triageErrorMsg{err: err}
+		}
+		return triageLabeledMsg{card: card, label: label}
+	}
+}
+
+// Message types for triage actions
+type (
+	triageErrorMsg    struct{ err error }
+	triageAssignedMsg struct {
+		card  *domain.Card
+		login string
+	}
+	triageLabeledMsg struct {
+		card  *domain.Card
+		label string
+	}
+)
@@ -0,0 +1,133 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/h0rv/ghp/internal/gh"
+	"github.com/h0rv/ghp/internal/store"
+)
+
+// undo reverts the last move, field edit, or archive (with redo, re-applies
+// the last undone one) on the board and sends the change to GitHub
+func (m *BoardModel) undo(redo bool) tea.Cmd {
+	step, verb := m.store.UndoLastAction, "Undo"
+	if redo {
+		step, verb = m.store.RedoLastAction, "Redo"
+	}
+	action, err := step()
+	if err != nil {
+		m.infoToast = "Nothing to undo"
+		if errors.Is(err, store.ErrNothingToRedo) {
+			m.infoToast = "Nothing to redo"
+		}
+		return nil
+	}
+
+	// Each step has its own key, so pressing U twice undoes two steps
+	key := fmt.Sprintf("%s:%d", strings.ToLower(verb), action.ID)
+	ctx, ok := m.guard.begin(m.ctx, key)
+	if !ok {
+		m.store.CancelUndo(action, redo)
+		m.errorToast = fmt.Sprintf("%s of %q is still being sent", verb, action.Label)
+		return nil
+	}
+	m.rebuildColumns()
+	m.applyFilter()
+
+	project, groupField, client, s := m.store.GetProject(), m.store.GetGroupField(), m.client, m.store
+	return m.outbox.enqueue(ctx, &outboxEntry{
+		label: verb + ": " + action.Label,
+		apply: func() error {
+			// A refused step was taken back; take it again before resending
+			if !s.RepeatUndo(action, redo) {
+				return fmt.Errorf("the board changed since; %s again instead", strings.ToLower(verb))
+			}
+			return nil
+		},
+		send: func(ctx context.Context) error {
+			defer m.guard.end(key)
+			if project == nil || groupField == nil {
+				return fmt.Errorf("missing project or field")
+			}
+			return sendAction(ctx, client, project.ID, groupField, action, redo)
+		},
+		done: func(err error) tea.Msg {
+			if err != nil {
+				return undoErrorMsg{action: action, redo: redo, err: err}
+			}
+			return undoneMsg{action: action, redo: redo}
+		},
+	})
+}
+
+// sendAction sets GitHub to the state after an action, or with forward false
+// the state before it
+func sendAction(ctx context.Context, client *gh.Client, projectID string, groupField *domain.FieldDef, a store.Action, forward bool) error {
+	switch a.Kind {
+	case store.ActionMove:
+		from, to := a.To, a.From
+		if forward {
+			from, to = a.From, a.To
+		}
+		if a.Field.ID != "" {
+			groupField = &a.Field
+		}
+		if store.IsAssigneeField(groupField) {
+			return client.ReassignItem(ctx, a.ContentID, from, to)
+		}
+		if to == "" {
+			return client.ClearItemField(ctx, projectID, a.ItemID, groupField.ID)
+		}
+		return client.UpdateItemField(ctx, projectID, a.ItemID, groupField.ID, gh.SingleSelectValue(to))
+
+	case store.ActionSetField:
+		v := a.Before
+		if forward {
+			v = a.After
+		}
+		if v == nil {
+			return client.ClearItemField(ctx, projectID, a.ItemID, a.Field.ID)
+		}
+		return client.UpdateItemField(ctx, projectID, a.ItemID, a.Field.ID, toFieldInput(a.Field, *v))
+
+	case store.ActionArchive:
+		if forward {
+			_, err := client.ArchiveItems(ctx, projectID, a.ItemIDs())
+			return err
+		}
+		_, err := client.UnarchiveItems(ctx, projectID, a.ItemIDs())
+		return err
+	}
+	return fmt.Errorf("unknown action %d", a.Kind)
+}
+
+// handleUndoError puts the board back after GitHub refused an undo or redo.
+// The action returns to the stack it came from unless the board changed since.
+func (m *BoardModel) handleUndoError(msg undoErrorMsg) {
+	verb := "Undo"
+	if msg.redo {
+		verb = "Redo"
+	}
+	m.store.CancelUndo(msg.action, msg.redo)
+	m.rebuildColumns()
+	m.applyFilter()
+	m.errorToast = fmt.Sprintf("%s failed: %v", verb, msg.err)
+}
+
+// Message types for undo and redo
+type (
+	undoneMsg struct {
+		action store.Action
+		redo   bool
+	}
+	undoErrorMsg struct {
+		action store.Action
+		redo   bool
+		err    error
+	}
+)
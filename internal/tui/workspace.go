@@ -0,0 +1,149 @@
+package tui
+
+import (
+	"maps"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/h0rv/ghp/internal/uistate"
+	"github.com/h0rv/ghp/internal/workspace"
+)
+
+// applyWorkspace loads a workspace's view settings into the board.
+// The project and grouping field are resolved by the app before the board exists.
+func (m *BoardModel) applyWorkspace(ws *workspace.Workspace) {
+	m.workspaceName = ws.Name
+	m.filterText = ws.Filter
+	m.filterInput.SetValue(ws.Filter)
+	m.filterMyOnly = ws.MyOnly
+	m.teamSlug = ws.Team
+	m.teamInput.SetValue(ws.Team)
+	m.hiddenColumns = make(map[string]string, len(ws.HiddenColumns))
+	for _, name := range ws.HiddenColumns {
+		m.hiddenColumns[strings.ToLower(name)] = name
+	}
+	m.workspaceSorts = ws
+	m.applyWorkspaceSorts()
+}
+
+// applyWorkspaceSorts sets the launch workspace's sorts on the board's UI
+// state. The state loads after the board opens, so this runs again then.
+func (m *BoardModel) applyWorkspaceSorts() {
+	ws := m.workspaceSorts
+	groupField := m.store.GetGroupField()
+	if ws == nil || groupField == nil {
+		return
+	}
+	if m.uiState == nil {
+		m.uiState = &uistate.State{}
+	}
+	m.uiState.BoardSort = ws.BoardSort
+	delete(m.uiState.ColumnSorts, groupField.Name)
+	for column, key := range ws.ColumnSorts {
+		m.uiState.SetColumnSort(groupField.Name, column, key)
+	}
+}
+
+// isHidden reports whether the column with the given display name is hidden
+func (m BoardModel) isHidden(name string) bool {
+	_, hidden := m.hiddenColumns[strings.ToLower(name)]
+	return hidden
+}
+
+// hideSelectedColumn removes the selected column from the board
+func (m *BoardModel) hideSelectedColumn() {
+	if len(m.columns) == 0 {
+		return
+	}
+	if m.hiddenColumns == nil {
+		m.hiddenColumns = make(map[string]string)
+	}
+	name := m.columnNames[m.columns[m.selectedColumn]]
+	m.hiddenColumns[strings.ToLower(name)] = name
+
+	m.rebuildColumns()
+	m.applyFilter()
+	m.adjustColumnScroll()
+}
+
+// showAllColumns restores every hidden column
+func (m *BoardModel) showAllColumns() {
+	m.hiddenColumns = nil
+	m.rebuildColumns()
+	m.applyFilter()
+}
+
+// hiddenColumnNames returns the display names of hidden columns, sorted
+func (m BoardModel) hiddenColumnNames() []string {
+	names := make([]string, 0, len(m.hiddenColumns))
+	for _, name := range m.hiddenColumns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// handleWorkspacePrompt handles key presses while naming a workspace to save
+func (m BoardModel) handleWorkspacePrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		m.workspaceMode = false
+		name := strings.TrimSpace(m.workspaceInput.Value())
+		if name == "" {
+			return m, nil
+		}
+		return m, m.saveWorkspace(name)
+	case "esc":
+		m.workspaceMode = false
+		return m, nil
+	default:
+		var cmd tea.Cmd
+		m.workspaceInput, cmd = m.workspaceInput.Update(msg)
+		return m, cmd
+	}
+}
+
+// startWorkspacePrompt opens the workspace name prompt, prefilled with the current workspace
+func (m *BoardModel) startWorkspacePrompt() {
+	m.workspaceMode = true
+	m.workspaceInput.SetValue(m.workspaceName)
+	m.workspaceInput.CursorEnd()
+	m.workspaceInput.Focus()
+}
+
+// saveWorkspace stores the current project and view settings under name
+func (m BoardModel) saveWorkspace(name string) tea.Cmd {
+	project := m.store.GetProject()
+	groupField := m.store.GetGroupField()
+	if project == nil || groupField == nil {
+		return nil
+	}
+
+	ws := workspace.Workspace{
+		Name:          name,
+		Owner:         project.Owner,
+		Project:       project.Number,
+		GroupField:    groupField.Name,
+		Filter:        m.filterText,
+		MyOnly:        m.filterMyOnly,
+		Team:          m.teamSlug,
+		HiddenColumns: m.hiddenColumnNames(),
+	}
+	if m.uiState != nil {
+		ws.BoardSort = m.uiState.BoardSort
+		ws.ColumnSorts = maps.Clone(m.uiState.ColumnSorts[groupField.Name])
+	}
+	return func() tea.Msg {
+		if err := workspace.Save(ws); err != nil {
+			return workspaceErrorMsg{err: err}
+		}
+		return workspaceSavedMsg{name: ws.Name}
+	}
+}
+
+// Message types for workspaces
+type (
+	workspaceSavedMsg struct{ name string }
+	workspaceErrorMsg struct{ err error }
+)
@@ -0,0 +1,96 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/h0rv/ghp/internal/domain"
+)
+
+// handleMouse zooms into a column when its header is clicked, and back out
+// when the zoomed column's header is clicked
+func (m BoardModel) handleMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if msg.Action != tea.MouseActionPress || msg.Button != tea.MouseButtonLeft || m.modal() {
+		return m, nil
+	}
+	col := m.headerColumnAt(msg.X, msg.Y)
+	if col < 0 {
+		return m, nil
+	}
+	if m.zoomed {
+		m.zoomed = false
+		return m, nil
+	}
+	m.selectedColumn = col
+	m.zoomed = true
+	return m, nil
+}
+
+// headerColumnAt returns the index of the column whose header is at x, y in
+// the board's view, or -1. Headers are found by their "[N]" prefix just
+// inside the column's border.
+func (m BoardModel) headerColumnAt(x, y int) int {
+	lines := strings.Split(ansi.Strip(m.View()), "\n")
+	if y < 0 || y >= len(lines) {
+		return -1
+	}
+	line := lines[y]
+	for i := range m.columns {
+		idx := strings.Index(line, fmt.Sprintf("│ [%d] ", i+1))
+		if idx < 0 {
+			continue
+		}
+		// The header runs to the column's right border
+		rest := line[idx+len("│"):]
+		if end := strings.Index(rest, "│"); end >= 0 {
+			rest = rest[:end]
+		}
+		start := ansi.StringWidth(line[:idx])
+		if x >= start && x <= start+ansi.StringWidth(rest) {
+			return i
+		}
+	}
+	return -1
+}
+
+// formatZoomedCard formats a card row for a zoomed column, which has room for
+// the repository, assignees, labels, and last update next to the title
+func (m BoardModel) formatZoomedCard(card *domain.Card, maxWidth int) string {
+	var parts []string
+	if card.Repo != "" && card.Number > 0 {
+		parts = append(parts, fmt.Sprintf("%s#%d", card.Repo, card.Number))
+	} else if card.ContentType != "" {
+		parts = append(parts, card.ContentType)
+	}
+	if card.AuthorIsBot {
+		parts = append(parts, "bot")
+	}
+	if len(card.Assignees) > 0 {
+		parts = append(parts, "@"+strings.Join(card.Assignees, " @"))
+	}
+	if len(card.Labels) > 0 {
+		parts = append(parts, "["+strings.Join(card.Labels, ", ")+"]")
+	}
+	if card.UpdatedAt != "" {
+		parts = append(parts, formatTimeAgo(card.UpdatedAt))
+	}
+	meta := strings.Join(parts, "  ")
+
+	// Meta gives way to the title on narrow terminals
+	titleWidth := maxWidth - lipgloss.Width(meta) - 2
+	if titleWidth < maxWidth/2 {
+		meta, titleWidth = "", maxWidth
+	}
+	title := card.Title
+	if runes := []rune(title); len(runes) > titleWidth {
+		title = string(runes[:max(titleWidth-1, 0)]) + "…"
+	}
+	if meta == "" {
+		return title
+	}
+	gap := strings.Repeat(" ", max(maxWidth-lipgloss.Width(title)-lipgloss.Width(meta), 2))
+	return title + gap + dimStyle.Render(meta)
+}
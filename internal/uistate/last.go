@@ -0,0 +1,75 @@
+package uistate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/h0rv/ghp/internal/atomicfile"
+	"github.com/h0rv/ghp/internal/config"
+)
+
+// Last is the board open when ghp last exited, reopened by `ghp --resume`.
+type Last struct {
+	Owner      string // Project owner login
+	Project    int    // Project number
+	GroupField string // Grouping field name
+}
+
+// LastPath returns the last-board file path, honoring XDG_CONFIG_HOME.
+func LastPath() (string, error) {
+	base, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate config directory: %w", err)
+	}
+	return filepath.Join(base, "ghp", "state", "last.json"), nil
+}
+
+// LoadLast reads the last board. It returns nil when none was saved or the
+// saved one names no project.
+func LoadLast() (*Last, config.Problems, error) {
+	path, err := LastPath()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read last board: %w", err)
+	}
+
+	var last Last
+	problems, err := config.Decode(path, data, &last)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode last board: %w", err)
+	}
+	if last.Owner == "" || last.Project <= 0 {
+		return nil, problems, nil
+	}
+	return &last, problems, nil
+}
+
+// SaveLast records the board to reopen next time.
+func SaveLast(last Last) error {
+	path, err := LastPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(last, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode last board: %w", err)
+	}
+	if err := atomicfile.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write last board: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+// Package uistate persists per-project board preferences, such as column
+// sort orders, between sessions.
+package uistate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/h0rv/ghp/internal/atomicfile"
+	"github.com/h0rv/ghp/internal/config"
+)
+
+// State holds the board preferences for one project.
+type State struct {
+	// Sort key per column, keyed by grouping field name, then column name.
+	// Columns without an entry use project order.
+	ColumnSorts map[string]map[string]string
+
+	// Sort key for columns without their own, "" for project order.
+	BoardSort string
+
+	// Card density: "compact" for one line per card, "normal" for two,
+	// "expanded" for three, or "" to choose one or two by terminal height
+	// and column lengths.
+	Density string
+
+	// Repositories ("owner/name") whose items are left off the board, such
+	// as archived repositories whose issues are still in the project.
+	HiddenRepos []string
+
+	// Recent board filter queries, newest first.
+	SearchHistory []string
+}
+
+// maxSearchHistory is how many filter queries SearchHistory keeps.
+const maxSearchHistory = 20
+
+// AddSearch records a filter query as the most recent, dropping an earlier
+// copy of it and the oldest ones beyond the limit.
+func (s *State) AddSearch(query string) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return
+	}
+	history := []string{query}
+	for _, q := range s.SearchHistory {
+		if q != query && len(history) < maxSearchHistory {
+			history = append(history, q)
+		}
+	}
+	s.SearchHistory = history
+}
+
+// Clone returns a deep copy of the state, safe to save from another goroutine
+// while the board keeps changing the original.
+func (s *State) Clone() *State {
+	if s == nil {
+		return nil
+	}
+	c := *s
+	if s.ColumnSorts != nil {
+		c.ColumnSorts = make(map[string]map[string]string, len(s.ColumnSorts))
+		for field, columns := range s.ColumnSorts {
+			c.ColumnSorts[field] = maps.Clone(columns)
+		}
+	}
+	c.HiddenRepos = slices.Clone(s.HiddenRepos)
+	c.SearchHistory = slices.Clone(s.SearchHistory)
+	return &c
+}
+
+// ColumnSort returns the sort key for a column, or "" for project order.
+func (s *State) ColumnSort(field, column string) string {
+	return s.ColumnSorts[field][column]
+}
+
+// SetColumnSort sets the sort key for a column. An empty key restores project order.
+func (s *State) SetColumnSort(field, column, key string) {
+	if key == "" {
+		delete(s.ColumnSorts[field], column)
+		if len(s.ColumnSorts[field]) == 0 {
+			delete(s.ColumnSorts, field)
+		}
+		return
+	}
+	if s.ColumnSorts == nil {
+		s.ColumnSorts = make(map[string]map[string]string)
+	}
+	if s.ColumnSorts[field] == nil {
+		s.ColumnSorts[field] = make(map[string]string)
+	}
+	s.ColumnSorts[field][column] = key
+}
+
+// Path returns the state file path for a project, honoring XDG_CONFIG_HOME.
+func Path(owner string, number int) (string, error) {
+	base, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate config directory: %w", err)
+	}
+	name := fmt.Sprintf("%s-%d.json", strings.ToLower(owner), number)
+	return filepath.Join(base, "ghp", "state", name), nil
+}
+
+// Load reads a project's state. A project without saved state gets an empty State.
+// Unknown keys and invalid values are ignored; use Read to report them.
+func Load(owner string, number int) (*State, error) {
+	state, _, err := Read(owner, number)
+	return state, err
+}
+
+// Read is like Load but also returns the problems it worked around.
+func Read(owner string, number int) (*State, config.Problems, error) {
+	path, err := Path(owner, number)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &State{}, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read UI state: %w", err)
+	}
+
+	var state State
+	problems, err := config.Decode(path, data, &state)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode UI state: %w", err)
+	}
+	return &state, problems, nil
+}
+
+// Save writes a project's state.
+func Save(owner string, number int, state *State) error {
+	path, err := Path(owner, number)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode UI state: %w", err)
+	}
+	if err := atomicfile.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write UI state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package uistate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveLoad_RoundTrip(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	state, err := Load("MyOrg", 1)
+	require.NoError(t, err)
+	assert.Equal(t, "", state.ColumnSort("Status", "In Review"), "Missing state is empty")
+
+	state.SetColumnSort("Status", "In Review", "created")
+	state.SetColumnSort("Status", "Backlog", "field:Priority")
+	require.NoError(t, Save("MyOrg", 1, state))
+
+	loaded, err := Load("myorg", 1)
+	require.NoError(t, err)
+	assert.Equal(t, "created", loaded.ColumnSort("Status", "In Review"))
+	assert.Equal(t, "field:Priority", loaded.ColumnSort("Status", "Backlog"))
+	assert.Equal(t, "", loaded.ColumnSort("Priority", "Backlog"), "Sorts are scoped to the grouping field")
+}
+
+func TestSetColumnSort_ClearRemovesEntry(t *testing.T) {
+	state := &State{}
+	state.SetColumnSort("Status", "Done", "-updated")
+	state.SetColumnSort("Status", "Done", "")
+
+	assert.Empty(t, state.ColumnSorts)
+}
+
+func TestClone(t *testing.T) {
+	state := &State{BoardSort: "title", SearchHistory: []string{"bug"}}
+	state.SetColumnSort("Status", "Done", "-updated")
+
+	c := state.Clone()
+	assert.Equal(t, state, c)
+	state.SetColumnSort("Status", "Done", "number")
+	state.SearchHistory[0] = "api"
+	assert.Equal(t, "-updated", c.ColumnSort("Status", "Done"), "The copy shares no maps")
+	assert.Equal(t, []string{"bug"}, c.SearchHistory)
+}
+
+func TestAddSearch(t *testing.T) {
+	state := &State{}
+	state.AddSearch("bug")
+	state.AddSearch("  ")
+	state.AddSearch("@me")
+	state.AddSearch("bug")
+	assert.Equal(t, []string{"bug", "@me"}, state.SearchHistory, "Repeats move to the front")
+
+	for i := range maxSearchHistory + 5 {
+		state.AddSearch(string(rune('a' + i)))
+	}
+	assert.Len(t, state.SearchHistory, maxSearchHistory)
+	assert.Equal(t, string(rune('a'+maxSearchHistory+4)), state.SearchHistory[0])
+}
+
+func TestSaveLoadLast(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	last, _, err := LoadLast()
+	require.NoError(t, err)
+	assert.Nil(t, last, "Nothing saved yet")
+
+	require.NoError(t, SaveLast(Last{Owner: "myorg", Project: 3, GroupField: "Stage"}))
+	last, problems, err := LoadLast()
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+	assert.Equal(t, &Last{Owner: "myorg", Project: 3, GroupField: "Stage"}, last)
+
+	require.NoError(t, SaveLast(Last{Owner: "myorg"}))
+	last, _, err = LoadLast()
+	require.NoError(t, err)
+	assert.Nil(t, last, "A board without a project isn't resumed")
+}
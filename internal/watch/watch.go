@@ -0,0 +1,110 @@
+// Package watch turns successive fetches of a project into change events.
+package watch
+
+import (
+	"slices"
+	"time"
+
+	"github.com/h0rv/ghp/internal/domain"
+)
+
+// Event types
+const (
+	Added   = "added"   // Item added to the project
+	Removed = "removed" // Item removed from the project (or archived)
+	Moved   = "moved"   // Item changed column
+	Changed = "changed" // Title, state, or assignees changed
+	Failed  = "error"   // A poll failed; Error says why
+)
+
+// Event describes one change to a project item between two fetches.
+type Event struct {
+	Type    string    `json:"type"`
+	At      time.Time `json:"at"`
+	ItemID  string    `json:"itemId"`
+	Repo    string    `json:"repo,omitempty"`
+	Number  int       `json:"number,omitempty"`
+	Title   string    `json:"title"`
+	URL     string    `json:"url,omitempty"`
+	From    string    `json:"from,omitempty"`    // Previous column, for moved
+	To      string    `json:"to,omitempty"`      // New column, for added and moved
+	Changes []string  `json:"changes,omitempty"` // "title", "state", "assignees", for changed
+	Error   string    `json:"error,omitempty"`
+}
+
+// Diff compares two fetches of the same project and returns what changed, in
+// the order of next followed by removed items in the order of prev. column
+// names the column of an option ID of the grouping field.
+func Diff(prev, next []domain.Card, column func(optionID string) string, at time.Time) []Event {
+	before := make(map[string]*domain.Card, len(prev))
+	for i := range prev {
+		before[prev[i].ItemID] = &prev[i]
+	}
+
+	var events []Event
+	seen := make(map[string]bool, len(next))
+	for i := range next {
+		card := &next[i]
+		seen[card.ItemID] = true
+
+		old, ok := before[card.ItemID]
+		if !ok {
+			e := newEvent(Added, card, at)
+			e.To = column(card.GroupOptionID)
+			events = append(events, e)
+			continue
+		}
+
+		if old.GroupOptionID != card.GroupOptionID {
+			e := newEvent(Moved, card, at)
+			e.From, e.To = column(old.GroupOptionID), column(card.GroupOptionID)
+			events = append(events, e)
+		}
+
+		var changes []string
+		if old.Title != card.Title {
+			changes = append(changes, "title")
+		}
+		if old.State != card.State {
+			changes = append(changes, "state")
+		}
+		if !sameAssignees(old.Assignees, card.Assignees) {
+			changes = append(changes, "assignees")
+		}
+		if len(changes) > 0 {
+			e := newEvent(Changed, card, at)
+			e.Changes = changes
+			events = append(events, e)
+		}
+	}
+
+	for i := range prev {
+		if !seen[prev[i].ItemID] {
+			events = append(events, newEvent(Removed, &prev[i], at))
+		}
+	}
+	return events
+}
+
+func newEvent(typ string, card *domain.Card, at time.Time) Event {
+	return Event{
+		Type:   typ,
+		At:     at,
+		ItemID: card.ItemID,
+		Repo:   card.Repo,
+		Number: card.Number,
+		Title:  card.Title,
+		URL:    card.URL,
+	}
+}
+
+// sameAssignees reports whether two assignee lists hold the same logins in any order
+func sameAssignees(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
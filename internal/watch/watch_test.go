@@ -0,0 +1,50 @@
+package watch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/h0rv/ghp/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestCard(id, title, column string) domain.Card {
+	return domain.Card{ItemID: id, Title: title, GroupOptionID: column, State: "OPEN", Repo: "org/repo"}
+}
+
+func TestDiff(t *testing.T) {
+	names := map[string]string{"todo": "Todo", "done": "Done"}
+	column := func(id string) string { return names[id] }
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	prev := []domain.Card{
+		createTestCard("1", "Stays", "todo"),
+		createTestCard("2", "Moves", "todo"),
+		createTestCard("3", "Goes away", "done"),
+		createTestCard("4", "Renamed", "todo"),
+	}
+	prev[0].Assignees = []string{"alice", "bob"}
+
+	next := []domain.Card{
+		createTestCard("1", "Stays", "todo"),
+		createTestCard("2", "Moves", "done"),
+		createTestCard("4", "Renamed again", "todo"),
+		createTestCard("5", "New", "todo"),
+	}
+	next[0].Assignees = []string{"bob", "alice"}
+	next[2].State = "CLOSED"
+
+	events := Diff(prev, next, column, at)
+	require.Len(t, events, 4, "Reordered assignees are not a change")
+
+	assert.Equal(t, Event{Type: Moved, At: at, ItemID: "2", Repo: "org/repo", Title: "Moves", From: "Todo", To: "Done"}, events[0])
+	assert.Equal(t, Changed, events[1].Type)
+	assert.Equal(t, []string{"title", "state"}, events[1].Changes)
+	assert.Equal(t, Added, events[2].Type)
+	assert.Equal(t, "Todo", events[2].To)
+	assert.Equal(t, Removed, events[3].Type)
+	assert.Equal(t, "3", events[3].ItemID)
+
+	assert.Empty(t, Diff(next, next, column, at))
+}
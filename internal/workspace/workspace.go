@@ -0,0 +1,227 @@
+// Package workspace stores named board layouts: a project, grouping field,
+// and view settings that can be launched together with `ghp --workspace`.
+package workspace
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/h0rv/ghp/internal/atomicfile"
+	"github.com/h0rv/ghp/internal/config"
+)
+
+// ErrNotFound indicates no workspace exists with the requested name.
+var ErrNotFound = errors.New("workspace not found")
+
+// Workspace is a saved combination of project and board view settings.
+type Workspace struct {
+	Name          string
+	Owner         string   // Project owner login
+	Project       int      // Project number
+	GroupField    string   // Grouping field name ("" for the default)
+	Filter        string   // Board text filter
+	MyOnly        bool     // Only items assigned to the viewer
+	Team          string   // Only items assigned to members of this team ("slug" or "org/slug")
+	HiddenColumns []string // Column (option) names not shown on the board
+
+	// Sort keys as the board uses them ("title", "-updated", ...); "" is
+	// project order
+	BoardSort   string            // Sort for columns without their own
+	ColumnSorts map[string]string // Column (option) name -> sort key
+}
+
+// Path returns the workspaces file path, honoring XDG_CONFIG_HOME.
+func Path() (string, error) {
+	base, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate config directory: %w", err)
+	}
+	return filepath.Join(base, "ghp", "workspaces.json"), nil
+}
+
+// List returns all saved workspaces sorted by name. Unknown keys and invalid
+// values are skipped, as are workspaces without an owner or project; use Check
+// to report them.
+func List() ([]Workspace, error) {
+	workspaces, _, err := read()
+	if err != nil {
+		return nil, err
+	}
+	valid := workspaces[:0]
+	for _, ws := range workspaces {
+		if ws.Name != "" && ws.Owner != "" && ws.Project > 0 {
+			valid = append(valid, ws)
+		}
+	}
+	sort.Slice(valid, func(i, j int) bool { return valid[i].Name < valid[j].Name })
+	return valid, nil
+}
+
+// Check returns the problems List works around in the workspaces file.
+func Check() (config.Problems, error) {
+	workspaces, problems, err := read()
+	if err != nil {
+		return nil, err
+	}
+	path, _ := Path()
+	for i, ws := range workspaces {
+		name := ws.Name
+		if name == "" {
+			name = fmt.Sprintf("#%d", i+1)
+		}
+		switch {
+		case ws.Name == "":
+			problems = append(problems, config.Problem{File: path, Msg: fmt.Sprintf("workspace %s has no Name and is skipped", name)})
+		case ws.Owner == "" || ws.Project <= 0:
+			problems = append(problems, config.Problem{File: path, Msg: fmt.Sprintf("workspace %q needs an Owner and Project and is skipped", name)})
+		}
+	}
+	return problems, nil
+}
+
+// read decodes the workspaces file without dropping invalid entries
+func read() ([]Workspace, config.Problems, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read workspaces: %w", err)
+	}
+
+	var workspaces []Workspace
+	problems, err := config.Decode(path, data, &workspaces)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode workspaces: %w", err)
+	}
+	return workspaces, problems, nil
+}
+
+// Get returns the workspace with the given name (case-insensitive).
+// Returns ErrNotFound if it does not exist.
+func Get(name string) (*Workspace, error) {
+	workspaces, err := List()
+	if err != nil {
+		return nil, err
+	}
+	for i := range workspaces {
+		if strings.EqualFold(workspaces[i].Name, name) {
+			return &workspaces[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+}
+
+// Save stores ws, replacing any workspace with the same name. Other entries
+// are kept as written, including ones List skips.
+func Save(ws Workspace) error {
+	ws.Name = strings.TrimSpace(ws.Name)
+	if ws.Name == "" {
+		return errors.New("workspace name is required")
+	}
+	if ws.Owner == "" || ws.Project <= 0 {
+		return errors.New("workspace requires an owner and project number")
+	}
+
+	entries, err := readEntries()
+	if err != nil {
+		return err
+	}
+	entry, err := json.Marshal(ws)
+	if err != nil {
+		return fmt.Errorf("failed to encode workspaces: %w", err)
+	}
+
+	replaced := false
+	for i := range entries {
+		if strings.EqualFold(entryName(entries[i]), ws.Name) {
+			entries[i] = entry
+			replaced = true
+		}
+	}
+	if !replaced {
+		entries = append(entries, entry)
+	}
+	return write(entries)
+}
+
+// Delete removes the named workspace, keeping the other entries as written.
+// Returns ErrNotFound if it does not exist.
+func Delete(name string) error {
+	entries, err := readEntries()
+	if err != nil {
+		return err
+	}
+
+	kept := entries[:0]
+	for _, entry := range entries {
+		if !strings.EqualFold(entryName(entry), name) {
+			kept = append(kept, entry)
+		}
+	}
+	if len(kept) == len(entries) {
+		return fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	return write(kept)
+}
+
+// readEntries reads the workspaces file's entries undecoded, so rewriting
+// the file keeps invalid values and unknown keys for the user to fix
+func readEntries() ([]json.RawMessage, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read workspaces: %w", err)
+	}
+	var entries []json.RawMessage
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to decode workspaces: %w", err)
+	}
+	return entries, nil
+}
+
+// entryName returns an undecoded entry's name, "" if it has none
+func entryName(entry json.RawMessage) string {
+	var ws struct{ Name string }
+	if err := json.Unmarshal(entry, &ws); err != nil {
+		return ""
+	}
+	return strings.TrimSpace(ws.Name)
+}
+
+// write replaces the workspaces file atomically.
+func write(entries []json.RawMessage) error {
+	path, err := Path()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode workspaces: %w", err)
+	}
+
+	if err := atomicfile.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write workspaces: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestWorkspace(name string) Workspace {
+	return Workspace{
+		Name:          name,
+		Owner:         "myorg",
+		Project:       3,
+		GroupField:    "Status",
+		Filter:        "api",
+		HiddenColumns: []string{"Done"},
+		BoardSort:     "-updated",
+		ColumnSorts:   map[string]string{"Todo": "title"},
+	}
+}
+
+func TestSaveGet_RoundTrip(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	ws := createTestWorkspace("backend-sprint")
+	require.NoError(t, Save(ws))
+
+	loaded, err := Get("Backend-Sprint")
+	require.NoError(t, err)
+	assert.Equal(t, ws, *loaded)
+}
+
+func TestSave_ReplacesByName(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	require.NoError(t, Save(createTestWorkspace("b")))
+	require.NoError(t, Save(createTestWorkspace("a")))
+
+	updated := createTestWorkspace("b")
+	updated.Filter = "web"
+	require.NoError(t, Save(updated))
+
+	all, err := List()
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, "a", all[0].Name, "Sorted by name")
+	assert.Equal(t, "web", all[1].Filter)
+}
+
+func TestSave_RequiresProject(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	err := Save(Workspace{Name: "empty"})
+	assert.Error(t, err)
+}
+
+func TestDelete(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	require.NoError(t, Save(createTestWorkspace("a")))
+	require.NoError(t, Delete("a"))
+
+	_, err := Get("a")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, Delete("a"), ErrNotFound)
+}
+
+func TestList_SkipsInvalidEntries(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	path, err := Path()
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(`[
+  {"Name": "good", "Owner": "myorg", "Project": 3, "Fitler": "api"},
+  {"Name": "broken", "Owner": "myorg", "Project": "three"}
+]`), 0o644))
+
+	workspaces, err := List()
+	require.NoError(t, err)
+	require.Len(t, workspaces, 1, "The workspace with an invalid project is skipped")
+	assert.Equal(t, "good", workspaces[0].Name)
+
+	problems, err := Check()
+	require.NoError(t, err)
+	require.Len(t, problems, 3)
+	assert.Equal(t, 2, problems[0].Line)
+	assert.Equal(t, "Filter", problems[0].Suggestion)
+	assert.Equal(t, 3, problems[1].Line)
+	assert.Contains(t, problems[2].Msg, `"broken" needs an Owner and Project`)
+}
+
+func TestSaveDelete_KeepInvalidEntries(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	path, err := Path()
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(`[
+  {"Name": "broken", "Owner": "myorg", "Project": "three", "Fitler": "api"},
+  {"Name": "old", "Owner": "myorg", "Project": 1}
+]`), 0o644))
+
+	require.NoError(t, Save(createTestWorkspace("a")))
+	require.NoError(t, Delete("old"))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"Project": "three"`, "The mistyped workspace is kept for the user to fix")
+	assert.Contains(t, string(data), `"Fitler": "api"`)
+	assert.NotContains(t, string(data), `"old"`)
+
+	workspaces, err := List()
+	require.NoError(t, err)
+	require.Len(t, workspaces, 1)
+	assert.Equal(t, "a", workspaces[0].Name)
+}